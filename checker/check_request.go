@@ -18,6 +18,8 @@ import (
 	"context"
 
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/git"
+	"github.com/ossf/scorecard/v5/config"
 	"github.com/ossf/scorecard/v5/internal/packageclient"
 )
 
@@ -31,11 +33,28 @@ type CheckRequest struct {
 	Repo                  clients.Repo
 	VulnerabilitiesClient clients.VulnerabilitiesClient
 	ProjectClient         packageclient.ProjectPackageClient
+	// Config holds the maintainer annotations of the repository, if any.
+	Config *config.Config
+	// LicenseDeepScan enables the scan of the license declarations of the
+	// source files by the License check.
+	LicenseDeepScan bool
+	// MaintainedLookBackDays is the number of days of activity considered by
+	// the Maintained check. Zero means DefaultMaintainedLookBackDays.
+	MaintainedLookBackDays int
+	// HistoryClient gives access to the changes made by the recent commits,
+	// which the hasLeakedSecrets probe scans. Nil unless the scan is enabled.
+	HistoryClient HistoryClient
 	// UPGRADEv6: return raw results instead of scores.
 	RawResults    *RawResults
 	RequiredTypes []RequestType
 }
 
+// HistoryClient lists the lines added by the recent commits of a repository,
+// e.g. git.Client.
+type HistoryClient interface {
+	ListAddedLines(maxCommits int) ([]git.AddedLine, error)
+}
+
 // RequestType identifies special requirements/attributes that need to be supported by checks.
 type RequestType int
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/ossf/scorecard/v5/config"
 	sce "github.com/ossf/scorecard/v5/errors"
@@ -244,6 +245,17 @@ func CreateRuntimeErrorResult(name string, e error) CheckResult {
 	}
 }
 
+// CreateTimeoutResult is used when the check does not complete within its timeout.
+func CreateTimeoutResult(name string, timeout time.Duration) CheckResult {
+	return CreateRuntimeErrorResult(name,
+		sce.WithMessage(sce.ErrCheckTimeout, fmt.Sprintf("did not complete within %s", timeout)))
+}
+
+// TimedOut returns whether the check did not complete within its timeout.
+func (r *CheckResult) TimedOut() bool {
+	return errors.Is(r.Error, sce.ErrCheckTimeout)
+}
+
 // LogFinding logs the given finding at the given level.
 func LogFinding(dl DetailLogger, f *finding.Finding, level DetailType) {
 	lm := LogMessage{Finding: f}
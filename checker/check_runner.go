@@ -22,18 +22,31 @@ import (
 
 	opencensusstats "go.opencensus.io/stats"
 	"go.opencensus.io/tag"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/ossf/scorecard/v5/clients"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/stats"
 )
 
 const checkRetries = 3
 
+// maxAbandonedChecks bounds the checks left running in the background after
+// their context is done.
+const maxAbandonedChecks = 16
+
+// abandonedChecks holds a slot for each check left running in the background.
+var abandonedChecks = make(chan struct{}, maxAbandonedChecks)
+
 // Runner runs a check with retries.
 type Runner struct {
 	CheckName    string
 	Repo         string
 	CheckRequest CheckRequest
+	// Timeout bounds the run time of the check, retries included.
+	// A zero Timeout means no limit.
+	Timeout time.Duration
 }
 
 // NewRunner creates a new instance of `Runner`.
@@ -60,6 +73,11 @@ func (r *Runner) SetCheckRequest(checkReq *CheckRequest) {
 	r.CheckRequest = *checkReq
 }
 
+// SetTimeout sets the timeout of the check.
+func (r *Runner) SetTimeout(timeout time.Duration) {
+	r.Timeout = timeout
+}
+
 // CheckFn defined for convenience.
 type CheckFn func(*CheckRequest) CheckResult
 
@@ -72,11 +90,14 @@ type Check struct {
 // CheckNameToFnMap defined here for convenience.
 type CheckNameToFnMap map[string]Check
 
-func logStats(ctx context.Context, startTime time.Time, result *CheckResult) error {
+func (r *Runner) logStats(ctx context.Context, startTime time.Time, result *CheckResult) error {
 	runTimeInSecs := time.Now().Unix() - startTime.Unix()
 	opencensusstats.Record(ctx, stats.CheckRuntimeInSec.M(runTimeInSecs))
+	host := r.CheckRequest.Repo.Host()
+	stats.PromCheckDuration.WithLabelValues(r.CheckName, host).Observe(time.Since(startTime).Seconds())
 
 	if result.Error != nil {
+		stats.PromCheckErrors.WithLabelValues(r.CheckName, host, sce.GetName(result.Error)).Inc()
 		ctx, err := tag.New(ctx, tag.Upsert(stats.ErrorName, sce.GetName(result.Error)))
 		if err != nil {
 			return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("tag.New: %v", err))
@@ -107,15 +128,38 @@ func (r *Runner) Run(ctx context.Context, c Check) CheckResult {
 		l.Warn(&LogMessage{Text: fmt.Sprintf("tag.New: %v", err)})
 	}
 
+	ctx, span := stats.Tracer().Start(ctx, "check/"+r.CheckName,
+		trace.WithAttributes(
+			stats.AttrCheck.String(r.CheckName),
+			stats.AttrRepo.String(r.Repo),
+			stats.AttrRepoHost.String(r.CheckRequest.Repo.Host()),
+		))
+	defer span.End()
+
 	startTime := time.Now()
 
+	checkCtx := ctx
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		checkCtx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+
 	var res CheckResult
+	completed := true
 	l = NewLogger()
 	for retriesRemaining := checkRetries; retriesRemaining > 0; retriesRemaining-- {
 		checkRequest := r.CheckRequest
-		checkRequest.Ctx = ctx
+		checkRequest.Ctx = checkCtx
 		checkRequest.Dlogger = l
-		res = c.Fn(&checkRequest)
+		checkRequest.RepoClient = clients.NewTracingRepoClient(checkCtx, checkRequest.RepoClient, r.Repo, r.CheckName)
+		res, completed = runCheckFn(checkCtx, c.Fn, &checkRequest)
+		// A check which returns an error after its context is done most
+		// likely failed because its client calls were cancelled.
+		if !completed || (res.Error != nil && checkCtx.Err() != nil) {
+			res = r.cancelledResult(ctx, checkCtx)
+			break
+		}
 		if res.Error != nil && errors.Is(res.Error, sce.ErrRepoUnreachable) {
 			checkRequest.Dlogger.Warn(&LogMessage{
 				Text: fmt.Sprintf("%v", res.Error),
@@ -125,12 +169,60 @@ func (r *Runner) Run(ctx context.Context, c Check) CheckResult {
 		break
 	}
 
-	// Set details.
+	// Set details. A check which didn't complete may still be logging.
 	// TODO(#1393): Remove.
-	res.Details = l.Flush()
+	if completed {
+		res.Details = l.Flush()
+	}
 
-	if err := logStats(ctx, startTime, &res); err != nil {
+	if res.Error != nil {
+		span.RecordError(res.Error)
+		span.SetStatus(codes.Error, sce.GetName(res.Error))
+	}
+
+	if err := r.logStats(ctx, startTime, &res); err != nil {
 		panic(err)
 	}
 	return res
 }
+
+// runCheckFn runs the check until it returns or ctx is done, whichever comes
+// first. It reports whether the check returned. Checks can't be interrupted:
+// a check which didn't return keeps running in the background, with its client
+// calls failing fast. Once maxAbandonedChecks are running in the background,
+// runCheckFn waits for the check to return instead.
+func runCheckFn(ctx context.Context, fn CheckFn, req *CheckRequest) (CheckResult, bool) {
+	// done is unbuffered so the check either hands its result over, or sees it
+	// was abandoned and frees its slot.
+	done := make(chan CheckResult)
+	abandoned := make(chan struct{})
+	go func() {
+		res := fn(req)
+		select {
+		case done <- res:
+		case <-abandoned:
+			<-abandonedChecks
+		}
+	}()
+	select {
+	case res := <-done:
+		return res, true
+	case <-ctx.Done():
+		select {
+		case res := <-done:
+			return res, true
+		case abandonedChecks <- struct{}{}:
+			close(abandoned)
+			return CheckResult{}, false
+		}
+	}
+}
+
+// cancelledResult returns the result of a check whose context is done,
+// distinguishing its own timeout from the cancellation of the whole run.
+func (r *Runner) cancelledResult(ctx, checkCtx context.Context) CheckResult {
+	if ctx.Err() == nil && errors.Is(checkCtx.Err(), context.DeadlineExceeded) {
+		return CreateTimeoutResult(r.CheckName, r.Timeout)
+	}
+	return CreateRuntimeErrorResult(r.CheckName, sce.WithMessage(sce.ErrCheckRuntime, checkCtx.Err().Error()))
+}
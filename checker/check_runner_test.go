@@ -0,0 +1,157 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+func TestRunnerRun(t *testing.T) {
+	t.Parallel()
+	errStub := errors.New("stub error")
+	tests := []struct {
+		fn        CheckFn
+		wantErr   error
+		name      string
+		timeout   time.Duration
+		wantScore int
+	}{
+		{
+			name: "completes without a timeout",
+			fn: func(*CheckRequest) CheckResult {
+				return CreateMaxScoreResult("Check", "ok")
+			},
+			wantScore: MaxResultScore,
+		},
+		{
+			name:    "completes within the timeout",
+			timeout: time.Minute,
+			fn: func(*CheckRequest) CheckResult {
+				return CreateMaxScoreResult("Check", "ok")
+			},
+			wantScore: MaxResultScore,
+		},
+		{
+			name:    "runtime error",
+			timeout: time.Minute,
+			fn: func(*CheckRequest) CheckResult {
+				return CreateRuntimeErrorResult("Check", errStub)
+			},
+			wantErr:   errStub,
+			wantScore: InconclusiveResultScore,
+		},
+		{
+			name:    "blocked check times out",
+			timeout: 10 * time.Millisecond,
+			fn: func(*CheckRequest) CheckResult {
+				time.Sleep(time.Second)
+				return CreateMaxScoreResult("Check", "ok")
+			},
+			wantErr:   sce.ErrCheckTimeout,
+			wantScore: InconclusiveResultScore,
+		},
+		{
+			name:    "check failing on its cancelled context times out",
+			timeout: 10 * time.Millisecond,
+			fn: func(req *CheckRequest) CheckResult {
+				<-req.Ctx.Done()
+				return CreateRuntimeErrorResult("Check", req.Ctx.Err())
+			},
+			wantErr:   sce.ErrCheckTimeout,
+			wantScore: InconclusiveResultScore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			repo := mockrepo.NewMockRepo(ctrl)
+			repo.EXPECT().Host().Return("github.com").AnyTimes()
+			runner := NewRunner("Check", "github.com/foo/bar", &CheckRequest{
+				Repo:       repo,
+				RepoClient: mockrepo.NewMockRepoClient(ctrl),
+			})
+			runner.SetTimeout(tt.timeout)
+
+			res := runner.Run(context.Background(), Check{Fn: tt.fn})
+			if !errors.Is(res.Error, tt.wantErr) {
+				t.Errorf("Run() error = %v, want %v", res.Error, tt.wantErr)
+			}
+			if res.Score != tt.wantScore {
+				t.Errorf("Run() score = %d, want %d", res.Score, tt.wantScore)
+			}
+			if got, want := res.TimedOut(), errors.Is(tt.wantErr, sce.ErrCheckTimeout); got != want {
+				t.Errorf("TimedOut() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRunnerRun_cancelled(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	repo := mockrepo.NewMockRepo(ctrl)
+	repo.EXPECT().Host().Return("github.com").AnyTimes()
+	runner := NewRunner("Check", "github.com/foo/bar", &CheckRequest{Repo: repo})
+	runner.SetTimeout(time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res := runner.Run(ctx, Check{Fn: func(req *CheckRequest) CheckResult {
+		<-req.Ctx.Done()
+		return CreateRuntimeErrorResult("Check", req.Ctx.Err())
+	}})
+	if !errors.Is(res.Error, sce.ErrCheckRuntime) || res.TimedOut() {
+		t.Errorf("Run() error = %v, want %v", res.Error, sce.ErrCheckRuntime)
+	}
+}
+
+//nolint:paralleltest // Since abandonedChecks is shared.
+func TestRunCheckFn_abandonedChecks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	release := make(chan struct{})
+	blocked := func(*CheckRequest) CheckResult {
+		<-release
+		return CheckResult{}
+	}
+	for range maxAbandonedChecks {
+		if _, completed := runCheckFn(ctx, blocked, &CheckRequest{}); completed {
+			t.Fatal("runCheckFn() completed a blocked check")
+		}
+	}
+
+	// Once the limit is reached, runCheckFn waits for the check to return.
+	returned := func(*CheckRequest) CheckResult {
+		time.Sleep(10 * time.Millisecond)
+		return CheckResult{Score: 1}
+	}
+	if res, completed := runCheckFn(ctx, returned, &CheckRequest{}); !completed || res.Score != 1 {
+		t.Errorf("runCheckFn() = %v, %v, want the result of the check", res, completed)
+	}
+
+	close(release)
+	for len(abandonedChecks) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+}
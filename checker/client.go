@@ -17,7 +17,6 @@ package checker
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/ossf/scorecard/v5/clients"
 	azdorepo "github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
@@ -58,7 +57,6 @@ func GetClients(ctx context.Context, repoURI, localURI string, logger *log.Logge
 			retErr
 	}
 
-	_, experimental := os.LookupEnv("SCORECARD_EXPERIMENTAL")
 	var repoClient clients.RepoClient
 
 	repo, makeRepoError = glrepo.MakeGitlabRepo(repoURI)
@@ -66,7 +64,7 @@ func GetClients(ctx context.Context, repoURI, localURI string, logger *log.Logge
 		repoClient, makeRepoError = glrepo.CreateGitlabClient(ctx, repo.Host())
 	}
 
-	if experimental && (makeRepoError != nil || repo == nil) {
+	if makeRepoError != nil || repo == nil {
 		repo, makeRepoError = azdorepo.MakeAzureDevOpsRepo(repoURI)
 		if repo != nil && makeRepoError == nil {
 			repoClient, makeRepoError = azdorepo.CreateAzureDevOpsClient(ctx, repo)
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/config"
 	"github.com/ossf/scorecard/v5/finding"
 )
 
@@ -62,7 +63,26 @@ type RevisionCIInfo struct {
 }
 
 type CITestData struct {
+	// Config is the CI-Tests configuration of the repository's scorecard.yml.
+	Config config.CITests
 	CIInfo []RevisionCIInfo
+	// TestCommands are the commands of the CI configuration files which run tests.
+	TestCommands []CITestCommand
+	// ConfigFiles are the CI configuration files of the repository.
+	ConfigFiles []File
+	// PullRequestsUnavailable is whether the merged pull requests can't be
+	// listed, e.g. for local scans.
+	PullRequestsUnavailable bool
+}
+
+// CITestCommand is a command of a CI configuration file which runs tests.
+type CITestCommand struct {
+	// CISystem is the CI system configured by the file, e.g. "Jenkins".
+	CISystem string
+	// Job is the name of the job running the command, if known.
+	Job     string
+	Command string
+	File    File
 }
 
 // FuzzingData represents different fuzzing done.
@@ -106,7 +126,8 @@ type DependencyUseType string
 const (
 	// DependencyUseTypeGHAction is an action.
 	DependencyUseTypeGHAction DependencyUseType = "GitHubAction"
-	// DependencyUseTypeDockerfileContainerImage a container image used via FROM.
+	// DependencyUseTypeDockerfileContainerImage a container image used via FROM,
+	// or by a CI pipeline.
 	DependencyUseTypeDockerfileContainerImage DependencyUseType = "containerImage"
 	// DependencyUseTypeDownloadThenRun is a download followed by a run.
 	DependencyUseTypeDownloadThenRun DependencyUseType = "downloadThenRun"
@@ -120,6 +141,25 @@ const (
 	DependencyUseTypePipCommand DependencyUseType = "pipCommand"
 	// DependencyUseTypeNugetCommand is a nuget command.
 	DependencyUseTypeNugetCommand DependencyUseType = "nugetCommand"
+	// DependencyUseTypeAzurePipelinesTask is an Azure Pipelines task.
+	DependencyUseTypeAzurePipelinesTask DependencyUseType = "azurePipelinesTask"
+	// DependencyUseTypeAzurePipelinesTemplate is an Azure Pipelines template
+	// from another repository.
+	DependencyUseTypeAzurePipelinesTemplate DependencyUseType = "azurePipelinesTemplate"
+	// DependencyUseTypeComposeImage is a container image used by a Docker Compose service.
+	DependencyUseTypeComposeImage DependencyUseType = "composeImage"
+	// DependencyUseTypeKubernetesImage is a container image used by a Kubernetes manifest.
+	DependencyUseTypeKubernetesImage DependencyUseType = "kubernetesImage"
+	// DependencyUseTypeHelmImage is a container image set in the values of a Helm chart.
+	DependencyUseTypeHelmImage DependencyUseType = "helmImage"
+	// DependencyUseTypeHelmChart is a dependency of a Helm chart.
+	DependencyUseTypeHelmChart DependencyUseType = "helmChart"
+	// DependencyUseTypeTerraformModule is a Terraform module from another repository or a registry.
+	DependencyUseTypeTerraformModule DependencyUseType = "terraformModule"
+	// DependencyUseTypeTerraformProvider is a Terraform provider.
+	DependencyUseTypeTerraformProvider DependencyUseType = "terraformProvider"
+	// DependencyUseTypeDevcontainerImage is the container image of a development container.
+	DependencyUseTypeDevcontainerImage DependencyUseType = "devcontainerImage"
 )
 
 // PinningDependenciesData represents pinned dependency data.
@@ -138,9 +178,15 @@ type Dependency struct {
 	Msg         *string // Only for debug messages.
 	Pinned      *bool
 	Remediation *finding.Remediation
-	Type        DependencyUseType
+	// Caller is a call of the composite action using the dependency, if any.
+	Caller *WorkflowCall
+	Type   DependencyUseType
 }
 
+// DefaultMaintainedLookBackDays is the number of days of activity
+// considered by the Maintained check unless configured otherwise.
+const DefaultMaintainedLookBackDays = 90
+
 // MaintainedData contains the raw results
 // for the Maintained check.
 type MaintainedData struct {
@@ -148,6 +194,21 @@ type MaintainedData struct {
 	Issues               []clients.Issue
 	DefaultBranchCommits []clients.Commit
 	ArchivedStatus       ArchivedStatus
+	// PullRequests are the most recently created pull requests.
+	// They are only listed on forges which support it.
+	PullRequests []clients.PullRequest
+	Releases     []clients.Release
+	// LookBackDays is the number of days of activity considered.
+	LookBackDays int
+}
+
+// GetLookBackDays returns the number of days of activity considered,
+// falling back to DefaultMaintainedLookBackDays if it isn't set.
+func (m *MaintainedData) GetLookBackDays() int {
+	if m.LookBackDays <= 0 {
+		return DefaultMaintainedLookBackDays
+	}
+	return m.LookBackDays
 }
 
 type LicenseAttributionType string
@@ -177,19 +238,106 @@ type LicenseFile struct {
 // for the License check.
 // Some repos may have more than one license.
 type LicenseData struct {
-	LicenseFiles []LicenseFile
+	// SourceLicenses is the result of the deep license scan of the source
+	// files, nil unless the scan is enabled.
+	SourceLicenses *SourceLicenseData
+	LicenseFiles   []LicenseFile
+}
+
+// SourceLicenseSource is where the license of a source file is declared.
+type SourceLicenseSource string
+
+const (
+	// SourceLicenseSourceHeader is an SPDX-License-Identifier header in the file.
+	SourceLicenseSourceHeader SourceLicenseSource = "header"
+	// SourceLicenseSourceSidecar is a REUSE `<file>.license` file.
+	SourceLicenseSourceSidecar SourceLicenseSource = "sidecar"
+	// SourceLicenseSourceReuseToml is an annotation of a REUSE.toml file.
+	SourceLicenseSourceReuseToml SourceLicenseSource = "REUSE.toml"
+	// SourceLicenseSourceDep5 is a paragraph of a .reuse/dep5 file.
+	SourceLicenseSourceDep5 SourceLicenseSource = "dep5"
+)
+
+// SourceLicense is the license declared for a source file.
+type SourceLicense struct {
+	// File is the licensed file. For headers, Offset is the line of the header.
+	File File
+	// Declaration is the file declaring the license: the file itself for
+	// headers, or the sidecar or REUSE file.
+	Declaration string
+	// Expression is the SPDX license expression, e.g. `MIT OR Apache-2.0`.
+	Expression string
+	Source     SourceLicenseSource
+}
+
+// LicenseConflict is a source file whose license is incompatible with the
+// license of the project.
+type LicenseConflict struct {
+	SourceLicense
+	// ProjectLicense is the SPDX identifier of the license of the project.
+	ProjectLicense string
+}
+
+// SourceLicenseData contains the licenses declared by the source files of
+// the repository, with SPDX-License-Identifier headers or REUSE metadata.
+type SourceLicenseData struct {
+	Licenses []SourceLicense
+	// UnlicensedFiles are the source files without a license declaration.
+	UnlicensedFiles []File
+	Conflicts       []LicenseConflict
+	// ScannedFiles is the number of source files scanned.
+	ScannedFiles int
+	// REUSE is set when the repository has a .reuse/dep5 or REUSE.toml file.
+	REUSE bool
 }
 
 // SBOM details.
 type SBOM struct {
 	Name string // SBOM Filename
 	File File   // SBOM File Object
+	// Document is the parsed content of the SBOM, nil for SBOMs which aren't parsed
+	// (release assets) or couldn't be parsed (see ParseError).
+	Document   *SBOMDocument
+	ParseError string
+}
+
+// SBOMDocument contains the NTIA minimum elements of an SBOM document.
+// https://www.ntia.gov/report/2021/minimum-elements-software-bill-materials-sbom
+type SBOMDocument struct {
+	// Format is either "SPDX" or "CycloneDX".
+	Format      string
+	SpecVersion string
+	// Authors of the SBOM data, including the tools which generated it.
+	Authors   []string
+	Timestamp string
+	// Components lists the components described by the SBOM.
+	Components []SBOMComponent
+	// Relationships is the number of dependency relationships between components.
+	Relationships int
+	// MissingDependencies lists the dependencies declared by the repository's
+	// lockfiles and manifests which the SBOM doesn't list.
+	MissingDependencies []string
+	// Quality is the share of the NTIA minimum elements the SBOM provides,
+	// between 0 and 10.
+	Quality int
+}
+
+// SBOMComponent is a component described by an SBOM.
+type SBOMComponent struct {
+	Name     string
+	Version  string
+	Supplier string
+	// Identifiers are the package URLs and CPEs of the component.
+	Identifiers []string
 }
 
 // SBOMData contains the raw results for the SBOM check.
 // Some repos may have more than one SBOM.
 type SBOMData struct {
 	SBOMFiles []SBOM
+	// DeclaredDependencies lists the names of the dependencies declared by the
+	// lockfiles and manifests of the repository, if an SBOM was parsed.
+	DeclaredDependencies []string
 }
 
 // CodeReviewData contains the raw results
@@ -214,11 +362,51 @@ type Changeset struct {
 	Commits        []clients.Commit
 	Reviews        []clients.Review
 	Author         clients.User
+	// The fields below are only set for GitHub changesets.
+	MergedBy       clients.User
+	HeadSHA        string
+	ReviewDecision string
+	CreatedAt      time.Time
+	MergedAt       time.Time
+	// CoAuthors are the logins, or names if unknown, of the people other
+	// than Author who authored or co-authored commits of the changeset.
+	CoAuthors []string
 }
 
 // ContributorsData represents contributor information.
 type ContributorsData struct {
 	Users []clients.User
+	// Activity is nil if the repo client cannot list commits.
+	Activity *ContributorActivity
+}
+
+// ContributorActivity describes how recent changes are spread across people.
+type ContributorActivity struct {
+	Since time.Time
+	Until time.Time
+	// Authors are sorted by the number of changes, in descending order.
+	Authors []AuthorActivity
+	// Reviewers and Mergers are the distinct non-bot users who approved or
+	// merged the changes.
+	Reviewers []string
+	Mergers   []string
+	// DepartedContributors are top authors with no change in the
+	// departure window preceding Until.
+	DepartedContributors []AuthorActivity
+	Changes              int
+	// BusFactor50 and BusFactor80 are the minimum number of authors
+	// responsible for 50% and 80% of the changes.
+	BusFactor50 int
+	BusFactor80 int
+}
+
+// AuthorActivity is the activity of a single author over recent changes.
+type AuthorActivity struct {
+	FirstChange  time.Time
+	LastChange   time.Time
+	Login        string
+	Organization string
+	Changes      int
 }
 
 // VulnerabilitiesData contains the raw results
@@ -234,6 +422,12 @@ const (
 	SecurityPolicyInformationTypeEmail SecurityPolicyInformationType = "emailAddress"
 	SecurityPolicyInformationTypeLink  SecurityPolicyInformationType = "httpLink"
 	SecurityPolicyInformationTypeText  SecurityPolicyInformationType = "vulnDisclosureText"
+	// SecurityPolicyInformationTypeSupportedVersions is a table or
+	// section listing the versions receiving security updates.
+	SecurityPolicyInformationTypeSupportedVersions SecurityPolicyInformationType = "supportedVersions"
+	// SecurityPolicyInformationTypeResponseTimeline is a stated time
+	// frame for responding to or fixing a report.
+	SecurityPolicyInformationTypeResponseTimeline SecurityPolicyInformationType = "responseTimeline"
 )
 
 type SecurityPolicyValueType struct {
@@ -257,8 +451,11 @@ type SecurityPolicyFile struct {
 // SASTData contains the raw results
 // for the SAST check.
 type SASTData struct {
-	Workflows    []SASTWorkflow
-	Commits      []SASTCommit
+	Workflows []SASTWorkflow
+	Commits   []SASTCommit
+	// Languages are the prominent languages of the repository
+	// which at least one of the SAST tools we detect analyzes.
+	Languages    []clients.LanguageName
 	NumWorkflows int
 }
 
@@ -286,18 +483,38 @@ const (
 	PysaWorkflow SASTWorkflowType = "Pysa"
 	// QodanaWorkflow represents a workflow that runs Qodana.
 	QodanaWorkflow SASTWorkflowType = "Qodana"
+	// MicrosoftSecurityDevOpsWorkflow represents a pipeline that runs Microsoft Security DevOps.
+	MicrosoftSecurityDevOpsWorkflow SASTWorkflowType = "MicrosoftSecurityDevOps"
 )
 
 // SASTWorkflow represents a SAST workflow.
 type SASTWorkflow struct {
 	Type SASTWorkflowType
 	File File
+	// Languages are the languages the tool analyzes.
+	Languages []clients.LanguageName
 }
 
 // SecurityPolicyData contains the raw results
 // for the Security-Policy check.
 type SecurityPolicyData struct {
-	PolicyFiles []SecurityPolicyFile
+	// SecurityTxt is the security.txt file of the repository, if any.
+	SecurityTxt *SecurityTxt
+	// PrivateVulnerabilityReporting is whether vulnerabilities can be
+	// reported privately through the forge. It is nil if unknown.
+	PrivateVulnerabilityReporting *bool
+	PolicyFiles                   []SecurityPolicyFile
+}
+
+// SecurityTxt is a security.txt file as defined by RFC 9116.
+type SecurityTxt struct {
+	// Expires is the raw value of the Expires field.
+	Expires  string
+	Contacts []string
+	Policies []string
+	File     File
+	// Signed is whether the file is wrapped in an OpenPGP signature.
+	Signed bool
 }
 
 // BinaryArtifactData contains the raw results
@@ -319,6 +536,45 @@ type SignedReleasesData struct {
 type DependencyUpdateToolData struct {
 	// Tools contains a list of tools.
 	Tools []Tool
+	// Configs contains the configurations of the tools found in the repository.
+	Configs []DependencyUpdateConfig
+	// Manifests contains the dependency manifests found in the repository.
+	Manifests []DependencyManifest
+}
+
+// DependencyUpdateConfig is the configuration of a dependency update tool.
+type DependencyUpdateConfig struct {
+	// Tool is the name of the configured tool.
+	Tool string
+	File File
+	// Updates lists the dependencies the tool updates.
+	Updates []DependencyUpdate
+	// IgnorePaths lists the paths the tool does not update, as glob patterns
+	// or path fragments.
+	IgnorePaths []string
+	// Disabled is true if the configuration turns the tool off.
+	Disabled bool
+}
+
+// DependencyUpdate is a set of dependencies updated by a tool.
+type DependencyUpdate struct {
+	// Ecosystem updated, or empty for every ecosystem the tool supports.
+	Ecosystem string
+	// Directories updated, as glob patterns, or empty for the whole repository.
+	Directories []string
+	// Offset of the update in the configuration file.
+	Offset uint
+	// Disabled is true if the tool opens no pull request for these dependencies.
+	Disabled bool
+}
+
+// DependencyManifest is a file declaring the dependencies of an ecosystem.
+type DependencyManifest struct {
+	// Ecosystem of the dependencies, named after Dependabot's `package-ecosystem`.
+	Ecosystem string
+	// Directory the update tools look for the manifest in, starting with `/`.
+	Directory string
+	File      File
 }
 
 // WebhooksData contains the raw results
@@ -385,6 +641,21 @@ const (
 	DangerousWorkflowScriptInjection DangerousWorkflowType = "scriptInjection"
 	// DangerousWorkflowUntrustedCheckout represents an untrusted checkout.
 	DangerousWorkflowUntrustedCheckout DangerousWorkflowType = "untrustedCheckout"
+	// DangerousWorkflowArtifactPoisoning represents a cache or artifact which may be written
+	// by untrusted code and used by a privileged workflow.
+	DangerousWorkflowArtifactPoisoning DangerousWorkflowType = "artifactPoisoning"
+	// DangerousWorkflowSecretsInherit represents a third-party reusable workflow inheriting secrets.
+	DangerousWorkflowSecretsInherit DangerousWorkflowType = "secretsInherit"
+	// DangerousWorkflowSelfHostedRunner represents a self-hosted runner running pull requests.
+	DangerousWorkflowSelfHostedRunner DangerousWorkflowType = "selfHostedRunner"
+	// DangerousWorkflowEnvironmentFileInjection represents untrusted data written to
+	// GITHUB_ENV, GITHUB_OUTPUT or GITHUB_PATH.
+	DangerousWorkflowEnvironmentFileInjection DangerousWorkflowType = "environmentFileInjection"
+	// DangerousWorkflowUnguardedIssueComment represents an issue_comment workflow which runs
+	// for comments of anyone.
+	DangerousWorkflowUnguardedIssueComment DangerousWorkflowType = "unguardedIssueComment"
+	// DangerousWorkflowGitHubScriptInjection represents a script injection in actions/github-script.
+	DangerousWorkflowGitHubScriptInjection DangerousWorkflowType = "githubScriptInjection"
 )
 
 // DangerousWorkflowData contains raw results
@@ -396,9 +667,20 @@ type DangerousWorkflowData struct {
 
 // DangerousWorkflow represents a dangerous workflow.
 type DangerousWorkflow struct {
-	Job  *WorkflowJob
-	Type DangerousWorkflowType
+	Job *WorkflowJob
+	// Caller is the call of the reusable workflow or composite action of File, if the
+	// dangerous pattern depends on it.
+	Caller *WorkflowCall
+	Type   DangerousWorkflowType
+	File   File
+}
+
+// WorkflowCall represents the call of a local reusable workflow or composite action.
+type WorkflowCall struct {
+	// File is the location of the `uses` of the call.
 	File File
+	// InheritSecrets is true if the secrets of the caller are available to the callee.
+	InheritSecrets bool
 }
 
 // WorkflowJob represents a workflow job.
@@ -28,6 +28,7 @@ const CheckCITests = "CI-Tests"
 //nolint:gochecknoinits
 func init() {
 	supportedRequestTypes := []checker.RequestType{
+		checker.FileBased,
 		checker.CommitBased,
 	}
 	if err := registerCheck(CheckCITests, CITests, supportedRequestTypes); err != nil {
@@ -37,7 +38,7 @@ func init() {
 }
 
 func CITests(c *checker.CheckRequest) checker.CheckResult {
-	rawData, err := raw.CITests(c.RepoClient)
+	rawData, err := raw.CITests(c)
 	if err != nil {
 		e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		return checker.CreateRuntimeErrorResult(CheckCITests, e)
@@ -166,6 +166,7 @@ func TestContributors(t *testing.T) {
 				}
 				return tt.contrib, nil
 			})
+			mockRepo.EXPECT().ListCommits().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
 
 			req := checker.CheckRequest{
 				RepoClient: mockRepo,
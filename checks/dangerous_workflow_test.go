@@ -79,7 +79,8 @@ func TestDangerousWorkflow(t *testing.T) {
 			dl := scut.TestDetailLogger{}
 			ctrl := gomock.NewController(t)
 			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
-			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.workflowPaths, nil)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.workflowPaths, nil).AnyTimes()
+			mockRepoClient.EXPECT().IsPrivate().Return(false, nil).AnyTimes()
 			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
 				return os.Open("./testdata/" + file)
 			}).AnyTimes()
@@ -15,6 +15,8 @@
 package checks
 
 import (
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -27,6 +29,14 @@ import (
 
 const (
 	dependabotID = 49699333
+
+	dependabotConfig = `version: 2
+updates:
+  - package-ecosystem: github-actions
+    directory: /
+    schedule:
+      interval: weekly
+`
 )
 
 // TestDependencyUpdateTool tests the DependencyUpdateTool checker.
@@ -37,6 +47,7 @@ func TestDependencyUpdateTool(t *testing.T) {
 		want              checker.CheckResult
 		SearchCommits     []clients.Commit
 		files             []string
+		contents          map[string]string
 		expected          scut.TestReturn
 		CallSearchCommits int
 		wantErr           bool
@@ -47,11 +58,15 @@ func TestDependencyUpdateTool(t *testing.T) {
 			files: []string{
 				".github/dependabot.yml",
 			},
+			contents: map[string]string{
+				".github/dependabot.yml": dependabotConfig,
+			},
 			CallSearchCommits: 0,
 			expected: scut.TestReturn{
-				NumberOfInfo: 1,
-				NumberOfWarn: 0,
-				Score:        10,
+				NumberOfInfo:  2,
+				NumberOfWarn:  0,
+				NumberOfDebug: 1,
+				Score:         10,
 			},
 		},
 		{
@@ -60,11 +75,15 @@ func TestDependencyUpdateTool(t *testing.T) {
 			files: []string{
 				".github/dependabot.yaml",
 			},
+			contents: map[string]string{
+				".github/dependabot.yaml": dependabotConfig,
+			},
 			CallSearchCommits: 0,
 			expected: scut.TestReturn{
-				NumberOfInfo: 1,
-				NumberOfWarn: 0,
-				Score:        10,
+				NumberOfInfo:  2,
+				NumberOfWarn:  0,
+				NumberOfDebug: 1,
+				Score:         10,
 			},
 		},
 		{
@@ -75,9 +94,10 @@ func TestDependencyUpdateTool(t *testing.T) {
 			},
 			CallSearchCommits: 0,
 			expected: scut.TestReturn{
-				NumberOfInfo: 1,
-				NumberOfWarn: 0,
-				Score:        10,
+				NumberOfInfo:  2,
+				NumberOfWarn:  0,
+				NumberOfDebug: 1,
+				Score:         10,
 			},
 		},
 		{
@@ -88,9 +108,10 @@ func TestDependencyUpdateTool(t *testing.T) {
 			},
 			CallSearchCommits: 0,
 			expected: scut.TestReturn{
-				NumberOfInfo: 1,
-				NumberOfWarn: 0,
-				Score:        10,
+				NumberOfInfo:  2,
+				NumberOfWarn:  0,
+				NumberOfDebug: 1,
+				Score:         10,
 			},
 		},
 		{
@@ -100,9 +121,45 @@ func TestDependencyUpdateTool(t *testing.T) {
 				".pyup.yml",
 			},
 			CallSearchCommits: 0,
+			expected: scut.TestReturn{
+				NumberOfInfo:  2,
+				NumberOfWarn:  0,
+				NumberOfDebug: 1,
+				Score:         10,
+			},
+		},
+		{
+			name:    "dependabot config missing an ecosystem",
+			wantErr: false,
+			files: []string{
+				".github/dependabot.yml",
+				".github/workflows/ci.yml",
+				"go.mod",
+			},
+			contents: map[string]string{
+				".github/dependabot.yml": dependabotConfig,
+			},
+			CallSearchCommits: 0,
+			expected: scut.TestReturn{
+				NumberOfInfo: 3,
+				NumberOfWarn: 1,
+				Score:        10,
+			},
+		},
+		{
+			name:    "renovate disabled",
+			wantErr: false,
+			files: []string{
+				"renovate.json",
+				"go.mod",
+			},
+			contents: map[string]string{
+				"renovate.json": `{"enabled": false}`,
+			},
+			CallSearchCommits: 0,
 			expected: scut.TestReturn{
 				NumberOfInfo: 1,
-				NumberOfWarn: 0,
+				NumberOfWarn: 2,
 				Score:        10,
 			},
 		},
@@ -113,7 +170,8 @@ func TestDependencyUpdateTool(t *testing.T) {
 			SearchCommits:     []clients.Commit{{Committer: clients.User{ID: 111111111}}},
 			CallSearchCommits: 1,
 			expected: scut.TestReturn{
-				NumberOfWarn: 1,
+				NumberOfWarn:  1,
+				NumberOfDebug: 2,
 			},
 		},
 		{
@@ -125,7 +183,8 @@ func TestDependencyUpdateTool(t *testing.T) {
 			SearchCommits:     []clients.Commit{},
 			CallSearchCommits: 1,
 			expected: scut.TestReturn{
-				NumberOfWarn: 1,
+				NumberOfWarn:  1,
+				NumberOfDebug: 2,
 			},
 		},
 		{
@@ -137,9 +196,10 @@ func TestDependencyUpdateTool(t *testing.T) {
 			SearchCommits:     []clients.Commit{{Committer: clients.User{ID: dependabotID}}},
 			CallSearchCommits: 1,
 			expected: scut.TestReturn{
-				NumberOfInfo: 1,
-				NumberOfWarn: 0,
-				Score:        10,
+				NumberOfInfo:  1,
+				NumberOfWarn:  0,
+				NumberOfDebug: 2,
+				Score:         10,
 			},
 		},
 		{
@@ -152,9 +212,10 @@ func TestDependencyUpdateTool(t *testing.T) {
 			},
 			CallSearchCommits: 1,
 			expected: scut.TestReturn{
-				NumberOfInfo: 1,
-				NumberOfWarn: 0,
-				Score:        10,
+				NumberOfInfo:  1,
+				NumberOfWarn:  0,
+				NumberOfDebug: 2,
+				Score:         10,
 			},
 		},
 	}
@@ -164,6 +225,9 @@ func TestDependencyUpdateTool(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockRepo := mockrepo.NewMockRepoClient(ctrl)
 			mockRepo.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil)
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(name string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(tt.contents[name])), nil
+			}).AnyTimes()
 			mockRepo.EXPECT().SearchCommits(gomock.Any()).Return(tt.SearchCommits, nil).Times(tt.CallSearchCommits)
 			dl := scut.TestDetailLogger{}
 			c := &checker.CheckRequest{
@@ -20,6 +20,7 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/ciConfigRunsTests"
 	"github.com/ossf/scorecard/v5/probes/testsRunInCI"
 )
 
@@ -31,12 +32,32 @@ func CITests(name string,
 ) checker.CheckResult {
 	expectedProbes := []string{
 		testsRunInCI.Probe,
+		ciConfigRunsTests.Probe,
 	}
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
 		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
 		return checker.CreateRuntimeErrorResult(name, e)
 	}
 
+	var prFindings, configFindings []finding.Finding
+	for i := range findings {
+		f := &findings[i]
+		if f.Probe == ciConfigRunsTests.Probe {
+			configFindings = append(configFindings, *f)
+			switch f.Outcome {
+			case finding.OutcomeTrue:
+				checker.LogFinding(dl, f, checker.DetailInfo)
+			case finding.OutcomeFalse:
+				checker.LogFinding(dl, f, checker.DetailWarn)
+			default:
+				checker.LogFinding(dl, f, checker.DetailDebug)
+			}
+			continue
+		}
+		prFindings = append(prFindings, *f)
+	}
+	findings = prFindings
+
 	// Debug PRs that were merged without CI tests
 	for i := range findings {
 		f := &findings[i]
@@ -47,6 +68,11 @@ func CITests(name string,
 		}
 	}
 
+	// Without pull requests, e.g. for local scans, rely on the CI configuration.
+	if pullRequestsUnavailable(findings) {
+		return ciConfigResult(name, configFindings)
+	}
+
 	// check that the project has pull requests
 	if noPullRequestsFound(findings) {
 		return checker.CreateInconclusiveResult(CheckCITests, "no pull request found")
@@ -78,6 +104,27 @@ func getMergedAndTested(findings []finding.Finding) (int, int) {
 	return totalMerged, totalTested
 }
 
+func pullRequestsUnavailable(findings []finding.Finding) bool {
+	for i := range findings {
+		if findings[i].Outcome == finding.OutcomeNotAvailable {
+			return true
+		}
+	}
+	return false
+}
+
+func ciConfigResult(name string, findings []finding.Finding) checker.CheckResult {
+	// The probe returns a single finding unless the configuration runs tests.
+	switch findings[0].Outcome {
+	case finding.OutcomeTrue:
+		return checker.CreateMaxScoreResult(name, "CI configuration runs tests")
+	case finding.OutcomeFalse:
+		return checker.CreateMinScoreResult(name, "CI configuration does not run tests")
+	default:
+		return checker.CreateInconclusiveResult(name, "no CI configuration found")
+	}
+}
+
 func noPullRequestsFound(findings []finding.Finding) bool {
 	for i := range findings {
 		f := &findings[i]
@@ -16,6 +16,7 @@ package evaluation
 import (
 	"testing"
 
+	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/finding"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
@@ -38,10 +39,15 @@ func TestCITests(t *testing.T) {
 					Message:  "CI test found: pr: 1, context: e2e",
 					Location: &finding.Location{Type: 4},
 				},
+				{
+					Outcome: finding.OutcomeNotApplicable,
+					Probe:   "ciConfigRunsTests",
+					Message: "no CI configuration file found",
+				},
 			},
 			result: scut.TestReturn{
 				Score:         10,
-				NumberOfDebug: 1,
+				NumberOfDebug: 2,
 			},
 		},
 		{
@@ -71,10 +77,15 @@ func TestCITests(t *testing.T) {
 					Message:  "CI test found: pr: 1, context: e2e",
 					Location: &finding.Location{Type: 4},
 				},
+				{
+					Outcome: finding.OutcomeNotApplicable,
+					Probe:   "ciConfigRunsTests",
+					Message: "no CI configuration file found",
+				},
 			},
 			result: scut.TestReturn{
 				Score:         7,
-				NumberOfDebug: 4,
+				NumberOfDebug: 5,
 			},
 		},
 		{
@@ -98,12 +109,74 @@ func TestCITests(t *testing.T) {
 					Message:  "merged PR 1 without CI test at HEAD: 1",
 					Location: &finding.Location{Type: 4},
 				},
+				{
+					Outcome: finding.OutcomeNotApplicable,
+					Probe:   "ciConfigRunsTests",
+					Message: "no CI configuration file found",
+				},
 			},
 			result: scut.TestReturn{
-				NumberOfDebug: 3,
+				NumberOfDebug: 4,
 				Score:         0,
 			},
 		},
+		{
+			name: "Local scan with a CI configuration running tests",
+			findings: []finding.Finding{
+				{
+					Outcome: finding.OutcomeNotAvailable,
+					Probe:   "testsRunInCI",
+					Message: "pull requests are not available",
+				},
+				{
+					Outcome: finding.OutcomeTrue,
+					Probe:   "ciConfigRunsTests",
+					Message: "GitHub Actions job 'unit' runs tests with 'go test'",
+				},
+			},
+			result: scut.TestReturn{
+				Score:        10,
+				NumberOfInfo: 1,
+			},
+		},
+		{
+			name: "Local scan with a CI configuration not running tests",
+			findings: []finding.Finding{
+				{
+					Outcome: finding.OutcomeNotAvailable,
+					Probe:   "testsRunInCI",
+					Message: "pull requests are not available",
+				},
+				{
+					Outcome: finding.OutcomeFalse,
+					Probe:   "ciConfigRunsTests",
+					Message: "CI configuration files don't run tests",
+				},
+			},
+			result: scut.TestReturn{
+				Score:        0,
+				NumberOfWarn: 1,
+			},
+		},
+		{
+			name: "Local scan without a CI configuration",
+			findings: []finding.Finding{
+				{
+					Outcome: finding.OutcomeNotAvailable,
+					Probe:   "testsRunInCI",
+					Message: "pull requests are not available",
+				},
+				{
+					Outcome: finding.OutcomeNotApplicable,
+					Probe:   "ciConfigRunsTests",
+					Message: "no CI configuration file found",
+				},
+			},
+			result: scut.TestReturn{
+				Score:         checker.InconclusiveResultScore,
+				NumberOfDebug: 1,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -18,18 +18,38 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowArtifactPoisoning"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowEnvironmentFileInjection"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowGitHubScriptInjection"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowScriptInjection"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowSecretsInherit"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowSelfHostedRunner"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowUnguardedIssueComment"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowUntrustedCheckout"
 )
 
+// Patterns which fail the check.
+var scoredDangerousWorkflowProbes = []string{
+	hasDangerousWorkflowScriptInjection.Probe,
+	hasDangerousWorkflowUntrustedCheckout.Probe,
+}
+
+// Patterns which are only logged, without affecting the score.
+var informationalDangerousWorkflowProbes = []string{
+	hasDangerousWorkflowArtifactPoisoning.Probe,
+	hasDangerousWorkflowEnvironmentFileInjection.Probe,
+	hasDangerousWorkflowGitHubScriptInjection.Probe,
+	hasDangerousWorkflowSecretsInherit.Probe,
+	hasDangerousWorkflowSelfHostedRunner.Probe,
+	hasDangerousWorkflowUnguardedIssueComment.Probe,
+}
+
 // DangerousWorkflow applies the score policy for the DangerousWorkflow check.
 func DangerousWorkflow(name string,
 	findings []finding.Finding, dl checker.DetailLogger,
 ) checker.CheckResult {
-	expectedProbes := []string{
-		hasDangerousWorkflowScriptInjection.Probe,
-		hasDangerousWorkflowUntrustedCheckout.Probe,
-	}
+	expectedProbes := append(append([]string{}, scoredDangerousWorkflowProbes...),
+		informationalDangerousWorkflowProbes...)
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
 		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
@@ -52,16 +72,18 @@ func DangerousWorkflow(name string,
 		}
 	}
 
-	if hasDWWithUntrustedCheckout(findings) || hasDWWithScriptInjection(findings) {
-		return checker.CreateMinScoreResult(name,
-			"dangerous workflow patterns detected")
+	for _, probe := range scoredDangerousWorkflowProbes {
+		if hasDWWithPattern(findings, probe) {
+			return checker.CreateMinScoreResult(name,
+				"dangerous workflow patterns detected")
+		}
 	}
 
 	return checker.CreateMaxScoreResult(name,
 		"no dangerous workflow patterns detected")
 }
 
-// Both probes return OutcomeNotApplicable, if there project has no workflows.
+// All probes return OutcomeNotApplicable, if there project has no workflows.
 func hasWorkflows(findings []finding.Finding) bool {
 	for i := range findings {
 		f := &findings[i]
@@ -72,25 +94,11 @@ func hasWorkflows(findings []finding.Finding) bool {
 	return true
 }
 
-func hasDWWithUntrustedCheckout(findings []finding.Finding) bool {
+func hasDWWithPattern(findings []finding.Finding, probe string) bool {
 	for i := range findings {
 		f := &findings[i]
-		if f.Probe == hasDangerousWorkflowUntrustedCheckout.Probe {
-			if f.Outcome == finding.OutcomeTrue {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func hasDWWithScriptInjection(findings []finding.Finding) bool {
-	for i := range findings {
-		f := &findings[i]
-		if f.Probe == hasDangerousWorkflowScriptInjection.Probe {
-			if f.Outcome == finding.OutcomeTrue {
-				return true
-			}
+		if f.Probe == probe && f.Outcome == finding.OutcomeTrue {
+			return true
 		}
 	}
 	return false
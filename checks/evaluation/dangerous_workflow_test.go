@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/ossf/scorecard/v5/checker"
+	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
@@ -35,7 +36,7 @@ func TestDangerousWorkflow(t *testing.T) {
 	}{
 		{
 			name: "Has untrusted checkout workflow",
-			findings: []finding.Finding{
+			findings: withDWProbes(finding.OutcomeFalse, []finding.Finding{
 				{
 					Probe:   "hasDangerousWorkflowScriptInjection",
 					Outcome: finding.OutcomeFalse,
@@ -49,7 +50,7 @@ func TestDangerousWorkflow(t *testing.T) {
 						Snippet:   &testSnippet,
 					},
 				},
-			},
+			}),
 			result: scut.TestReturn{
 				Score:        0,
 				NumberOfWarn: 1,
@@ -57,7 +58,7 @@ func TestDangerousWorkflow(t *testing.T) {
 		},
 		{
 			name: "DangerousWorkflow - no workflows",
-			findings: []finding.Finding{
+			findings: withDWProbes(finding.OutcomeNotApplicable, []finding.Finding{
 				{
 					Probe:   "hasDangerousWorkflowScriptInjection",
 					Outcome: finding.OutcomeNotApplicable,
@@ -65,14 +66,14 @@ func TestDangerousWorkflow(t *testing.T) {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeNotApplicable,
 				},
-			},
+			}),
 			result: scut.TestReturn{
 				Score: checker.InconclusiveResultScore,
 			},
 		},
 		{
 			name: "DangerousWorkflow - found workflows, none dangerous",
-			findings: []finding.Finding{
+			findings: withDWProbes(finding.OutcomeFalse, []finding.Finding{
 				{
 					Probe:   "hasDangerousWorkflowScriptInjection",
 					Outcome: finding.OutcomeFalse,
@@ -80,14 +81,14 @@ func TestDangerousWorkflow(t *testing.T) {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
 				},
-			},
+			}),
 			result: scut.TestReturn{
 				Score: 10,
 			},
 		},
 		{
 			name: "DangerousWorkflow - Script injection detected",
-			findings: []finding.Finding{
+			findings: withDWProbes(finding.OutcomeFalse, []finding.Finding{
 				{
 					Probe:   "hasDangerousWorkflowScriptInjection",
 					Outcome: finding.OutcomeTrue,
@@ -101,7 +102,7 @@ func TestDangerousWorkflow(t *testing.T) {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
 				},
-			},
+			}),
 			result: scut.TestReturn{
 				Score:        0,
 				NumberOfWarn: 1,
@@ -109,7 +110,7 @@ func TestDangerousWorkflow(t *testing.T) {
 		},
 		{
 			name: "2 script injections are both logged",
-			findings: []finding.Finding{
+			findings: withDWProbes(finding.OutcomeFalse, []finding.Finding{
 				{
 					Probe:   "hasDangerousWorkflowScriptInjection",
 					Outcome: finding.OutcomeTrue,
@@ -132,7 +133,7 @@ func TestDangerousWorkflow(t *testing.T) {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
 				},
-			},
+			}),
 			result: scut.TestReturn{
 				Score:        0,
 				NumberOfWarn: 2,
@@ -140,7 +141,7 @@ func TestDangerousWorkflow(t *testing.T) {
 		},
 		{
 			name: "DangerousWorkflow - 8 script injection workflows detected",
-			findings: []finding.Finding{
+			findings: withDWProbes(finding.OutcomeFalse, []finding.Finding{
 				{
 					Probe:   "hasDangerousWorkflowScriptInjection",
 					Outcome: finding.OutcomeTrue,
@@ -217,12 +218,94 @@ func TestDangerousWorkflow(t *testing.T) {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
 				},
-			},
+			}),
 			result: scut.TestReturn{
 				Score:        0,
 				NumberOfWarn: 8,
 			},
 		},
+		{
+			name: "informational patterns don't affect the score",
+			findings: withDWProbes(finding.OutcomeFalse, []finding.Finding{
+				{
+					Probe:   "hasDangerousWorkflowSelfHostedRunner",
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "./github/workflows/ci.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "./github/workflows/release.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "./github/workflows/deploy.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				},
+			}),
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfWarn: 3,
+			},
+		},
+		{
+			name: "github-script injection and unguarded issue comment are logged",
+			findings: withDWProbes(finding.OutcomeFalse, []finding.Finding{
+				{
+					Probe:   "hasDangerousWorkflowGitHubScriptInjection",
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "./github/workflows/triage.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				},
+				{
+					Probe:   "hasDangerousWorkflowUnguardedIssueComment",
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "./github/workflows/deploy.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				},
+			}),
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "missing probes",
+			findings: []finding.Finding{
+				{
+					Probe:   "hasDangerousWorkflowScriptInjection",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowUntrustedCheckout",
+					Outcome: finding.OutcomeFalse,
+				},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+				Error: sce.ErrScorecardInternal,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -233,3 +316,24 @@ func TestDangerousWorkflow(t *testing.T) {
 		})
 	}
 }
+
+// withDWProbes adds a finding with the given outcome for each probe of the check
+// without findings.
+func withDWProbes(outcome finding.Outcome, findings []finding.Finding) []finding.Finding {
+	probes := append(append([]string{}, scoredDangerousWorkflowProbes...), informationalDangerousWorkflowProbes...)
+	for _, probe := range probes {
+		var found bool
+		for i := range findings {
+			if findings[i].Probe == probe {
+				found = true
+			}
+		}
+		if !found {
+			findings = append(findings, finding.Finding{
+				Probe:   probe,
+				Outcome: outcome,
+			})
+		}
+	}
+	return findings
+}
@@ -19,6 +19,8 @@ import (
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolConfigured"
+	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolCoversManifests"
+	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolEnabled"
 )
 
 // DependencyUpdateTool applies the score policy and logs the details
@@ -29,7 +31,13 @@ func DependencyUpdateTool(name string,
 	expectedProbes := []string{
 		dependencyUpdateToolConfigured.Probe,
 	}
-	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+	// The configuration of the tools is reported, but doesn't affect the score.
+	informationalProbes := []string{
+		dependencyUpdateToolCoversManifests.Probe,
+		dependencyUpdateToolEnabled.Probe,
+	}
+	if !finding.UniqueProbesEqual(findings, expectedProbes) &&
+		!finding.UniqueProbesEqual(findings, append(expectedProbes, informationalProbes...)) {
 		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
 		return checker.CreateRuntimeErrorResult(name, e)
 	}
@@ -42,7 +50,9 @@ func DependencyUpdateTool(name string,
 		case finding.OutcomeFalse:
 			logLevel = checker.DetailWarn
 		case finding.OutcomeTrue:
-			usesTool = true
+			if f.Probe == dependencyUpdateToolConfigured.Probe {
+				usesTool = true
+			}
 			logLevel = checker.DetailInfo
 		default:
 			logLevel = checker.DetailDebug
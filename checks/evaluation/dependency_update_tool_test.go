@@ -21,6 +21,8 @@ import (
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolConfigured"
+	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolCoversManifests"
+	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolEnabled"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
 
@@ -65,6 +67,50 @@ func TestDependencyUpdateTool(t *testing.T) {
 				NumberOfWarn: 1,
 			},
 		},
+		{
+			name: "manifests not updated are logged without affecting the score",
+			findings: []finding.Finding{
+				depUpdateTool("Dependabot"),
+				depUpdateCoverage(finding.OutcomeTrue),
+				depUpdateCoverage(finding.OutcomeFalse),
+				depUpdateCoverage(finding.OutcomeFalse),
+				depUpdateEnabled(finding.OutcomeTrue),
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 3,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "disabled update tool is logged without affecting the score",
+			findings: []finding.Finding{
+				depUpdateTool("RenovateBot"),
+				depUpdateCoverage(finding.OutcomeFalse),
+				depUpdateEnabled(finding.OutcomeFalse),
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 1,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "no update tool with informational probes is min score",
+			findings: []finding.Finding{
+				{
+					Probe:   dependencyUpdateToolConfigured.Probe,
+					Outcome: finding.OutcomeFalse,
+				},
+				depUpdateCoverage(finding.OutcomeNotApplicable),
+				depUpdateEnabled(finding.OutcomeNotApplicable),
+			},
+			result: scut.TestReturn{
+				Score:         checker.MinResultScore,
+				NumberOfWarn:  1,
+				NumberOfDebug: 2,
+			},
+		},
 		{
 			name: "invalid probe name is an error",
 			findings: []finding.Finding{
@@ -98,3 +144,17 @@ func depUpdateTool(name string) finding.Finding {
 		},
 	}
 }
+
+func depUpdateCoverage(outcome finding.Outcome) finding.Finding {
+	return finding.Finding{
+		Probe:   dependencyUpdateToolCoversManifests.Probe,
+		Outcome: outcome,
+	}
+}
+
+func depUpdateEnabled(outcome finding.Outcome) finding.Finding {
+	return finding.Finding{
+		Probe:   dependencyUpdateToolEnabled.Probe,
+		Outcome: outcome,
+	}
+}
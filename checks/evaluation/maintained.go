@@ -23,12 +23,16 @@ import (
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/probes/archived"
 	"github.com/ossf/scorecard/v5/probes/createdRecently"
+	"github.com/ossf/scorecard/v5/probes/hasFewStalePullRequests"
 	"github.com/ossf/scorecard/v5/probes/hasRecentCommits"
+	"github.com/ossf/scorecard/v5/probes/hasRecentRelease"
 	"github.com/ossf/scorecard/v5/probes/issueActivityByProjectMember"
+	"github.com/ossf/scorecard/v5/probes/respondsToIssues"
+	"github.com/ossf/scorecard/v5/probes/respondsToPullRequests"
+	"github.com/ossf/scorecard/v5/probes/respondsToSecurityIssues"
 )
 
 const (
-	lookBackDays    = 90
 	activityPerWeek = 1
 	daysInOneWeek   = 7
 )
@@ -37,12 +41,17 @@ const (
 func Maintained(name string,
 	findings []finding.Finding, dl checker.DetailLogger,
 ) checker.CheckResult {
-	// We have 4 unique probes, each should have a finding.
+	// We have 9 unique probes, each should have a finding.
 	expectedProbes := []string{
 		archived.Probe,
 		issueActivityByProjectMember.Probe,
 		hasRecentCommits.Probe,
 		createdRecently.Probe,
+		respondsToIssues.Probe,
+		respondsToPullRequests.Probe,
+		hasFewStalePullRequests.Probe,
+		hasRecentRelease.Probe,
+		respondsToSecurityIssues.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -53,9 +62,31 @@ func Maintained(name string,
 	var isArchived, recentlyCreated bool
 
 	var commitsWithinThreshold, numberOfIssuesUpdatedWithinThreshold int
+	lookBackDays := checker.DefaultMaintainedLookBackDays
 	var err error
 	for i := range findings {
 		f := &findings[i]
+		if f.Probe == hasRecentCommits.Probe {
+			if days, ok := f.Values[hasRecentCommits.LookbackDayKey]; ok {
+				lookBackDays, err = strconv.Atoi(days)
+				if err != nil {
+					return checker.CreateRuntimeErrorResult(name, sce.WithMessage(sce.ErrScorecardInternal, err.Error()))
+				}
+			}
+		}
+		if isResponsivenessProbe(f.Probe) {
+			// Responsiveness findings are logged whatever their outcome,
+			// without affecting the score.
+			switch f.Outcome {
+			case finding.OutcomeTrue:
+				checker.LogFinding(dl, f, checker.DetailInfo)
+			case finding.OutcomeFalse:
+				checker.LogFinding(dl, f, checker.DetailWarn)
+			default:
+				checker.LogFinding(dl, f, checker.DetailDebug)
+			}
+			continue
+		}
 		switch f.Outcome {
 		case finding.OutcomeTrue:
 			switch f.Probe {
@@ -90,12 +121,28 @@ func Maintained(name string,
 	}
 
 	if recentlyCreated {
-		return checker.CreateMinScoreResult(name,
-			"project was created within the last 90 days. Please review its contents carefully")
+		return checker.CreateMinScoreResult(name, fmt.Sprintf(
+			"project was created within the last %d days. Please review its contents carefully", lookBackDays))
 	}
 
-	return checker.CreateProportionalScoreResult(name, fmt.Sprintf(
-		"%d commit(s) and %d issue activity found in the last %d days",
-		commitsWithinThreshold, numberOfIssuesUpdatedWithinThreshold, lookBackDays),
-		commitsWithinThreshold+numberOfIssuesUpdatedWithinThreshold, activityPerWeek*lookBackDays/daysInOneWeek)
+	reason := fmt.Sprintf("%d commit(s) and %d issue activity found in the last %d days",
+		commitsWithinThreshold, numberOfIssuesUpdatedWithinThreshold, lookBackDays)
+	score := checker.CreateProportionalScore(commitsWithinThreshold+numberOfIssuesUpdatedWithinThreshold,
+		activityPerWeek*lookBackDays/daysInOneWeek)
+	return checker.CreateResultWithScore(name, checker.NormalizeReason(reason, score), score)
+}
+
+// isResponsivenessProbe returns whether the probe measures how maintainers
+// respond to their users, rather than the activity of the project.
+func isResponsivenessProbe(probe string) bool {
+	switch probe {
+	case respondsToIssues.Probe,
+		respondsToPullRequests.Probe,
+		hasFewStalePullRequests.Probe,
+		hasRecentRelease.Probe,
+		respondsToSecurityIssues.Probe:
+		return true
+	default:
+		return false
+	}
 }
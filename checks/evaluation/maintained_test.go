@@ -21,11 +21,31 @@ import (
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/probes/archived"
 	"github.com/ossf/scorecard/v5/probes/createdRecently"
+	"github.com/ossf/scorecard/v5/probes/hasFewStalePullRequests"
 	"github.com/ossf/scorecard/v5/probes/hasRecentCommits"
+	"github.com/ossf/scorecard/v5/probes/hasRecentRelease"
 	"github.com/ossf/scorecard/v5/probes/issueActivityByProjectMember"
+	"github.com/ossf/scorecard/v5/probes/respondsToIssues"
+	"github.com/ossf/scorecard/v5/probes/respondsToPullRequests"
+	"github.com/ossf/scorecard/v5/probes/respondsToSecurityIssues"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
 
+// withResponsiveness adds a finding with the given outcome for each
+// responsiveness probe.
+func withResponsiveness(findings []finding.Finding, outcome finding.Outcome) []finding.Finding {
+	for _, probe := range []string{
+		respondsToIssues.Probe,
+		respondsToPullRequests.Probe,
+		hasFewStalePullRequests.Probe,
+		hasRecentRelease.Probe,
+		respondsToSecurityIssues.Probe,
+	} {
+		findings = append(findings, finding.Finding{Probe: probe, Outcome: outcome})
+	}
+	return findings
+}
+
 func TestMaintained(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -35,7 +55,7 @@ func TestMaintained(t *testing.T) {
 	}{
 		{
 			name: "Two commits in last 90 days",
-			findings: []finding.Finding{
+			findings: withResponsiveness([]finding.Finding{
 				{
 					Probe:   hasRecentCommits.Probe,
 					Outcome: finding.OutcomeTrue,
@@ -55,14 +75,15 @@ func TestMaintained(t *testing.T) {
 					Probe:   createdRecently.Probe,
 					Outcome: finding.OutcomeFalse,
 				},
-			},
+			}, finding.OutcomeNotApplicable),
 			result: scut.TestReturn{
-				Score: 2,
+				Score:         2,
+				NumberOfDebug: 5,
 			},
 		},
 		{
 			name: "No issues, no commits and not archived",
-			findings: []finding.Finding{
+			findings: withResponsiveness([]finding.Finding{
 				{
 					Probe:   hasRecentCommits.Probe,
 					Outcome: finding.OutcomeFalse,
@@ -76,14 +97,15 @@ func TestMaintained(t *testing.T) {
 					Probe:   createdRecently.Probe,
 					Outcome: finding.OutcomeFalse,
 				},
-			},
+			}, finding.OutcomeNotApplicable),
 			result: scut.TestReturn{
-				Score: 0,
+				Score:         0,
+				NumberOfDebug: 5,
 			},
 		},
 		{
 			name: "Wrong probe name",
-			findings: []finding.Finding{
+			findings: withResponsiveness([]finding.Finding{
 				{
 					Probe:   hasRecentCommits.Probe,
 					Outcome: finding.OutcomeFalse,
@@ -97,7 +119,7 @@ func TestMaintained(t *testing.T) {
 					Probe:   createdRecently.Probe,
 					Outcome: finding.OutcomeFalse,
 				},
-			},
+			}, finding.OutcomeNotApplicable),
 			result: scut.TestReturn{
 				Score: -1,
 				Error: sce.ErrScorecardInternal,
@@ -105,7 +127,7 @@ func TestMaintained(t *testing.T) {
 		},
 		{
 			name: "Project is archived",
-			findings: []finding.Finding{
+			findings: withResponsiveness([]finding.Finding{
 				{
 					Probe:   hasRecentCommits.Probe,
 					Outcome: finding.OutcomeFalse,
@@ -119,15 +141,16 @@ func TestMaintained(t *testing.T) {
 					Probe:   createdRecently.Probe,
 					Outcome: finding.OutcomeFalse,
 				},
-			},
+			}, finding.OutcomeNotApplicable),
 			result: scut.TestReturn{
-				Score:        0,
-				NumberOfWarn: 1,
+				Score:         0,
+				NumberOfWarn:  1,
+				NumberOfDebug: 5,
 			},
 		},
 		{
 			name: "recently created projects get min score",
-			findings: []finding.Finding{
+			findings: withResponsiveness([]finding.Finding{
 				{
 					Probe:   hasRecentCommits.Probe,
 					Outcome: finding.OutcomeTrue,
@@ -147,10 +170,82 @@ func TestMaintained(t *testing.T) {
 					Probe:   createdRecently.Probe,
 					Outcome: finding.OutcomeTrue,
 				},
+			}, finding.OutcomeNotApplicable),
+			result: scut.TestReturn{
+				Score:         checker.MinResultScore,
+				NumberOfWarn:  1,
+				NumberOfDebug: 5,
+			},
+		},
+		{
+			name: "Two commits and one issue in a 30 days window",
+			findings: withResponsiveness([]finding.Finding{
+				{
+					Probe:   hasRecentCommits.Probe,
+					Outcome: finding.OutcomeTrue,
+					Values: map[string]string{
+						hasRecentCommits.NumCommitsKey:  "2",
+						hasRecentCommits.LookbackDayKey: "30",
+					},
+				}, {
+					Probe:   issueActivityByProjectMember.Probe,
+					Outcome: finding.OutcomeTrue,
+					Values: map[string]string{
+						issueActivityByProjectMember.NumIssuesKey: "1",
+					},
+				}, {
+					Probe:   archived.Probe,
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   createdRecently.Probe,
+					Outcome: finding.OutcomeFalse,
+				},
+			}, finding.OutcomeTrue),
+			result: scut.TestReturn{
+				Score:        7,
+				NumberOfInfo: 5,
+			},
+		},
+		{
+			name: "Unresponsive maintainers don't affect the score",
+			findings: []finding.Finding{
+				{
+					Probe:   hasRecentCommits.Probe,
+					Outcome: finding.OutcomeTrue,
+					Values: map[string]string{
+						hasRecentCommits.NumCommitsKey: "20",
+					},
+				}, {
+					Probe:   issueActivityByProjectMember.Probe,
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   archived.Probe,
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   createdRecently.Probe,
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   respondsToIssues.Probe,
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   respondsToPullRequests.Probe,
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   hasFewStalePullRequests.Probe,
+					Outcome: finding.OutcomeTrue,
+				}, {
+					Probe:   hasRecentRelease.Probe,
+					Outcome: finding.OutcomeTrue,
+				}, {
+					Probe:   respondsToSecurityIssues.Probe,
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
-				Score:        checker.MinResultScore,
-				NumberOfWarn: 1,
+				Score:         checker.MaxResultScore,
+				NumberOfWarn:  2,
+				NumberOfInfo:  2,
+				NumberOfDebug: 1,
 			},
 		},
 	}
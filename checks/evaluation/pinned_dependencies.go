@@ -50,6 +50,18 @@ const (
 	normalWeight            int = gitHubOwnedActionWeight + thirdPartyActionWeight
 )
 
+// Dependencies of infrastructure-as-code files, which are logged but don't
+// affect the score yet.
+var informationalDependencyTypes = map[checker.DependencyUseType]bool{
+	checker.DependencyUseTypeComposeImage:      true,
+	checker.DependencyUseTypeKubernetesImage:   true,
+	checker.DependencyUseTypeHelmImage:         true,
+	checker.DependencyUseTypeHelmChart:         true,
+	checker.DependencyUseTypeTerraformModule:   true,
+	checker.DependencyUseTypeTerraformProvider: true,
+	checker.DependencyUseTypeDevcontainerImage: true,
+}
+
 // PinningDependencies applies the score policy for the Pinned-Dependencies check.
 func PinningDependencies(name string,
 	findings []finding.Finding,
@@ -116,6 +128,9 @@ func PinningDependencies(name string,
 	// This results in only existing ecosystems being included in the final score
 	for t := range pr {
 		logPinnedResult(dl, pr[t], string(t))
+		if informationalDependencyTypes[t] {
+			continue
+		}
 		scores = append(scores, checker.ProportionalScoreWeighted{
 			Success: pr[t].pinned,
 			Total:   pr[t].total,
@@ -456,6 +456,67 @@ func Test_PinningDependencies(t *testing.T) {
 				NumberOfInfo: 2, // 1 for processing error, 1 for pinned pip ecosystem
 			},
 		},
+		{
+			name: "unpinned infrastructure-as-code dependency is logged without affecting the score",
+			findings: []finding.Finding{
+				{
+					Probe:   pinsDependencies.Probe,
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "test-file",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypePipCommand),
+					},
+				},
+				{
+					Probe:   pinsDependencies.Probe,
+					Outcome: finding.OutcomeFalse,
+					Location: &finding.Location{
+						Type:      finding.FileTypeSource,
+						Path:      "k8s/deployment.yaml",
+						LineStart: &testLineStart,
+						LineEnd:   &testLineEnd,
+						Snippet:   &testSnippet,
+					},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypeKubernetesImage),
+					},
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 2, // 1 for pinned pip ecosystem, 1 for unpinned Kubernetes images
+				NumberOfWarn: 1,
+			},
+		},
+		{
+			name: "only infrastructure-as-code dependencies is inconclusive",
+			findings: []finding.Finding{
+				{
+					Probe:   pinsDependencies.Probe,
+					Outcome: finding.OutcomeFalse,
+					Location: &finding.Location{
+						Type:      finding.FileTypeSource,
+						Path:      "main.tf",
+						LineStart: &testLineStart,
+						LineEnd:   &testLineEnd,
+						Snippet:   &testSnippet,
+					},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypeTerraformModule),
+					},
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.InconclusiveResultScore,
+				NumberOfInfo: 1,
+				NumberOfWarn: 1,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -22,6 +22,7 @@ import (
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/probes/sastToolConfigured"
+	"github.com/ossf/scorecard/v5/probes/sastToolCoversLanguages"
 	"github.com/ossf/scorecard/v5/probes/sastToolRunsOnAllCommits"
 )
 
@@ -32,6 +33,7 @@ func SAST(name string,
 	expectedProbes := []string{
 		sastToolConfigured.Probe,
 		sastToolRunsOnAllCommits.Probe,
+		sastToolCoversLanguages.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -62,6 +64,13 @@ func SAST(name string,
 			default:
 				otherScore = score
 			}
+		case sastToolCoversLanguages.Probe:
+			// Language coverage is informational and doesn't affect the score (yet).
+			if f.Outcome == finding.OutcomeFalse {
+				dl.Warn(&checker.LogMessage{
+					Text: f.Message,
+				})
+			}
 		}
 	}
 
@@ -20,6 +20,7 @@ import (
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/probes/sastToolConfigured"
+	"github.com/ossf/scorecard/v5/probes/sastToolCoversLanguages"
 	"github.com/ossf/scorecard/v5/probes/sastToolRunsOnAllCommits"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
@@ -57,6 +58,7 @@ func TestSAST(t *testing.T) {
 						sastToolRunsOnAllCommits.TotalPRsKey:    "2",
 					},
 				},
+				languages(finding.OutcomeNotApplicable, ""),
 			},
 			result: scut.TestReturn{
 				Score:        10,
@@ -76,6 +78,7 @@ func TestSAST(t *testing.T) {
 						sastToolRunsOnAllCommits.TotalPRsKey:    "2",
 					},
 				},
+				languages(finding.OutcomeNotApplicable, ""),
 			},
 			result: scut.TestReturn{
 				Score:        10,
@@ -93,6 +96,7 @@ func TestSAST(t *testing.T) {
 					Probe:   sastToolRunsOnAllCommits.Probe,
 					Outcome: finding.OutcomeNotApplicable,
 				},
+				languages(finding.OutcomeNotApplicable, ""),
 			},
 			result: scut.TestReturn{
 				Score:        10,
@@ -115,6 +119,7 @@ func TestSAST(t *testing.T) {
 						sastToolRunsOnAllCommits.TotalPRsKey:    "3",
 					},
 				},
+				languages(finding.OutcomeNotApplicable, ""),
 			},
 			result: scut.TestReturn{
 				Score:        3,
@@ -134,6 +139,7 @@ func TestSAST(t *testing.T) {
 						sastToolRunsOnAllCommits.TotalPRsKey:    "3",
 					},
 				},
+				languages(finding.OutcomeNotApplicable, ""),
 			},
 			result: scut.TestReturn{
 				Score:        10,
@@ -153,6 +159,7 @@ func TestSAST(t *testing.T) {
 						sastToolRunsOnAllCommits.TotalPRsKey:    "3",
 					},
 				},
+				languages(finding.OutcomeNotApplicable, ""),
 			},
 			result: scut.TestReturn{
 				Score:        10,
@@ -160,6 +167,23 @@ func TestSAST(t *testing.T) {
 				NumberOfInfo: 2,
 			},
 		},
+		{
+			name: "Bandit is installed but Go code is not analyzed",
+			findings: []finding.Finding{
+				tool("Bandit"),
+				{
+					Probe:   sastToolRunsOnAllCommits.Probe,
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				languages(finding.OutcomeTrue, "python code is analyzed by: Bandit"),
+				languages(finding.OutcomeFalse, "go code is not analyzed by a SAST tool"),
+			},
+			result: scut.TestReturn{
+				Score:        10,
+				NumberOfWarn: 2,
+				NumberOfInfo: 1,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -171,6 +195,14 @@ func TestSAST(t *testing.T) {
 	}
 }
 
+func languages(outcome finding.Outcome, msg string) finding.Finding {
+	return finding.Finding{
+		Probe:   sastToolCoversLanguages.Probe,
+		Outcome: outcome,
+		Message: msg,
+	}
+}
+
 func tool(name checker.SASTWorkflowType) finding.Finding {
 	return finding.Finding{
 		Probe:   sastToolConfigured.Probe,
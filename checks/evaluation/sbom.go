@@ -20,6 +20,8 @@ import (
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/probes/hasReleaseSBOM"
 	"github.com/ossf/scorecard/v5/probes/hasSBOM"
+	"github.com/ossf/scorecard/v5/probes/sbomHasNTIAMinimumElements"
+	"github.com/ossf/scorecard/v5/probes/sbomListsDeclaredDependencies"
 )
 
 // SBOM applies the score policy for the SBOM check.
@@ -31,6 +33,8 @@ func SBOM(name string,
 	expectedProbes := []string{
 		hasSBOM.Probe,
 		hasReleaseSBOM.Probe,
+		sbomHasNTIAMinimumElements.Probe,
+		sbomListsDeclaredDependencies.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/ossf/scorecard/v5/checker"
+	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
@@ -39,6 +40,14 @@ func TestSBOM(t *testing.T) {
 					Probe:   "hasReleaseSBOM",
 					Outcome: finding.OutcomeFalse,
 				},
+				{
+					Probe:   "sbomHasNTIAMinimumElements",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "sbomListsDeclaredDependencies",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        checker.MinResultScore,
@@ -57,6 +66,14 @@ func TestSBOM(t *testing.T) {
 					Probe:   "hasReleaseSBOM",
 					Outcome: finding.OutcomeFalse,
 				},
+				{
+					Probe:   "sbomHasNTIAMinimumElements",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "sbomListsDeclaredDependencies",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        5,
@@ -75,6 +92,14 @@ func TestSBOM(t *testing.T) {
 					Probe:   "hasReleaseSBOM",
 					Outcome: finding.OutcomeTrue,
 				},
+				{
+					Probe:   "sbomHasNTIAMinimumElements",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "sbomListsDeclaredDependencies",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        checker.MaxResultScore,
@@ -82,6 +107,49 @@ func TestSBOM(t *testing.T) {
 				NumberOfWarn: 0,
 			},
 		},
+		{
+			name: "Incomplete Source SBOM. Half Points",
+			findings: []finding.Finding{
+				{
+					Probe:   "hasSBOM",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "hasReleaseSBOM",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "sbomHasNTIAMinimumElements",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "sbomListsDeclaredDependencies",
+					Outcome: finding.OutcomeTrue,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        5,
+				NumberOfInfo: 2,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "Missing probe",
+			findings: []finding.Finding{
+				{
+					Probe:   "hasSBOM",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "hasReleaseSBOM",
+					Outcome: finding.OutcomeFalse,
+				},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+				Error: sce.ErrScorecardInternal,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
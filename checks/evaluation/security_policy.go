@@ -18,20 +18,30 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasPrivateVulnerabilityReporting"
+	"github.com/ossf/scorecard/v5/probes/hasValidSecurityTxt"
+	"github.com/ossf/scorecard/v5/probes/securityPolicyContactsAreValid"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsLinks"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsText"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsVulnerabilityDisclosure"
+	"github.com/ossf/scorecard/v5/probes/securityPolicyHasResponseTimeline"
+	"github.com/ossf/scorecard/v5/probes/securityPolicyHasSupportedVersions"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyPresent"
 )
 
 // SecurityPolicy applies the score policy for the Security-Policy check.
 func SecurityPolicy(name string, findings []finding.Finding, dl checker.DetailLogger) checker.CheckResult {
-	// We have 4 unique probes, each should have a finding.
+	// We have 9 unique probes, each should have a finding.
 	expectedProbes := []string{
 		securityPolicyContainsVulnerabilityDisclosure.Probe,
 		securityPolicyContainsLinks.Probe,
 		securityPolicyContainsText.Probe,
 		securityPolicyPresent.Probe,
+		hasPrivateVulnerabilityReporting.Probe,
+		hasValidSecurityTxt.Probe,
+		securityPolicyHasSupportedVersions.Probe,
+		securityPolicyHasResponseTimeline.Probe,
+		securityPolicyContactsAreValid.Probe,
 	}
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
 		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
@@ -56,6 +66,12 @@ func SecurityPolicy(name string, findings []finding.Finding, dl checker.DetailLo
 				score += scoreProbeOnce(f.Probe, m, 3)
 			case securityPolicyPresent.Probe:
 				m[f.Probe] = true
+			// These probes are informational and don't affect the score.
+			case hasPrivateVulnerabilityReporting.Probe,
+				hasValidSecurityTxt.Probe,
+				securityPolicyHasSupportedVersions.Probe,
+				securityPolicyHasResponseTimeline.Probe,
+				securityPolicyContactsAreValid.Probe:
 			default:
 				e := sce.WithMessage(sce.ErrScorecardInternal, "unknown probe results")
 				return checker.CreateRuntimeErrorResult(name, e)
@@ -20,9 +20,29 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasPrivateVulnerabilityReporting"
+	"github.com/ossf/scorecard/v5/probes/hasValidSecurityTxt"
+	"github.com/ossf/scorecard/v5/probes/securityPolicyContactsAreValid"
+	"github.com/ossf/scorecard/v5/probes/securityPolicyHasResponseTimeline"
+	"github.com/ossf/scorecard/v5/probes/securityPolicyHasSupportedVersions"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
 
+// withPolicyContent adds a finding with the given outcome for each
+// probe which doesn't affect the score.
+func withPolicyContent(findings []finding.Finding, outcome finding.Outcome) []finding.Finding {
+	for _, probe := range []string{
+		hasPrivateVulnerabilityReporting.Probe,
+		hasValidSecurityTxt.Probe,
+		securityPolicyHasSupportedVersions.Probe,
+		securityPolicyHasResponseTimeline.Probe,
+		securityPolicyContactsAreValid.Probe,
+	} {
+		findings = append(findings, finding.Finding{Probe: probe, Outcome: outcome})
+	}
+	return findings
+}
+
 func TestSecurityPolicy(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -53,7 +73,7 @@ func TestSecurityPolicy(t *testing.T) {
 		},
 		{
 			name: "invalid probe name",
-			findings: []finding.Finding{
+			findings: withPolicyContent([]finding.Finding{
 				{
 					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
 					Outcome: finding.OutcomeFalse,
@@ -74,7 +94,7 @@ func TestSecurityPolicy(t *testing.T) {
 					Probe:   "securityPolicyInvalidProbeName",
 					Outcome: finding.OutcomeFalse,
 				},
-			},
+			}, finding.OutcomeNotApplicable),
 			result: scut.TestReturn{
 				Score: checker.InconclusiveResultScore,
 				Error: sce.ErrScorecardInternal,
@@ -82,7 +102,7 @@ func TestSecurityPolicy(t *testing.T) {
 		},
 		{
 			name: "file found only",
-			findings: []finding.Finding{
+			findings: withPolicyContent([]finding.Finding{
 				{
 					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
 					Outcome: finding.OutcomeFalse,
@@ -99,16 +119,17 @@ func TestSecurityPolicy(t *testing.T) {
 					Probe:   "securityPolicyPresent",
 					Outcome: finding.OutcomeTrue,
 				},
-			},
+			}, finding.OutcomeNotApplicable),
 			result: scut.TestReturn{
-				Score:        checker.MinResultScore,
-				NumberOfInfo: 1,
-				NumberOfWarn: 3,
+				Score:         checker.MinResultScore,
+				NumberOfInfo:  1,
+				NumberOfWarn:  3,
+				NumberOfDebug: 5,
 			},
 		},
 		{
 			name: "file not found with true probes",
-			findings: []finding.Finding{
+			findings: withPolicyContent([]finding.Finding{
 				{
 					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
 					Outcome: finding.OutcomeTrue,
@@ -125,17 +146,18 @@ func TestSecurityPolicy(t *testing.T) {
 					Probe:   "securityPolicyPresent",
 					Outcome: finding.OutcomeFalse,
 				},
-			},
+			}, finding.OutcomeNotApplicable),
 			result: scut.TestReturn{
-				Score:        checker.InconclusiveResultScore,
-				Error:        sce.ErrScorecardInternal,
-				NumberOfWarn: 1,
-				NumberOfInfo: 3,
+				Score:         checker.InconclusiveResultScore,
+				Error:         sce.ErrScorecardInternal,
+				NumberOfWarn:  1,
+				NumberOfInfo:  3,
+				NumberOfDebug: 5,
 			},
 		},
 		{
 			name: "file found with no disclosure and text",
-			findings: []finding.Finding{
+			findings: withPolicyContent([]finding.Finding{
 				{
 					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
 					Outcome: finding.OutcomeFalse,
@@ -152,16 +174,17 @@ func TestSecurityPolicy(t *testing.T) {
 					Probe:   "securityPolicyPresent",
 					Outcome: finding.OutcomeTrue,
 				},
-			},
+			}, finding.OutcomeNotApplicable),
 			result: scut.TestReturn{
-				Score:        6,
-				NumberOfInfo: 2,
-				NumberOfWarn: 2,
+				Score:         6,
+				NumberOfInfo:  2,
+				NumberOfWarn:  2,
+				NumberOfDebug: 5,
 			},
 		},
 		{
 			name: "file found all true",
-			findings: []finding.Finding{
+			findings: withPolicyContent([]finding.Finding{
 				{
 					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
 					Outcome: finding.OutcomeTrue,
@@ -178,10 +201,37 @@ func TestSecurityPolicy(t *testing.T) {
 					Probe:   "securityPolicyPresent",
 					Outcome: finding.OutcomeTrue,
 				},
+			}, finding.OutcomeNotApplicable),
+			result: scut.TestReturn{
+				Score:         checker.MaxResultScore,
+				NumberOfInfo:  4,
+				NumberOfDebug: 5,
 			},
+		},
+		{
+			name: "policy content doesn't affect the score",
+			findings: withPolicyContent([]finding.Finding{
+				{
+					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "securityPolicyContainsLinks",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "securityPolicyContainsText",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "securityPolicyPresent",
+					Outcome: finding.OutcomeTrue,
+				},
+			}, finding.OutcomeFalse),
 			result: scut.TestReturn{
 				Score:        checker.MaxResultScore,
 				NumberOfInfo: 4,
+				NumberOfWarn: 5,
 			},
 		},
 	}
@@ -0,0 +1,391 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// Directories conventionally holding Azure Pipelines definitions and templates.
+var azurePipelinesDirs = map[string]bool{
+	".azure-pipelines": true,
+	"azure-pipelines":  true,
+	".pipelines":       true,
+}
+
+var (
+	reAzureTaskFullVersion = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+	reCommitSHA            = regexp.MustCompile(`^[a-fA-F\d]{40}$`)
+)
+
+// Step keys whose value is an inline script.
+// See https://learn.microsoft.com/en-us/azure/devops/pipelines/yaml-schema/steps.
+var azureScriptStepKeys = []string{"script", "bash", "pwsh", "powershell"}
+
+// Task inputs holding an inline script, for the built-in script tasks
+// (CmdLine, Bash, PowerShell, AzureCLI...).
+var azureScriptTaskInputs = []string{"script", "inlineScript"}
+
+// AzurePipeline is the subset of an Azure Pipelines YAML file, or of a template,
+// used by the checks.
+// See https://learn.microsoft.com/en-us/azure/devops/pipelines/yaml-schema.
+type AzurePipeline struct {
+	// Repositories are the `resources.repositories` entries, keyed by alias.
+	Repositories map[string]*AzureRepository
+	// Containers are the `resources.containers` entries and the images
+	// used inline by jobs.
+	Containers []*AzureContainer
+	// Templates are the stage, job, step and extends templates referenced.
+	Templates []*AzureTemplate
+	// Jobs of all stages. Steps declared at the top level of the file are
+	// reported as a single job with an empty ID.
+	Jobs []*AzureJob
+}
+
+// AzureRepository is a repository resource.
+type AzureRepository struct {
+	Alias string
+	Type  string
+	Name  string
+	Ref   string
+	Line  uint
+}
+
+// AzureContainer is a container image used by the pipeline.
+type AzureContainer struct {
+	// Alias is empty for images declared inline by a job.
+	Alias string
+	Image string
+	Line  uint
+}
+
+// AzureTemplate is a reference to a template, `path[@repository]`.
+type AzureTemplate struct {
+	Path string
+	// Repository is the alias of the repository resource holding the
+	// template, empty for templates in the same repository.
+	Repository string
+	Line       uint
+}
+
+// AzureJob is a job or a deployment job.
+type AzureJob struct {
+	ID          string
+	DisplayName string
+	Steps       []*AzureStep
+	Line        uint
+}
+
+// AzureStep is a step of a job.
+type AzureStep struct {
+	DisplayName string
+	// Task is the `task:` reference, `name@version`, for task steps.
+	Task string
+	// Inputs are the scalar inputs of task steps.
+	Inputs map[string]string
+	// Script is the inline script of script steps and of script tasks.
+	Script     string
+	Line       uint
+	ScriptLine uint
+}
+
+// IsAzurePipelinesFile returns true if this looks like an Azure Pipelines
+// definition or template: `azure-pipelines*.yml` anywhere, or any YAML file
+// in a conventional pipelines directory.
+func IsAzurePipelinesFile(pathfn string) bool {
+	lower := strings.ToLower(pathfn)
+	switch path.Ext(lower) {
+	case ".yml", ".yaml":
+	default:
+		return false
+	}
+	if strings.HasPrefix(path.Base(lower), "azure-pipelines") {
+		return true
+	}
+	for _, dir := range strings.Split(path.Dir(lower), "/") {
+		if azurePipelinesDirs[dir] {
+			return true
+		}
+	}
+	return false
+}
+
+// OnAzurePipelinesFileContentDo runs onFileContent on the content of every
+// Azure Pipelines file listed by `repoClient`.
+// Continues iterating along the files until onFileContent returns
+// either a false value or an error.
+func OnAzurePipelinesFileContentDo(repoClient clients.RepoClient,
+	onFileContent DoWhileTrueOnFileContent, args ...interface{},
+) error {
+	return onFilesDo(repoClient, func(pathfn string) (bool, error) {
+		return !IsTestdataFile(pathfn) && IsAzurePipelinesFile(pathfn), nil
+	}, onFileContent, args...)
+}
+
+// ParseAzurePipeline parses an Azure Pipelines file or template.
+func ParseAzurePipeline(content []byte) (*AzurePipeline, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: %w", errInvalidAzurePipeline, err)
+	}
+	p := &AzurePipeline{Repositories: make(map[string]*AzureRepository)}
+	if len(doc.Content) == 0 {
+		return p, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%w: top-level value is not a mapping", errInvalidAzurePipeline)
+	}
+
+	if resources := mappingValue(root, "resources"); resources != nil {
+		p.parseResources(resources)
+	}
+	if extends := mappingValue(root, "extends"); extends != nil {
+		p.addTemplate(mappingValue(extends, "template"))
+	}
+	p.parseStages(mappingValue(root, "stages"))
+	p.parseJobs(mappingValue(root, "jobs"))
+	if steps := mappingValue(root, "steps"); steps != nil {
+		job := &AzureJob{Line: uint(steps.Line)}
+		job.Steps = p.parseSteps(steps)
+		p.Jobs = append(p.Jobs, job)
+	}
+	return p, nil
+}
+
+// SplitAzureTask splits a task reference into its name and version.
+func SplitAzureTask(task string) (name, version string) {
+	name, version, _ = strings.Cut(task, "@")
+	return strings.TrimSpace(name), strings.TrimSpace(version)
+}
+
+// IsAzureTaskPinned returns true if the task reference specifies a full
+// `major.minor.patch` version, rather than only the major version which
+// resolves to the latest release.
+func IsAzureTaskPinned(task string) bool {
+	_, version := SplitAzureTask(task)
+	return reAzureTaskFullVersion.MatchString(version)
+}
+
+// IsPinned returns true if the repository resource is pinned to a commit.
+func (r *AzureRepository) IsPinned() bool {
+	return reCommitSHA.MatchString(r.Ref)
+}
+
+func (p *AzurePipeline) parseResources(resources *yaml.Node) {
+	for _, item := range sequenceItems(mappingValue(resources, "repositories")) {
+		alias := scalarValue(mappingValue(item, "repository"))
+		if alias == "" {
+			continue
+		}
+		p.Repositories[alias] = &AzureRepository{
+			Alias: alias,
+			Type:  scalarValue(mappingValue(item, "type")),
+			Name:  scalarValue(mappingValue(item, "name")),
+			Ref:   scalarValue(mappingValue(item, "ref")),
+			Line:  uint(item.Line),
+		}
+	}
+	for _, item := range sequenceItems(mappingValue(resources, "containers")) {
+		image := mappingValue(item, "image")
+		if image == nil {
+			continue
+		}
+		p.Containers = append(p.Containers, &AzureContainer{
+			Alias: scalarValue(mappingValue(item, "container")),
+			Image: image.Value,
+			Line:  uint(image.Line),
+		})
+	}
+}
+
+func (p *AzurePipeline) addTemplate(n *yaml.Node) bool {
+	ref := scalarValue(n)
+	if ref == "" {
+		return false
+	}
+	tmpl := &AzureTemplate{Path: ref, Line: uint(n.Line)}
+	if at := strings.LastIndexByte(ref, '@'); at >= 0 {
+		tmpl.Path, tmpl.Repository = ref[:at], ref[at+1:]
+		if tmpl.Repository == "self" {
+			tmpl.Repository = ""
+		}
+	}
+	p.Templates = append(p.Templates, tmpl)
+	return true
+}
+
+func (p *AzurePipeline) parseStages(stages *yaml.Node) {
+	for _, stage := range sequenceItems(stages) {
+		if p.addTemplate(mappingValue(stage, "template")) {
+			continue
+		}
+		p.parseJobs(mappingValue(stage, "jobs"))
+	}
+}
+
+func (p *AzurePipeline) parseJobs(jobs *yaml.Node) {
+	for _, item := range sequenceItems(jobs) {
+		if p.addTemplate(mappingValue(item, "template")) {
+			continue
+		}
+		job := &AzureJob{
+			DisplayName: scalarValue(mappingValue(item, "displayName")),
+			Line:        uint(item.Line),
+		}
+		job.ID = scalarValue(mappingValue(item, "job"))
+		if job.ID == "" {
+			job.ID = scalarValue(mappingValue(item, "deployment"))
+		}
+		p.parseJobContainer(mappingValue(item, "container"))
+
+		if steps := mappingValue(item, "steps"); steps != nil {
+			job.Steps = p.parseSteps(steps)
+		}
+		// Deployment jobs declare their steps in the lifecycle hooks of their strategy.
+		for _, steps := range findKey(mappingValue(item, "strategy"), "steps") {
+			job.Steps = append(job.Steps, p.parseSteps(steps)...)
+		}
+		p.Jobs = append(p.Jobs, job)
+	}
+}
+
+// parseJobContainer records the image of a job container, unless it refers
+// to a container resource.
+func (p *AzurePipeline) parseJobContainer(n *yaml.Node) {
+	if n == nil {
+		return
+	}
+	image := n
+	if n.Kind == yaml.MappingNode {
+		image = mappingValue(n, "image")
+	}
+	if image == nil || image.Kind != yaml.ScalarNode || image.Value == "" {
+		return
+	}
+	for _, c := range p.Containers {
+		if c.Alias == image.Value {
+			return
+		}
+	}
+	p.Containers = append(p.Containers, &AzureContainer{Image: image.Value, Line: uint(image.Line)})
+}
+
+func (p *AzurePipeline) parseSteps(steps *yaml.Node) []*AzureStep {
+	var ret []*AzureStep
+	for _, item := range sequenceItems(steps) {
+		if p.addTemplate(mappingValue(item, "template")) {
+			continue
+		}
+		step := &AzureStep{
+			DisplayName: scalarValue(mappingValue(item, "displayName")),
+			Line:        uint(item.Line),
+		}
+		for _, key := range azureScriptStepKeys {
+			if script := mappingValue(item, key); script != nil {
+				step.Script, step.ScriptLine = script.Value, uint(script.Line)
+				break
+			}
+		}
+		if task := mappingValue(item, "task"); task != nil {
+			step.Task = task.Value
+			inputs := mappingValue(item, "inputs")
+			if inputs != nil && inputs.Kind == yaml.MappingNode {
+				step.Inputs = make(map[string]string)
+				for i := 0; i+1 < len(inputs.Content); i += 2 {
+					if v := inputs.Content[i+1]; v.Kind == yaml.ScalarNode {
+						step.Inputs[inputs.Content[i].Value] = v.Value
+					}
+				}
+			}
+			for _, key := range azureScriptTaskInputs {
+				if script := mappingValue(inputs, key); script != nil {
+					step.Script, step.ScriptLine = script.Value, uint(script.Line)
+					break
+				}
+			}
+		}
+		ret = append(ret, step)
+	}
+	return ret
+}
+
+// mappingValue returns the value of `key` in a mapping node.
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func scalarValue(n *yaml.Node) string {
+	if n == nil || n.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return n.Value
+}
+
+// sequenceItems returns the mapping items of a sequence node. Items inserted
+// conditionally or in a loop by template expressions, e.g.
+// `- ${{ if eq(parameters.x, true) }}:`, are flattened.
+func sequenceItems(n *yaml.Node) []*yaml.Node {
+	if n == nil || n.Kind != yaml.SequenceNode {
+		return nil
+	}
+	var ret []*yaml.Node
+	for _, item := range n.Content {
+		if item.Kind != yaml.MappingNode {
+			continue
+		}
+		if len(item.Content) == 2 && strings.HasPrefix(item.Content[0].Value, "${{") {
+			ret = append(ret, sequenceItems(item.Content[1])...)
+			continue
+		}
+		ret = append(ret, item)
+	}
+	return ret
+}
+
+// findKey returns the values of all the mappings nested in `n` for `key`.
+func findKey(n *yaml.Node, key string) []*yaml.Node {
+	if n == nil {
+		return nil
+	}
+	var ret []*yaml.Node
+	if n.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i].Value == key {
+				ret = append(ret, n.Content[i+1])
+				continue
+			}
+			ret = append(ret, findKey(n.Content[i+1], key)...)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsAzurePipelinesFile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "azure-pipelines.yml", want: true},
+		{path: "build/Azure-Pipelines-release.yaml", want: true},
+		{path: ".azure-pipelines/templates/steps.yml", want: true},
+		{path: ".pipelines/ci.yaml", want: true},
+		{path: "azure-pipelines.json", want: false},
+		{path: ".github/workflows/ci.yml", want: false},
+		{path: "pipelines/ci.yml", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsAzurePipelinesFile(tt.path); got != tt.want {
+			t.Errorf("IsAzurePipelinesFile(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestIsAzureTaskPinned(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		task string
+		want bool
+	}{
+		{task: "PowerShell@2", want: false},
+		{task: "PowerShell@2.247", want: false},
+		{task: "PowerShell@2.247.1", want: true},
+		{task: "PowerShell", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsAzureTaskPinned(tt.task); got != tt.want {
+			t.Errorf("IsAzureTaskPinned(%q) = %v, want %v", tt.task, got, tt.want)
+		}
+	}
+}
+
+func TestParseAzurePipeline(t *testing.T) {
+	t.Parallel()
+	const content = `
+extends:
+  template: pipelines/main.yml@templates
+resources:
+  repositories:
+    - repository: templates
+      type: git
+      name: project/templates
+      ref: refs/heads/main
+steps:
+  - script: make
+    displayName: Build
+  - task: CmdLine@2
+    inputs:
+      script: make test
+  - template: steps/publish.yml@self
+`
+	got, err := ParseAzurePipeline([]byte(content))
+	if err != nil {
+		t.Fatalf("ParseAzurePipeline: %v", err)
+	}
+	want := &AzurePipeline{
+		Repositories: map[string]*AzureRepository{
+			"templates": {Alias: "templates", Type: "git", Name: "project/templates", Ref: "refs/heads/main", Line: 6},
+		},
+		Templates: []*AzureTemplate{
+			{Path: "pipelines/main.yml", Repository: "templates", Line: 3},
+			{Path: "steps/publish.yml", Line: 16},
+		},
+		Jobs: []*AzureJob{
+			{
+				Line: 11,
+				Steps: []*AzureStep{
+					{DisplayName: "Build", Script: "make", Line: 11, ScriptLine: 11},
+					{
+						Task: "CmdLine@2", Inputs: map[string]string{"script": "make test"},
+						Script: "make test", Line: 13, ScriptLine: 15,
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ParseAzurePipeline mismatch (-want +got):\n%s", diff)
+	}
+	if got.Repositories["templates"].IsPinned() {
+		t.Errorf("repository pinned to a branch reported as pinned")
+	}
+}
+
+func TestParseAzurePipeline_invalid(t *testing.T) {
+	t.Parallel()
+	for _, content := range []string{"- a\n- b\n", "steps: [\n"} {
+		if _, err := ParseAzurePipeline([]byte(content)); !errors.Is(err, errInvalidAzurePipeline) {
+			t.Errorf("ParseAzurePipeline(%q) = %v, want %v", content, err, errInvalidAzurePipeline)
+		}
+	}
+	p, err := ParseAzurePipeline(nil)
+	if err != nil || len(p.Jobs) != 0 {
+		t.Errorf("ParseAzurePipeline(nil) = %v, %v, want empty pipeline", p, err)
+	}
+}
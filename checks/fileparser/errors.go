@@ -21,4 +21,6 @@ import (
 var (
 	errInvalidGitHubWorkflow = errors.New("invalid GitHub workflow")
 	errInternalFilenameMatch = errors.New("filename match error")
+	errInvalidAzurePipeline  = errors.New("invalid Azure Pipelines file")
+	errInvalidActionMetadata = errors.New("invalid action metadata")
 )
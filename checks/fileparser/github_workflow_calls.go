@@ -0,0 +1,372 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// CompositeAction is a local composite action, i.e. an `action.yml` running `steps`.
+type CompositeAction struct {
+	Path  string
+	Steps []*CompositeActionStep
+}
+
+// CompositeActionStep is a step of a composite action.
+type CompositeActionStep struct {
+	With     map[string]string
+	Name     string
+	Uses     string
+	Run      string
+	Shell    string
+	Line     uint
+	UsesLine uint
+	RunLine  uint
+}
+
+// ParseCompositeAction parses the metadata of an action. It returns nil if the
+// action isn't a composite action.
+func ParseCompositeAction(pathfn string, content []byte) (*CompositeAction, error) {
+	var metadata struct {
+		Runs struct {
+			Using string    `yaml:"using"`
+			Steps yaml.Node `yaml:"steps"`
+		} `yaml:"runs"`
+	}
+	if err := yaml.Unmarshal(content, &metadata); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidActionMetadata, err)
+	}
+	if !strings.EqualFold(metadata.Runs.Using, "composite") {
+		return nil, nil
+	}
+
+	action := &CompositeAction{Path: pathfn}
+	if metadata.Runs.Steps.Kind != yaml.SequenceNode {
+		return action, nil
+	}
+	for _, node := range metadata.Runs.Steps.Content {
+		if node.Kind != yaml.MappingNode {
+			continue
+		}
+		step := &CompositeActionStep{Line: uint(node.Line)}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			switch key.Value {
+			case "name":
+				step.Name = value.Value
+			case "uses":
+				step.Uses, step.UsesLine = value.Value, uint(value.Line)
+			case "run":
+				step.Run, step.RunLine = value.Value, uint(value.Line)
+			case "shell":
+				step.Shell = value.Value
+			case "with":
+				step.With = make(map[string]string)
+				for j := 0; j+1 < len(value.Content); j += 2 {
+					step.With[strings.ToLower(value.Content[j].Value)] = value.Content[j+1].Value
+				}
+			}
+		}
+		action.Steps = append(action.Steps, step)
+	}
+	return action, nil
+}
+
+// IsActionMetadataFile returns true if the file may define a local action.
+func IsActionMetadataFile(pathfn string) bool {
+	base := path.Base(pathfn)
+	return base == "action.yml" || base == "action.yaml"
+}
+
+// WorkflowCall is the call of a local reusable workflow by a job, or of a local
+// composite action by a step.
+type WorkflowCall struct {
+	// Job is the calling job, nil for calls by composite actions.
+	Job *actionlint.Job
+	// Permissions of the token of the calling job, nil if they aren't declared.
+	Permissions *actionlint.Permissions
+	// With are the inputs passed to the callee, by lower case name.
+	With   map[string]string
+	Caller string
+	Callee string
+	Uses   string
+	Line   uint
+	// InheritSecrets is true if the secrets of the caller are available to the callee:
+	// with `secrets: inherit` for reusable workflows, always for composite actions.
+	InheritSecrets bool
+}
+
+// WorkflowGraph is the call graph of the GitHub workflows, local reusable workflows and
+// local composite actions of a repository.
+type WorkflowGraph struct {
+	Workflows map[string]*actionlint.Workflow
+	Actions   map[string]*CompositeAction
+	calls     map[string][]*WorkflowCall
+	callers   map[string][]*WorkflowCall
+}
+
+// NewWorkflowGraph reads the workflows and composite actions of a repository and
+// resolves their calls to one another.
+func NewWorkflowGraph(repoClient clients.RepoClient) (*WorkflowGraph, error) {
+	g := &WorkflowGraph{
+		Workflows: make(map[string]*actionlint.Workflow),
+		Actions:   make(map[string]*CompositeAction),
+		calls:     make(map[string][]*WorkflowCall),
+		callers:   make(map[string][]*WorkflowCall),
+	}
+	isGraphFile := func(pathfn string) bool {
+		return !IsTestdataFile(pathfn) && (IsWorkflowFile(pathfn) || IsActionMetadataFile(pathfn))
+	}
+	files, err := repoClient.ListFiles(func(pathfn string) (bool, error) {
+		return isGraphFile(pathfn), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error during ListFiles: %w", err)
+	}
+	for _, file := range files {
+		if !isGraphFile(file) {
+			continue
+		}
+		content, err := readFile(repoClient, file)
+		if err != nil {
+			return nil, err
+		}
+		if IsWorkflowFile(file) {
+			// Workflows which can't be parsed are reported by the checks analyzing them.
+			if workflow, _ := actionlint.Parse(content); workflow != nil {
+				g.Workflows[file] = workflow
+			}
+			continue
+		}
+		if action, err := ParseCompositeAction(file, content); err == nil && action != nil {
+			g.Actions[file] = action
+		}
+	}
+	g.resolveCalls()
+	return g, nil
+}
+
+func readFile(repoClient clients.RepoClient, pathfn string) ([]byte, error) {
+	reader, err := repoClient.GetFileReader(pathfn)
+	if err != nil {
+		return nil, fmt.Errorf("error during GetFileReader: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading from file: %w", err)
+	}
+	return content, nil
+}
+
+func (g *WorkflowGraph) resolveCalls() {
+	for _, p := range sortedKeys(g.Workflows) {
+		workflow := g.Workflows[p]
+		for _, id := range sortedKeys(workflow.Jobs) {
+			job := workflow.Jobs[id]
+			if job == nil {
+				continue
+			}
+			permissions := job.Permissions
+			if permissions == nil {
+				permissions = workflow.Permissions
+			}
+			if wc := job.WorkflowCall; wc != nil && wc.Uses != nil {
+				if callee, ok := g.resolve(wc.Uses.Value); ok {
+					with := make(map[string]string)
+					for name, input := range wc.Inputs {
+						if input != nil && input.Value != nil {
+							with[name] = input.Value.Value
+						}
+					}
+					g.addCall(&WorkflowCall{
+						Job:            job,
+						Permissions:    permissions,
+						With:           with,
+						Caller:         p,
+						Callee:         callee,
+						Uses:           wc.Uses.Value,
+						Line:           GetLineNumber(wc.Uses.Pos),
+						InheritSecrets: wc.InheritSecrets,
+					})
+				}
+			}
+			for _, step := range job.Steps {
+				uses := GetUses(step)
+				if uses == nil {
+					continue
+				}
+				callee, ok := g.resolve(uses.Value)
+				if !ok {
+					continue
+				}
+				with := make(map[string]string)
+				for name, input := range getWith(step) {
+					if input != nil && input.Value != nil {
+						with[strings.ToLower(name)] = input.Value.Value
+					}
+				}
+				g.addCall(&WorkflowCall{
+					Job:            job,
+					Permissions:    permissions,
+					With:           with,
+					Caller:         p,
+					Callee:         callee,
+					Uses:           uses.Value,
+					Line:           GetLineNumber(uses.Pos),
+					InheritSecrets: true,
+				})
+			}
+		}
+	}
+	for _, p := range sortedKeys(g.Actions) {
+		for _, step := range g.Actions[p].Steps {
+			callee, ok := g.resolve(step.Uses)
+			if !ok {
+				continue
+			}
+			g.addCall(&WorkflowCall{
+				With:           step.With,
+				Caller:         p,
+				Callee:         callee,
+				Uses:           step.Uses,
+				Line:           step.UsesLine,
+				InheritSecrets: true,
+			})
+		}
+	}
+}
+
+// resolve returns the path of the local workflow or action metadata file of a `uses`.
+// Local paths are relative to the root of the repository.
+func (g *WorkflowGraph) resolve(uses string) (string, bool) {
+	if !strings.HasPrefix(uses, "./") {
+		return "", false
+	}
+	p := path.Clean(uses)
+	if _, ok := g.Workflows[p]; ok {
+		return p, true
+	}
+	for _, name := range []string{"action.yml", "action.yaml"} {
+		if _, ok := g.Actions[path.Join(p, name)]; ok {
+			return path.Join(p, name), true
+		}
+	}
+	return "", false
+}
+
+func (g *WorkflowGraph) addCall(call *WorkflowCall) {
+	g.calls[call.Caller] = append(g.calls[call.Caller], call)
+	g.callers[call.Callee] = append(g.callers[call.Callee], call)
+}
+
+// Calls returns the calls of local reusable workflows and composite actions by a file.
+func (g *WorkflowGraph) Calls(pathfn string) []*WorkflowCall {
+	return g.calls[pathfn]
+}
+
+// Callers returns the calls of a local reusable workflow or composite action.
+func (g *WorkflowGraph) Callers(pathfn string) []*WorkflowCall {
+	return g.callers[pathfn]
+}
+
+// Walk calls fn for each chain of calls starting from a file, in depth-first order.
+// The last call of a chain is the call of its callee. Chains stop at cycles.
+func (g *WorkflowGraph) Walk(pathfn string, fn func(chain []*WorkflowCall)) {
+	g.walk(pathfn, nil, map[string]bool{pathfn: true}, fn)
+}
+
+func (g *WorkflowGraph) walk(pathfn string, chain []*WorkflowCall, visiting map[string]bool,
+	fn func([]*WorkflowCall),
+) {
+	for _, call := range g.calls[pathfn] {
+		if visiting[call.Callee] {
+			continue
+		}
+		next := append(chain[:len(chain):len(chain)], call)
+		fn(next)
+		visiting[call.Callee] = true
+		g.walk(call.Callee, next, visiting, fn)
+		delete(visiting, call.Callee)
+	}
+}
+
+// Cycles returns the cycles of calls, as the paths of the files of each cycle, starting
+// from the smallest path. GitHub fails to run workflows with cycles.
+func (g *WorkflowGraph) Cycles() [][]string {
+	var cycles [][]string
+	seen := make(map[string]bool)
+	for _, start := range sortedKeys(g.calls) {
+		var visit func(pathfn string, stack []string)
+		visit = func(pathfn string, stack []string) {
+			for _, call := range g.calls[pathfn] {
+				if call.Callee == start {
+					cycle := append(stack[:len(stack):len(stack)], pathfn)
+					if key := strings.Join(cycle, "\n"); !seen[key] {
+						seen[key] = true
+						cycles = append(cycles, cycle)
+					}
+					continue
+				}
+				if call.Callee < start || containsString(stack, call.Callee) || call.Callee == pathfn {
+					continue
+				}
+				visit(call.Callee, append(stack[:len(stack):len(stack)], pathfn))
+			}
+		}
+		visit(start, nil)
+	}
+	return cycles
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOnlyCalled returns true if a workflow is only triggered by calls from other workflows.
+func IsOnlyCalled(workflow *actionlint.Workflow) bool {
+	if workflow == nil || len(workflow.On) == 0 {
+		return false
+	}
+	for _, event := range workflow.On {
+		if event.EventName() != "workflow_call" {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestParseCompositeAction(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want    *CompositeAction
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name: "composite action",
+			content: `runs:
+  using: composite
+  steps:
+    - uses: actions/setup-go@v5
+      with:
+        Go-Version: '1.22'
+    - name: build
+      run: go build ./...
+      shell: bash
+`,
+			want: &CompositeAction{
+				Path: "action.yml",
+				Steps: []*CompositeActionStep{
+					{
+						Uses:     "actions/setup-go@v5",
+						With:     map[string]string{"go-version": "1.22"},
+						Line:     4,
+						UsesLine: 4,
+					},
+					{
+						Name:    "build",
+						Run:     "go build ./...",
+						Shell:   "bash",
+						Line:    7,
+						RunLine: 8,
+					},
+				},
+			},
+		},
+		{
+			name: "javascript action",
+			content: `runs:
+  using: node20
+  main: index.js
+`,
+		},
+		{
+			name:    "invalid yaml",
+			content: "runs: [",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseCompositeAction("action.yml", []byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCompositeAction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseCompositeAction() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkflowGraph(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		".github/workflows/main.yml": `on: push
+jobs:
+  a:
+    uses: ./.github/workflows/a.yml
+    secrets: inherit
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: ./.github/actions/lint
+`,
+		".github/workflows/a.yml": `on: workflow_call
+jobs:
+  b:
+    uses: ./.github/workflows/b.yml
+`,
+		".github/workflows/b.yml": `on: workflow_call
+jobs:
+  a:
+    uses: ./.github/workflows/a.yml
+`,
+		".github/actions/lint/action.yaml": `runs:
+  using: composite
+  steps:
+    - uses: actions/setup-go@v5
+`,
+	}
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+		func(predicate func(string) (bool, error)) ([]string, error) {
+			var matched []string
+			for name := range files {
+				ok, err := predicate(name)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					matched = append(matched, name)
+				}
+			}
+			return matched, nil
+		}).AnyTimes()
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(name string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(files[name])), nil
+	}).AnyTimes()
+
+	graph, err := NewWorkflowGraph(mockRepoClient)
+	if err != nil {
+		t.Fatalf("NewWorkflowGraph() error = %v", err)
+	}
+
+	callees := func(calls []*WorkflowCall) []string {
+		var paths []string
+		for _, call := range calls {
+			paths = append(paths, call.Callee)
+		}
+		return paths
+	}
+	want := []string{".github/workflows/a.yml", ".github/actions/lint/action.yaml"}
+	if diff := cmp.Diff(want, callees(graph.Calls(".github/workflows/main.yml"))); diff != "" {
+		t.Errorf("Calls() mismatch (-want +got):\n%s", diff)
+	}
+	if callers := graph.Callers(".github/workflows/a.yml"); len(callers) != 2 {
+		t.Errorf("Callers() = %d calls, want 2", len(callers))
+	}
+	for _, call := range graph.Callers(".github/workflows/a.yml") {
+		if call.Caller == ".github/workflows/main.yml" && !call.InheritSecrets {
+			t.Error("call from main.yml should inherit secrets")
+		}
+	}
+
+	var chains [][]string
+	graph.Walk(".github/workflows/main.yml", func(chain []*WorkflowCall) {
+		chains = append(chains, callees(chain))
+	})
+	wantChains := [][]string{
+		{".github/workflows/a.yml"},
+		{".github/workflows/a.yml", ".github/workflows/b.yml"},
+		{".github/actions/lint/action.yaml"},
+	}
+	if diff := cmp.Diff(wantChains, chains); diff != "" {
+		t.Errorf("Walk() mismatch (-want +got):\n%s", diff)
+	}
+
+	wantCycles := [][]string{{".github/workflows/a.yml", ".github/workflows/b.yml"}}
+	if diff := cmp.Diff(wantCycles, graph.Cycles()); diff != "" {
+		t.Errorf("Cycles() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -50,7 +50,8 @@ func isMatchingPath(fullpath string, matchPathTo PathMatcher) (bool, error) {
 	return match, nil
 }
 
-func isTestdataFile(fullpath string) bool {
+// IsTestdataFile reports whether the file is in a directory holding test data.
+func IsTestdataFile(fullpath string) bool {
 	// testdata/ or /some/dir/testdata/some/other
 	return strings.HasPrefix(fullpath, "testdata/") ||
 		strings.Contains(fullpath, "/testdata/") ||
@@ -94,12 +95,24 @@ func OnMatchingFileContentDo(repoClient clients.RepoClient, matchPathTo PathMatc
 	return onMatchingFileDo(repoClient, matchPathTo, onFileContent, args...)
 }
 
+// OnPredicateFileContentDo runs onFileContent on the content of every file
+// outside of testdata directories for which predicate returns true.
+// Continues iterating along the files until onFileContent returns
+// either a false value or an error.
+func OnPredicateFileContentDo(repoClient clients.RepoClient, predicate func(string) bool,
+	onFileContent DoWhileTrueOnFileContent, args ...interface{},
+) error {
+	return onFilesDo(repoClient, func(pathfn string) (bool, error) {
+		return !IsTestdataFile(pathfn) && predicate(pathfn), nil
+	}, onFileContent, args...)
+}
+
 func onMatchingFileDo(repoClient clients.RepoClient, matchPathTo PathMatcher,
 	onFile any, args ...interface{},
 ) error {
 	predicate := func(filepath string) (bool, error) {
 		// Filter out test files.
-		if isTestdataFile(filepath) {
+		if IsTestdataFile(filepath) {
 			return false, nil
 		}
 		// Filter out files based on path/names using the pattern.
@@ -109,7 +122,13 @@ func onMatchingFileDo(repoClient clients.RepoClient, matchPathTo PathMatcher,
 		}
 		return b, nil
 	}
+	return onFilesDo(repoClient, predicate, onFile, args...)
+}
 
+// onFilesDo runs onFile on every file listed by `repoClient` matching `predicate`.
+func onFilesDo(repoClient clients.RepoClient, predicate func(string) (bool, error),
+	onFile any, args ...interface{},
+) error {
 	matchedFiles, err := repoClient.ListFiles(predicate)
 	if err != nil {
 		return fmt.Errorf("error during ListFiles: %w", err)
@@ -365,6 +365,8 @@ func Test_Maintained(t *testing.T) {
 
 							return tt.createdat, nil
 						})
+						mockRepo.EXPECT().ListPullRequests().Return(nil, nil)
+						mockRepo.EXPECT().ListReleases().Return(nil, nil)
 					}
 				}
 			}
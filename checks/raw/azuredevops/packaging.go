@@ -0,0 +1,173 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevops
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// Tasks publishing packages, with the values of their `command` input which publish.
+var packagingTasks = map[string][]string{
+	"NuGetCommand":      {"push"},
+	"DotNetCoreCLI":     {"push"},
+	"Npm":               {"publish"},
+	"UniversalPackages": {"publish"},
+	"Docker":            {"push", "buildAndPush"},
+}
+
+// Default `command` input of the tasks above, for those which publish by default.
+var defaultTaskCommands = map[string]string{
+	"Docker": "buildAndPush",
+}
+
+var packagingCommands = []string{
+	"cargo publish",
+	"docker push",
+	"gradle publish",
+	"mvn deploy",
+	"npm publish",
+	"nuget push",
+	"poetry publish",
+	"twine upload",
+}
+
+// Packaging checks for Azure Pipelines publishing packages.
+func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
+	var data checker.PackagingData
+	matchedFiles, err := c.RepoClient.ListFiles(func(path string) (bool, error) {
+		return fileparser.IsAzurePipelinesFile(path), nil
+	})
+	if err != nil {
+		return data, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+
+	for _, fp := range matchedFiles {
+		fr, err := c.RepoClient.GetFileReader(fp)
+		if err != nil {
+			return data, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+		}
+		fc, err := io.ReadAll(fr)
+		fr.Close()
+		if err != nil {
+			return data, fmt.Errorf("reading file: %w", err)
+		}
+
+		pipeline, err := fileparser.ParseAzurePipeline(fc)
+		if err != nil {
+			data.Packages = append(data.Packages, checker.Package{
+				Msg: stringPointer(fmt.Sprintf("unable to parse Azure Pipelines file: %v", err)),
+				File: &checker.File{
+					Path:   fp,
+					Type:   finding.FileTypeSource,
+					Offset: checker.OffsetDefault,
+				},
+			})
+			continue
+		}
+
+		line, ok := findPackagingStep(pipeline)
+		if !ok {
+			continue
+		}
+
+		runs, err := c.RepoClient.ListSuccessfulWorkflowRuns(fp)
+		if err != nil {
+			// assume the pipeline will have run for localdir client
+			if errors.Is(err, clients.ErrUnsupportedFeature) {
+				runs = append(runs, clients.WorkflowRun{})
+			} else {
+				return data, fmt.Errorf("Client.ListSuccessfulWorkflowRuns: %w", err)
+			}
+		}
+
+		if len(runs) == 0 {
+			data.Packages = append(data.Packages, checker.Package{
+				// Debug message.
+				Msg: stringPointer(fmt.Sprintf("Azure Pipelines publishing pipeline not used in runs: %v", fp)),
+				File: &checker.File{
+					Path:   fp,
+					Type:   finding.FileTypeSource,
+					Offset: checker.OffsetDefault,
+				},
+			})
+			continue
+		}
+
+		pkg := checker.Package{
+			File: &checker.File{
+				Path:   fp,
+				Type:   finding.FileTypeSource,
+				Offset: line,
+			},
+		}
+		for _, run := range runs {
+			pkg.Runs = append(pkg.Runs, checker.Run{URL: run.URL})
+		}
+		data.Packages = append(data.Packages, pkg)
+		return data, nil
+	}
+
+	return data, nil
+}
+
+// findPackagingStep returns the line of the first step publishing a package.
+func findPackagingStep(pipeline *fileparser.AzurePipeline) (uint, bool) {
+	for _, job := range pipeline.Jobs {
+		for _, step := range job.Steps {
+			if isPackagingTask(step) {
+				return step.Line, true
+			}
+			for _, command := range packagingCommands {
+				if strings.Contains(step.Script, command) {
+					return step.ScriptLine, true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+func isPackagingTask(step *fileparser.AzureStep) bool {
+	if step.Task == "" {
+		return false
+	}
+	name, _ := fileparser.SplitAzureTask(step.Task)
+	commands, ok := packagingTasks[name]
+	if !ok {
+		return false
+	}
+	input, ok := step.Inputs["command"]
+	if !ok {
+		input = defaultTaskCommands[name]
+	}
+	for _, command := range commands {
+		if strings.EqualFold(input, command) {
+			return true
+		}
+	}
+	return false
+}
+
+func stringPointer(s string) *string {
+	return &s
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevops
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestAzurePipelinesPackaging(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name       string
+		content    string
+		runs       []clients.WorkflowRun
+		lineNumber uint
+		exists     bool
+	}{
+		{
+			name: "No Publishing Detected",
+			content: `
+steps:
+  - script: make test
+  - task: DotNetCoreCLI@2
+    inputs:
+      command: build
+`,
+			runs: []clients.WorkflowRun{{URL: "https://dev.azure.com/org/project/_build/results?buildId=1"}},
+		},
+		{
+			name: "NuGet push",
+			content: `
+steps:
+  - script: make
+  - task: NuGetCommand@2
+    inputs:
+      command: push
+`,
+			runs:       []clients.WorkflowRun{{URL: "https://dev.azure.com/org/project/_build/results?buildId=1"}},
+			lineNumber: 4,
+			exists:     true,
+		},
+		{
+			name: "Docker default command",
+			content: `
+jobs:
+  - job: publish
+    steps:
+      - task: Docker@2
+        inputs:
+          repository: org/image
+`,
+			runs:       []clients.WorkflowRun{{URL: "https://dev.azure.com/org/project/_build/results?buildId=1"}},
+			lineNumber: 5,
+			exists:     true,
+		},
+		{
+			name: "Twine",
+			content: `
+steps:
+  - script: |
+      python -m build
+      twine upload dist/*
+`,
+			runs:       []clients.WorkflowRun{{URL: "https://dev.azure.com/org/project/_build/results?buildId=1"}},
+			lineNumber: 3,
+			exists:     true,
+		},
+		{
+			name: "Not run",
+			content: `
+steps:
+  - task: Npm@1
+    inputs:
+      command: publish
+`,
+			runs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			const filename = "azure-pipelines.yml"
+			ctrl := gomock.NewController(t)
+			moqRepoClient := mockrepo.NewMockRepoClient(ctrl)
+
+			moqRepoClient.EXPECT().ListFiles(gomock.Any()).
+				Return([]string{filename}, nil).AnyTimes()
+			moqRepoClient.EXPECT().GetFileReader(filename).
+				DoAndReturn(func(string) (io.ReadCloser, error) {
+					return io.NopCloser(strings.NewReader(tt.content)), nil
+				}).AnyTimes()
+			moqRepoClient.EXPECT().ListSuccessfulWorkflowRuns(filename).
+				Return(tt.runs, nil).AnyTimes()
+
+			req := checker.CheckRequest{
+				RepoClient: moqRepoClient,
+			}
+
+			packagingData, err := Packaging(&req)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+
+			var pkgs []checker.Package
+			for _, p := range packagingData.Packages {
+				if p.Msg == nil {
+					pkgs = append(pkgs, p)
+				}
+			}
+
+			if !tt.exists {
+				if len(pkgs) != 0 {
+					t.Errorf("Repo should not contain any packages")
+				}
+				return
+			}
+
+			if len(pkgs) != 1 {
+				t.Fatalf("Repo should contain a single package, got %d", len(pkgs))
+			}
+			if pkgs[0].File.Offset != tt.lineNumber {
+				t.Errorf("Expected line number: %d != %d", tt.lineNumber, pkgs[0].File.Offset)
+			}
+			if len(pkgs[0].Runs) != len(tt.runs) || pkgs[0].Runs[0].URL != tt.runs[0].URL {
+				t.Errorf("Expected runs %v, got %v", tt.runs, pkgs[0].Runs)
+			}
+		})
+	}
+}
@@ -14,21 +14,127 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/rhysd/actionlint"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
 	"github.com/ossf/scorecard/v5/clients"
 	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
 )
 
-func CITests(c clients.RepoClient) (checker.CITestData, error) {
+const ciSystemGitHubActions = "GitHub Actions"
+
+// ciConfigFiles maps the CI systems to a predicate for their configuration files.
+var ciConfigFiles = []struct {
+	match  func(pathfn string) bool
+	system string
+}{
+	{system: ciSystemGitHubActions, match: fileparser.IsWorkflowFile},
+	{system: "GitLab CI", match: isGitLabCIFile},
+	{system: "Azure Pipelines", match: fileparser.IsAzurePipelinesFile},
+	{system: "Jenkins", match: func(pathfn string) bool {
+		base := path.Base(pathfn)
+		return base == "Jenkinsfile" || strings.HasPrefix(base, "Jenkinsfile.")
+	}},
+	{system: "CircleCI", match: func(pathfn string) bool {
+		return pathfn == ".circleci/config.yml" || pathfn == ".circleci/config.yaml"
+	}},
+	{system: "Travis CI", match: func(pathfn string) bool {
+		return pathfn == ".travis.yml"
+	}},
+	{system: "Buildkite", match: func(pathfn string) bool {
+		return isYAMLInDir(pathfn, ".buildkite")
+	}},
+	{system: "Drone", match: func(pathfn string) bool {
+		return pathfn == ".drone.yml" || pathfn == ".drone.yaml"
+	}},
+	{system: "Woodpecker", match: func(pathfn string) bool {
+		return pathfn == ".woodpecker.yml" || pathfn == ".woodpecker.yaml" || isYAMLInDir(pathfn, ".woodpecker")
+	}},
+	{system: "Tekton", match: func(pathfn string) bool {
+		return isYAMLInDir(pathfn, ".tekton")
+	}},
+	{system: "Cirrus CI", match: func(pathfn string) bool {
+		return pathfn == ".cirrus.yml"
+	}},
+	{system: "AppVeyor", match: func(pathfn string) bool {
+		return pathfn == "appveyor.yml" || pathfn == ".appveyor.yml"
+	}},
+}
+
+// reTestCommand matches the usual commands running the tests of a project.
+var reTestCommand = regexp.MustCompile(`(?:^|[\s;&|('"/])(` +
+	`go test|gotestsum|py\.?test|python3? -m (?:pytest|unittest)|tox|nox|` +
+	`(?:npm|pnpm|yarn|bun)(?: run)? test|jest|vitest|mocha|` +
+	`cargo (?:test|nextest)|mvnw? [^;&|]*\b(?:test|verify)|gradlew? [^;&|]*\b(?:test|check)|` +
+	`make (?:test|check)|ctest|bazel test|dotnet test|rspec|rake (?:test|spec)|phpunit|` +
+	`mix test|swift test|flutter test|dart test|sbt test)\b`)
+
+func isYAMLInDir(pathfn, dir string) bool {
+	switch path.Ext(pathfn) {
+	case ".yml", ".yaml":
+		return path.Dir(pathfn) == dir
+	default:
+		return false
+	}
+}
+
+// ciSystemOf returns the CI system configured by the file, or "" if none.
+func ciSystemOf(pathfn string) string {
+	for _, c := range ciConfigFiles {
+		if c.match(pathfn) {
+			return c.system
+		}
+	}
+	return ""
+}
+
+func isCIConfigFile(pathfn string) bool {
+	return ciSystemOf(pathfn) != ""
+}
+
+func CITests(c *checker.CheckRequest) (checker.CITestData, error) {
+	var data checker.CITestData
+	if c.Config != nil {
+		data.Config = c.Config.CITests
+	}
+
+	infos, err := ciInfoOfMergedPullRequests(c.RepoClient)
+	switch {
+	case err == nil:
+		data.CIInfo = infos
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+		// Local scans have no pull requests, only the CI configuration files.
+		data.PullRequestsUnavailable = true
+	default:
+		return checker.CITestData{}, err
+	}
+
+	err = fileparser.OnPredicateFileContentDo(c.RepoClient, isCIConfigFile, collectTestCommands, &data, c.Dlogger)
+	if err != nil {
+		return checker.CITestData{}, err
+	}
+	return data, nil
+}
+
+func ciInfoOfMergedPullRequests(c clients.RepoClient) ([]checker.RevisionCIInfo, error) {
 	commits, err := c.ListCommits()
+	if errors.Is(err, clients.ErrUnsupportedFeature) {
+		return nil, fmt.Errorf("RepoClient.ListCommits: %w", err)
+	}
 	if err != nil {
 		e := sce.WithMessage(
 			sce.ErrScorecardInternal,
 			fmt.Sprintf("RepoClient.ListCommits: %v", err),
 		)
-		return checker.CITestData{}, e
+		return nil, e
 	}
 
 	runs := make(map[string][]clients.CheckRun)
@@ -49,7 +155,7 @@ func CITests(c clients.RepoClient) (checker.CITestData, error) {
 		if len(runs[pr.HeadSHA]) == 0 {
 			crs, err := c.ListCheckRunsForRef(pr.HeadSHA)
 			if err != nil {
-				return checker.CITestData{}, sce.WithMessage(
+				return nil, sce.WithMessage(
 					sce.ErrScorecardInternal,
 					fmt.Sprintf("Client.Repositories.ListCheckRunsForRef: %v", err),
 				)
@@ -60,7 +166,7 @@ func CITests(c clients.RepoClient) (checker.CITestData, error) {
 
 		statuses, err := c.ListStatuses(pr.HeadSHA)
 		if err != nil {
-			return checker.CITestData{}, sce.WithMessage(
+			return nil, sce.WithMessage(
 				sce.ErrScorecardInternal,
 				fmt.Sprintf("Client.Repositories.ListStatuses: %v", err),
 			)
@@ -82,5 +188,101 @@ func CITests(c clients.RepoClient) (checker.CITestData, error) {
 		})
 	}
 
-	return checker.CITestData{CIInfo: infos}, nil
+	return infos, nil
+}
+
+// collectTestCommands records a CI configuration file and the commands it
+// runs which run tests.
+var collectTestCommands fileparser.DoWhileTrueOnFileContent = func(pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"collectTestCommands requires exactly 2 arguments: %w", errInvalid)
+	}
+	data, ok := args[0].(*checker.CITestData)
+	if !ok {
+		return false, fmt.Errorf(
+			"collectTestCommands expects arg[0] of type *checker.CITestData: %w", errInvalid)
+	}
+	dl, ok := args[1].(checker.DetailLogger)
+	if !ok {
+		return false, fmt.Errorf(
+			"collectTestCommands expects arg[1] of type checker.DetailLogger: %w", errInvalid)
+	}
+
+	configFile := checker.File{
+		Path:   pathfn,
+		Type:   finding.FileTypeSource,
+		Offset: checker.OffsetDefault,
+	}
+	system := ciSystemOf(pathfn)
+	if system == ciSystemGitHubActions {
+		workflow, errs := actionlint.Parse(content)
+		if len(errs) > 0 && workflow == nil {
+			// An unparsable workflow doesn't run, so skip it rather than
+			// failing the check.
+			dl.Debug(&checker.LogMessage{
+				Text: fmt.Sprintf("skipping unparsable workflow %s: %v", pathfn, fileparser.FormatActionlintError(errs)),
+			})
+			return true, nil
+		}
+		data.ConfigFiles = append(data.ConfigFiles, configFile)
+		collectWorkflowTestCommands(pathfn, workflow, data)
+		return true, nil
+	}
+	data.ConfigFiles = append(data.ConfigFiles, configFile)
+
+	for i, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "//") {
+			continue
+		}
+		if m := reTestCommand.FindStringSubmatch(line); m != nil {
+			data.TestCommands = append(data.TestCommands, checker.CITestCommand{
+				CISystem: system,
+				Command:  m[1],
+				File: checker.File{
+					Path:    pathfn,
+					Type:    finding.FileTypeSource,
+					Offset:  uint(i + 1),
+					Snippet: trimmed,
+				},
+			})
+		}
+	}
+	return true, nil
+}
+
+// collectWorkflowTestCommands records the `run` steps of the workflow jobs which run tests.
+func collectWorkflowTestCommands(pathfn string, workflow *actionlint.Workflow, data *checker.CITestData) {
+	for _, job := range workflow.Jobs {
+		if job == nil {
+			continue
+		}
+		for _, step := range job.Steps {
+			e, ok := step.Exec.(*actionlint.ExecRun)
+			if !ok || e == nil || e.Run == nil {
+				continue
+			}
+			m := reTestCommand.FindStringSubmatch(e.Run.Value)
+			if m == nil {
+				continue
+			}
+			cmd := checker.CITestCommand{
+				CISystem: ciSystemGitHubActions,
+				Command:  m[1],
+				File: checker.File{
+					Path:   pathfn,
+					Type:   finding.FileTypeSource,
+					Offset: fileparser.GetLineNumber(step.Pos),
+				},
+			}
+			if job.ID != nil {
+				cmd.Job = job.ID.Value
+			}
+			data.TestCommands = append(data.TestCommands, cmd)
+		}
+	}
 }
@@ -0,0 +1,208 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/config"
+	scut "github.com/ossf/scorecard/v5/utests"
+)
+
+const ciTestsWorkflow = `name: CI
+on: [pull_request]
+jobs:
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - run: golangci-lint run
+  unit:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - run: |
+          go mod download
+          go test ./...
+`
+
+const ciTestsJenkinsfile = `pipeline {
+  stages {
+    stage('Test') {
+      steps {
+        // sh 'make test' is too slow
+        sh 'python -m pytest tests/'
+      }
+    }
+  }
+}
+`
+
+func TestCITestsLocal(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  checker.CITestData
+	}{
+		{
+			name: "no CI configuration",
+			files: map[string]string{
+				"main.go": "package main",
+			},
+			want: checker.CITestData{
+				PullRequestsUnavailable: true,
+			},
+		},
+		{
+			name: "GitHub workflow and Jenkinsfile running tests",
+			files: map[string]string{
+				".github/workflows/ci.yml": ciTestsWorkflow,
+				"Jenkinsfile":              ciTestsJenkinsfile,
+			},
+			want: checker.CITestData{
+				PullRequestsUnavailable: true,
+				ConfigFiles: []checker.File{
+					{Path: ".github/workflows/ci.yml", Offset: checker.OffsetDefault},
+					{Path: "Jenkinsfile", Offset: checker.OffsetDefault},
+				},
+				TestCommands: []checker.CITestCommand{
+					{
+						CISystem: "GitHub Actions",
+						Job:      "unit",
+						Command:  "go test",
+						File:     checker.File{Path: ".github/workflows/ci.yml", Offset: 12},
+					},
+					{
+						CISystem: "Jenkins",
+						Command:  "python -m pytest",
+						File:     checker.File{Path: "Jenkinsfile", Offset: 6},
+					},
+				},
+			},
+		},
+		{
+			name: "unparsable workflow",
+			files: map[string]string{
+				".github/workflows/broken.yml": "jobs: [\n",
+				".travis.yml":                  "script:\n  - go test ./...\n",
+			},
+			want: checker.CITestData{
+				PullRequestsUnavailable: true,
+				ConfigFiles: []checker.File{
+					{Path: ".travis.yml", Offset: checker.OffsetDefault},
+				},
+				TestCommands: []checker.CITestCommand{
+					{
+						CISystem: "Travis CI",
+						Command:  "go test",
+						File:     checker.File{Path: ".travis.yml", Offset: 2},
+					},
+				},
+			},
+		},
+		{
+			name: "CI configuration without tests",
+			files: map[string]string{
+				".travis.yml": "script:\n  - make lint\n",
+			},
+			want: checker.CITestData{
+				PullRequestsUnavailable: true,
+				ConfigFiles: []checker.File{
+					{Path: ".travis.yml", Offset: checker.OffsetDefault},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListCommits().Return(nil, clients.ErrUnsupportedFeature)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+				var files []string
+				for file := range tt.files {
+					if ok, err := predicate(file); err == nil && ok {
+						files = append(files, file)
+					}
+				}
+				return files, nil
+			}).AnyTimes()
+			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(tt.files[file])), nil
+			}).AnyTimes()
+
+			c := checker.CheckRequest{
+				RepoClient: mockRepoClient,
+				Config:     &config.Config{},
+				Dlogger:    &scut.TestDetailLogger{},
+			}
+			got, err := CITests(&c)
+			if err != nil {
+				t.Fatalf("CITests() error = %v", err)
+			}
+			less := func(a, b checker.File) bool { return a.Path < b.Path }
+			diff := cmp.Diff(tt.want, got,
+				cmpopts.EquateEmpty(),
+				cmpopts.SortSlices(less),
+				cmpopts.SortSlices(func(a, b checker.CITestCommand) bool { return less(a.File, b.File) }),
+				cmpopts.IgnoreFields(checker.File{}, "Type", "Snippet"),
+			)
+			if diff != "" {
+				t.Errorf("CITests() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_reTestCommand(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		line string
+		want string
+	}{
+		{line: "go test -race ./...", want: "go test"},
+		{line: "  - npm run test", want: "npm run test"},
+		{line: "./gradlew build check", want: "gradlew build check"},
+		{line: "mvn -B verify", want: "mvn -B verify"},
+		{line: "cargo nextest run", want: "cargo nextest"},
+		{line: "make test-unit", want: "make test"},
+		{line: "go build ./...", want: ""},
+		{line: "npm run lint", want: ""},
+		{line: "pip install detox", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			t.Parallel()
+			got := ""
+			if m := reTestCommand.FindStringSubmatch(tt.line); m != nil {
+				got = m[1]
+			}
+			if got != tt.want {
+				t.Errorf("reTestCommand.FindStringSubmatch(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+		})
+	}
+}
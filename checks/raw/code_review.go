@@ -27,6 +27,9 @@ import (
 var (
 	rePhabricatorRevID = regexp.MustCompile(`Differential Revision:[^\r\n]*(D\d+)`)
 	rePiperRevID       = regexp.MustCompile(`PiperOrigin-RevId:\s*(\d{3,})`)
+	reGerritChangeID   = regexp.MustCompile(`(?m)^Change-Id:\s*(I[0-9a-f]{40})\s*$`)
+	reCoAuthoredBy     = regexp.MustCompile(`(?mi)^Co-authored-by:\s*(.*?)\s*<([^>]*)>\s*$`)
+	reNoReplyEmail     = regexp.MustCompile(`^(?:\d+\+)?([^@]+)@users\.noreply\.github\.com$`)
 )
 
 // CodeReview retrieves the raw data for the Code-Review check.
@@ -66,6 +69,32 @@ func getGithubAuthor(c *clients.Commit) (author clients.User) {
 	return c.AssociatedMergeRequest.Author
 }
 
+// getCoAuthors returns the people other than the changeset's author who
+// authored its commits or are credited with Co-authored-by trailers.
+// GitHub no-reply emails identify the login of a co-author, otherwise
+// their name is used.
+func getCoAuthors(cs *checker.Changeset) []string {
+	seen := map[string]bool{strings.ToLower(cs.Author.Login): true}
+	var coAuthors []string
+	add := func(who string) {
+		if who != "" && !seen[strings.ToLower(who)] {
+			seen[strings.ToLower(who)] = true
+			coAuthors = append(coAuthors, who)
+		}
+	}
+	for i := range cs.Commits {
+		add(cs.Commits[i].Author.Login)
+		for _, match := range reCoAuthoredBy.FindAllStringSubmatch(cs.Commits[i].Message, -1) {
+			if login := reNoReplyEmail.FindStringSubmatch(match[2]); login != nil {
+				add(login[1])
+			} else {
+				add(match[1])
+			}
+		}
+	}
+	return coAuthors
+}
+
 func getProwRevisionID(c *clients.Commit) string {
 	mr := c.AssociatedMergeRequest
 	if !c.AssociatedMergeRequest.MergedAt.IsZero() {
@@ -81,10 +110,17 @@ func getProwRevisionID(c *clients.Commit) string {
 
 func getGerritRevisionID(c *clients.Commit) string {
 	m := c.Message
-	if strings.Contains(m, "Reviewed-on:") &&
-		strings.Contains(m, "Reviewed-by:") {
+	if !strings.Contains(m, "Reviewed-by:") {
+		return ""
+	}
+	if strings.Contains(m, "Reviewed-on:") {
 		return c.SHA
 	}
+	// Mirrors of Gerrit projects may lack the Reviewed-on trailer,
+	// but keep the Change-Id identifying the change.
+	if match := reGerritChangeID.FindStringSubmatch(m); len(match) == 2 {
+		return match[1]
+	}
 	return ""
 }
 
@@ -162,8 +198,14 @@ func getChangesets(commits []clients.Commit) []checker.Changeset {
 			}
 
 			if rev.Platform == checker.ReviewPlatformGitHub {
+				mr := &commits[i].AssociatedMergeRequest
 				newChangeset.Reviews = getGithubReviews(&commits[i])
 				newChangeset.Author = getGithubAuthor(&commits[i])
+				newChangeset.MergedBy = mr.MergedBy
+				newChangeset.HeadSHA = mr.HeadSHA
+				newChangeset.ReviewDecision = mr.ReviewDecision
+				newChangeset.CreatedAt = mr.CreatedAt
+				newChangeset.MergedAt = mr.MergedAt
 			}
 
 			changesetsByRevInfo[rev] = newChangeset
@@ -176,7 +218,11 @@ func getChangesets(commits []clients.Commit) []checker.Changeset {
 
 	// Changesets are returned in map order (i.e. randomized)
 	for ri := range changesetsByRevInfo {
-		changesets = append(changesets, changesetsByRevInfo[ri])
+		changeset := changesetsByRevInfo[ri]
+		if changeset.ReviewPlatform == checker.ReviewPlatformGitHub {
+			changeset.CoAuthors = getCoAuthors(&changeset)
+		}
+		changesets = append(changesets, changeset)
 	}
 
 	return changesets
@@ -111,6 +111,10 @@ func Test_getChangesets(t *testing.T) {
 			Message: "followup\nReviewed-on: server.url \nReviewed-by:user-123",
 			SHA:     "def",
 		}
+		gerritMirrorCommit = clients.Commit{
+			Message: "mirrored change\n\nChange-Id: I0123456789abcdef0123456789abcdef01234567\nReviewed-by: user-123",
+			SHA:     "fed",
+		}
 	)
 
 	tests := []struct {
@@ -311,6 +315,17 @@ func Test_getChangesets(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "gerrit: mirror without Reviewed-on",
+			commits: []clients.Commit{gerritMirrorCommit},
+			expected: []checker.Changeset{
+				{
+					ReviewPlatform: checker.ReviewPlatformGerrit,
+					RevisionID:     "I0123456789abcdef0123456789abcdef01234567",
+					Commits:        []clients.Commit{gerritMirrorCommit},
+				},
+			},
+		},
 		{
 			name:    "mixed: phabricator + gh",
 			commits: []clients.Commit{phabricatorCommitA, phabricatorCommitD, commitB, commitBUnsquashed},
@@ -391,6 +406,9 @@ func Test_getChangesets(t *testing.T) {
 			t.Parallel()
 			changesets := getChangesets(tt.commits)
 			if !cmp.Equal(tt.expected, changesets,
+				// Pull request details are covered by Test_getChangesets_pullRequest.
+				cmpopts.IgnoreFields(checker.Changeset{}, "MergedBy", "HeadSHA", "ReviewDecision",
+					"CreatedAt", "MergedAt", "CoAuthors"),
 				cmpopts.SortSlices(func(x, y checker.Changeset) bool {
 					if x.RevisionID == y.RevisionID {
 						return x.ReviewPlatform < y.ReviewPlatform
@@ -406,3 +424,54 @@ func Test_getChangesets(t *testing.T) {
 		})
 	}
 }
+
+func Test_getChangesets_pullRequest(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2023, time.March, 20, 9, 0, 0, 0, time.UTC)
+	merged := time.Date(2023, time.March, 21, 13, 42, 0, 0, time.UTC)
+	pr := clients.PullRequest{
+		Number:         3,
+		HeadSHA:        "head",
+		Author:         clients.User{Login: "alice"},
+		MergedBy:       clients.User{Login: "bob"},
+		CreatedAt:      created,
+		MergedAt:       merged,
+		ReviewDecision: "APPROVED",
+	}
+	commits := []clients.Commit{
+		{
+			SHA:                    "b",
+			Author:                 clients.User{Login: "carol"},
+			Message:                "fix\n\nCo-authored-by: Alice <alice@example.com>\nCo-authored-by: Dan <123+dan@users.noreply.github.com>",
+			AssociatedMergeRequest: pr,
+		},
+		{
+			SHA:                    "a",
+			Author:                 clients.User{Login: "alice"},
+			Message:                "feature\n\nco-authored-by: Erin Doe <erin@example.com>",
+			AssociatedMergeRequest: pr,
+		},
+	}
+	want := []checker.Changeset{
+		{
+			ReviewPlatform: checker.ReviewPlatformGitHub,
+			RevisionID:     "3",
+			Commits:        commits,
+			Reviews: []clients.Review{
+				{Author: &clients.User{Login: "bob"}, State: "APPROVED"},
+			},
+			Author:         clients.User{Login: "alice"},
+			MergedBy:       clients.User{Login: "bob"},
+			HeadSHA:        "head",
+			ReviewDecision: "APPROVED",
+			CreatedAt:      created,
+			MergedAt:       merged,
+			CoAuthors:      []string{"carol", "dan", "Erin Doe"},
+		},
+	}
+
+	got := getChangesets(commits)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getChangesets() mismatch (-want +got):\n%s", diff)
+	}
+}
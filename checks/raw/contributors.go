@@ -15,8 +15,11 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
@@ -62,7 +65,135 @@ func Contributors(cr *checker.CheckRequest) (checker.ContributorsData, error) {
 		users = append(users, user)
 	}
 
-	return checker.ContributorsData{Users: users}, nil
+	activity, err := contributorActivity(c)
+	if err != nil {
+		return checker.ContributorsData{}, err
+	}
+
+	return checker.ContributorsData{Users: users, Activity: activity}, nil
+}
+
+// departureWindow is how long a top author can go without a change before
+// they are considered to have left the project.
+const departureWindow = 90 * 24 * time.Hour
+
+// contributorActivity summarizes who authored, reviewed and merged the recent
+// commits. It returns nil if the client cannot list commits.
+func contributorActivity(c clients.RepoClient) (*checker.ContributorActivity, error) {
+	commits, err := c.ListCommits()
+	if errors.Is(err, clients.ErrUnsupportedFeature) {
+		return nil, nil //nolint:nilnil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Client.Repositories.ListCommits: %w", err)
+	}
+
+	activity := checker.ContributorActivity{}
+	authors := map[string]*checker.AuthorActivity{}
+	reviewers := map[string]bool{}
+	mergers := map[string]bool{}
+	for i := range commits {
+		commit := &commits[i]
+		// The committer isn't a fallback for a missing author: it is often
+		// the forge itself, e.g. GitHub's web-flow for bot and unlinked-email
+		// commits.
+		author := commit.Author
+		if author.Login == "" || author.IsBot {
+			continue
+		}
+		activity.Changes++
+		date := commit.CommittedDate
+		if activity.Since.IsZero() || date.Before(activity.Since) {
+			activity.Since = date
+		}
+		if date.After(activity.Until) {
+			activity.Until = date
+		}
+
+		a, ok := authors[author.Login]
+		if !ok {
+			a = &checker.AuthorActivity{
+				Login:        author.Login,
+				Organization: authorOrganization(&author),
+				FirstChange:  date,
+				LastChange:   date,
+			}
+			authors[author.Login] = a
+		}
+		a.Changes++
+		if date.Before(a.FirstChange) {
+			a.FirstChange = date
+		}
+		if date.After(a.LastChange) {
+			a.LastChange = date
+		}
+
+		mr := &commit.AssociatedMergeRequest
+		for _, review := range mr.Reviews {
+			if review.Author == nil || review.Author.IsBot || review.Author.Login == "" ||
+				review.Author.Login == mr.Author.Login {
+				continue
+			}
+			reviewers[review.Author.Login] = true
+		}
+		if mr.MergedBy.Login != "" && !mr.MergedBy.IsBot {
+			mergers[mr.MergedBy.Login] = true
+		}
+	}
+
+	for _, a := range authors {
+		activity.Authors = append(activity.Authors, *a)
+	}
+	sort.Slice(activity.Authors, func(i, j int) bool {
+		if activity.Authors[i].Changes != activity.Authors[j].Changes {
+			return activity.Authors[i].Changes > activity.Authors[j].Changes
+		}
+		return activity.Authors[i].Login < activity.Authors[j].Login
+	})
+	activity.BusFactor50 = busFactor(activity.Authors, activity.Changes, 50)
+	activity.BusFactor80 = busFactor(activity.Authors, activity.Changes, 80)
+	activity.Reviewers = sortedKeys(reviewers)
+	activity.Mergers = sortedKeys(mergers)
+
+	cutoff := activity.Until.Add(-departureWindow)
+	for _, a := range activity.Authors[:activity.BusFactor80] {
+		if a.LastChange.Before(cutoff) {
+			activity.DepartedContributors = append(activity.DepartedContributors, a)
+		}
+	}
+	return &activity, nil
+}
+
+// busFactor returns the minimum number of authors, taken in descending order
+// of changes, who together made at least percent of the changes.
+func busFactor(authors []checker.AuthorActivity, changes, percent int) int {
+	covered := 0
+	for i, a := range authors {
+		covered += a.Changes
+		if covered*100 >= changes*percent {
+			return i + 1
+		}
+	}
+	return len(authors)
+}
+
+// authorOrganization returns the organization an author belongs to, if known.
+func authorOrganization(u *clients.User) string {
+	for _, company := range u.Companies {
+		if company = strings.TrimLeft(strings.TrimSpace(strings.ToLower(company)), "@"); company != "" {
+			return company
+		}
+	}
+	return ""
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func companyContains(cs []string, name string) bool {
@@ -15,6 +15,7 @@ package raw
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
@@ -130,6 +131,7 @@ func TestContributors(t *testing.T) {
 	}
 
 	mockRepoClient.EXPECT().ListContributors().Return(contributors, nil)
+	mockRepoClient.EXPECT().ListCommits().Return(nil, clients.ErrUnsupportedFeature)
 	req := &checker.CheckRequest{
 		RepoClient: mockRepoClient,
 	}
@@ -162,4 +164,128 @@ func TestContributors(t *testing.T) {
 	if diff := cmp.Diff(expectedUsers, data.Users); diff != "" {
 		t.Errorf("unexpected contributors data (-want +got):\n%s", diff)
 	}
+	if data.Activity != nil {
+		t.Errorf("expected no activity, got %v", data.Activity)
+	}
+}
+
+func TestContributorActivity(t *testing.T) {
+	t.Parallel()
+	until := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	commit := func(login string, age time.Duration, mr clients.PullRequest) clients.Commit {
+		return clients.Commit{
+			CommittedDate:          until.Add(-age),
+			Author:                 clients.User{Login: login},
+			AssociatedMergeRequest: mr,
+		}
+	}
+	reviewed := clients.PullRequest{
+		Author:   clients.User{Login: "alice"},
+		MergedBy: clients.User{Login: "bob"},
+		Reviews: []clients.Review{
+			{Author: &clients.User{Login: "bob"}, State: "APPROVED"},
+			{Author: &clients.User{Login: "alice"}, State: "COMMENTED"},
+			{Author: &clients.User{Login: "dependabot[bot]", IsBot: true}, State: "APPROVED"},
+		},
+	}
+	commits := []clients.Commit{
+		commit("alice", 0, reviewed),
+		commit("alice", day, clients.PullRequest{}),
+		commit("alice", 2*day, clients.PullRequest{}),
+		commit("alice", 3*day, clients.PullRequest{}),
+		commit("alice", 4*day, clients.PullRequest{}),
+		commit("carol", 100*day, clients.PullRequest{MergedBy: clients.User{Login: "carol"}}),
+		commit("carol", 120*day, clients.PullRequest{}),
+		commit("carol", 150*day, clients.PullRequest{}),
+		commit("dave", 10*day, clients.PullRequest{}),
+		{
+			CommittedDate: until.Add(-5 * day),
+			Author:        clients.User{Login: "renovate[bot]", IsBot: true},
+		},
+		{
+			CommittedDate: until.Add(-6 * day),
+			Author:        clients.User{Login: "erin@corp.example", Companies: []string{"Corp.Example"}},
+		},
+		{
+			// A GitHub commit without a linked author, committed by web-flow.
+			CommittedDate: until.Add(-7 * day),
+			Committer:     clients.User{Login: "web-flow"},
+		},
+		{
+			CommittedDate: until.Add(-8 * day),
+			Author:        clients.User{Login: "github-actions[bot]", IsBot: true},
+			Committer:     clients.User{Login: "github"},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListCommits().Return(commits, nil)
+
+	got, err := contributorActivity(mockRepoClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	carol := checker.AuthorActivity{
+		Login:       "carol",
+		Changes:     3,
+		FirstChange: until.Add(-150 * day),
+		LastChange:  until.Add(-100 * day),
+	}
+	want := &checker.ContributorActivity{
+		Since: until.Add(-150 * day),
+		Until: until,
+		Authors: []checker.AuthorActivity{
+			{Login: "alice", Changes: 5, FirstChange: until.Add(-4 * day), LastChange: until},
+			carol,
+			{Login: "dave", Changes: 1, FirstChange: until.Add(-10 * day), LastChange: until.Add(-10 * day)},
+			{
+				Login:        "erin@corp.example",
+				Organization: "corp.example",
+				Changes:      1,
+				FirstChange:  until.Add(-6 * day),
+				LastChange:   until.Add(-6 * day),
+			},
+		},
+		Reviewers:            []string{"bob"},
+		Mergers:              []string{"bob", "carol"},
+		DepartedContributors: []checker.AuthorActivity{carol},
+		Changes:              10,
+		BusFactor50:          1,
+		BusFactor80:          2,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected activity (-want +got):\n%s", diff)
+	}
+}
+
+func TestBusFactor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		changes []int
+		percent int
+		want    int
+	}{
+		{name: "no authors", percent: 50, want: 0},
+		{name: "single author", changes: []int{4}, percent: 80, want: 1},
+		{name: "even split", changes: []int{1, 1, 1, 1}, percent: 50, want: 2},
+		{name: "even split 80", changes: []int{1, 1, 1, 1, 1}, percent: 80, want: 4},
+		{name: "dominant author", changes: []int{8, 1, 1}, percent: 80, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var authors []checker.AuthorActivity
+			total := 0
+			for _, c := range tt.changes {
+				authors = append(authors, checker.AuthorActivity{Changes: c})
+				total += c
+			}
+			if got := busFactor(authors, total, tt.percent); got != tt.want {
+				t.Errorf("busFactor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
 }
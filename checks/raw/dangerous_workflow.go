@@ -17,6 +17,7 @@ package raw
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/rhysd/actionlint"
@@ -68,14 +69,131 @@ var (
 func DangerousWorkflow(c *checker.CheckRequest) (checker.DangerousWorkflowData, error) {
 	// data is shared across all GitHub workflows.
 	var data checker.DangerousWorkflowData
+	patternsData := workflowPatternsData{
+		results: &data,
+		owner:   repositoryOwner(c),
+		private: isPrivateRepository(c),
+	}
 	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
 		Pattern:       ".github/workflows/*",
 		CaseSensitive: false,
-	}, validateGitHubActionWorkflowPatterns, &data)
+	}, validateGitHubActionWorkflowPatterns, &patternsData)
+	if err != nil {
+		return data, err
+	}
+
+	graph, err := fileparser.NewWorkflowGraph(c.RepoClient)
+	if err != nil {
+		return data, err
+	}
+	if err := validateWorkflowCalls(graph, &data); err != nil {
+		return data, err
+	}
 
+	err = fileparser.OnAzurePipelinesFileContentDo(c.RepoClient, validateAzurePipelinesPatterns, &data)
 	return data, err
 }
 
+// Azure Pipelines variables that may be attacker controlled.
+// See https://learn.microsoft.com/en-us/azure/devops/pipelines/build/variables.
+var azureUntrustedVariables = []string{
+	"system.pullrequest.sourcebranch",
+	"system.pullrequest.sourcebranchname",
+	"system.pullrequest.sourcerepositoryuri",
+	"build.sourceversionmessage",
+	"build.sourceversionauthor",
+	"build.requestedfor",
+	"build.requestedforemail",
+}
+
+// Macro `$(var)`, template `${{ expr }}` and runtime `$[ expr ]` expressions
+// are all expanded before the script runs.
+var reAzureExpression = regexp.MustCompile(`\$\([^)]+\)|\$\{\{.+?\}\}|\$\[.+?\]`)
+
+func containsAzureUntrustedVariable(expression string) bool {
+	expression = strings.ToLower(expression)
+	for _, v := range azureUntrustedVariables {
+		// Also matches the `variables['name']` form.
+		if i := strings.Index(expression, v); i >= 0 {
+			end := i + len(v)
+			if end == len(expression) || !isAzureVariableChar(expression[end]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isAzureVariableChar(c byte) bool {
+	return c == '.' || c == '_' || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9')
+}
+
+// validateAzurePipelinesPatterns checks Azure Pipelines inline scripts for
+// attacker-controlled variables.
+var validateAzurePipelinesPatterns fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsAzurePipelinesFile(path) {
+		return true, nil
+	}
+
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"validateAzurePipelinesPatterns requires exactly 1 arguments: %w", errInvalidArgLength)
+	}
+	pdata, ok := args[0].(*checker.DangerousWorkflowData)
+	if !ok {
+		return false, fmt.Errorf(
+			"validateAzurePipelinesPatterns expects arg[0] of type *checker.DangerousWorkflowData: %w", errInvalidArgType)
+	}
+
+	if !fileparser.CheckFileContainsCommands(content, "#") {
+		return true, nil
+	}
+
+	pipeline, err := fileparser.ParseAzurePipeline(content)
+	if err != nil || len(pipeline.Jobs) == 0 {
+		// Not a pipeline, or a template without jobs or steps.
+		return true, nil //nolint:nilerr
+	}
+	pdata.NumWorkflows += 1
+
+	for _, job := range pipeline.Jobs {
+		for _, step := range job.Steps {
+			for _, expr := range reAzureExpression.FindAllString(step.Script, -1) {
+				if !containsAzureUntrustedVariable(expr) {
+					continue
+				}
+				pdata.Workflows = append(pdata.Workflows,
+					checker.DangerousWorkflow{
+						File: checker.File{
+							Path:    path,
+							Type:    finding.FileTypeSource,
+							Offset:  step.ScriptLine,
+							Snippet: expr,
+						},
+						Job:  createAzureJob(job),
+						Type: checker.DangerousWorkflowScriptInjection,
+					},
+				)
+			}
+		}
+	}
+	return true, nil
+}
+
+func createAzureJob(job *fileparser.AzureJob) *checker.WorkflowJob {
+	var r checker.WorkflowJob
+	if job.ID != "" {
+		r.ID = &job.ID
+	}
+	if job.DisplayName != "" {
+		r.Name = &job.DisplayName
+	}
+	return &r
+}
+
 // Check file content.
 var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = func(path string,
 	content []byte,
@@ -91,11 +209,12 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 	}
 
 	// Verify the type of the data.
-	pdata, ok := args[0].(*checker.DangerousWorkflowData)
+	data, ok := args[0].(*workflowPatternsData)
 	if !ok {
 		return false, fmt.Errorf(
-			"validateGitHubActionWorkflowPatterns expects arg[0] of type *patternCbData: %w", errInvalidArgType)
+			"validateGitHubActionWorkflowPatterns expects arg[0] of type *workflowPatternsData: %w", errInvalidArgType)
 	}
+	pdata := data.results
 
 	if !fileparser.CheckFileContainsCommands(content, "#") {
 		return true, nil
@@ -118,7 +237,9 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return false, err
 	}
 
-	// TODO: Check other dangerous patterns.
+	// 3. Check for the other dangerous patterns.
+	validateAdditionalPatterns(workflow, path, data)
+
 	return true, nil
 }
 
@@ -226,7 +347,8 @@ func validateScriptInjection(workflow *actionlint.Workflow, path string,
 				continue
 			}
 			// Check Run *String for user-controllable (untrustworthy) properties.
-			if err := checkVariablesInScript(run.Run.Value, run.Run.Pos, job, path, pdata); err != nil {
+			if err := checkVariablesInScript(run.Run.Value, fileparser.GetLineNumber(run.Run.Pos), job, path,
+				containsUntrustedContextPattern, nil, pdata); err != nil {
 				return err
 			}
 		}
@@ -234,8 +356,9 @@ func validateScriptInjection(workflow *actionlint.Workflow, path string,
 	return nil
 }
 
-func checkVariablesInScript(script string, pos *actionlint.Pos,
+func checkVariablesInScript(script string, line uint,
 	job *actionlint.Job, path string,
+	untrusted func(string) bool, caller *checker.WorkflowCall,
 	pdata *checker.DangerousWorkflowData,
 ) error {
 	for {
@@ -251,8 +374,7 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 
 		// Check if the variable may be untrustworthy.
 		variable := script[s+3 : s+e]
-		if containsUntrustedContextPattern(variable) {
-			line := fileparser.GetLineNumber(pos)
+		if untrusted(variable) {
 			pdata.Workflows = append(pdata.Workflows,
 				checker.DangerousWorkflow{
 					File: checker.File{
@@ -261,8 +383,9 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 						Offset:  line,
 						Snippet: variable,
 					},
-					Job:  createJob(job),
-					Type: checker.DangerousWorkflowScriptInjection,
+					Job:    createJob(job),
+					Caller: caller,
+					Type:   checker.DangerousWorkflowScriptInjection,
 				},
 			)
 		}
@@ -270,3 +393,203 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 	}
 	return nil
 }
+
+var reInputsContext = regexp.MustCompile(`\binputs\.([\w-]+)`)
+
+// validateWorkflowCalls checks local reusable workflows and composite actions in the context
+// of their callers: scripts of composite actions, scripts using inputs set from untrusted
+// contexts, and untrusted code checkouts in workflows called by pull_request_target or
+// workflow_run workflows.
+func validateWorkflowCalls(g *fileparser.WorkflowGraph, pdata *checker.DangerousWorkflowData) error {
+	// Composite actions aren't analyzed on their own: check their scripts for untrusted contexts.
+	for _, p := range sortedActionPaths(g) {
+		var caller *checker.WorkflowCall
+		if callers := g.Callers(p); len(callers) > 0 {
+			caller = createWorkflowCall(callers[:1])
+		}
+		for _, step := range g.Actions[p].Steps {
+			if step.Run == "" {
+				continue
+			}
+			if err := checkVariablesInScript(step.Run, step.RunLine, nil, p,
+				containsUntrustedContextPattern, caller, pdata); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, p := range sortedWorkflowPaths(g) {
+		if err := validateTaintedInputs(g, p, nil, nil, map[string]bool{p: true}, pdata); err != nil {
+			return err
+		}
+
+		workflow := g.Workflows[p]
+		if !usesEventTrigger(workflow, triggerPullRequestTarget) && !usesEventTrigger(workflow, triggerWorkflowRun) {
+			continue
+		}
+		var err error
+		g.Walk(p, func(chain []*fileparser.WorkflowCall) {
+			if err != nil {
+				return
+			}
+			caller := createWorkflowCall(chain)
+			callee := chain[len(chain)-1].Callee
+			start := len(pdata.Workflows)
+			if w, ok := g.Workflows[callee]; ok {
+				for _, job := range w.Jobs {
+					if err = checkJobForUntrustedCodeCheckout(job, callee, pdata); err != nil {
+						return
+					}
+				}
+			}
+			if action, ok := g.Actions[callee]; ok {
+				checkCompositeActionForUntrustedCodeCheckout(action, pdata)
+			}
+			for i := start; i < len(pdata.Workflows); i++ {
+				pdata.Workflows[i].Caller = caller
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTaintedInputs checks the scripts of the callees of a file for inputs set from
+// untrusted contexts, or from inputs of the file which are themselves tainted.
+func validateTaintedInputs(g *fileparser.WorkflowGraph, pathfn string, tainted map[string]bool,
+	origin *fileparser.WorkflowCall, visiting map[string]bool, pdata *checker.DangerousWorkflowData,
+) error {
+	untrusted := func(variable string) bool {
+		if containsUntrustedContextPattern(variable) {
+			return true
+		}
+		m := reInputsContext.FindStringSubmatch(variable)
+		return m != nil && tainted[strings.ToLower(m[1])]
+	}
+	for _, call := range g.Calls(pathfn) {
+		if visiting[call.Callee] {
+			continue
+		}
+		calleeTainted := make(map[string]bool)
+		for name, value := range call.With {
+			for _, expr := range reGitHubExpression.FindAllString(value, -1) {
+				if untrusted(expr) {
+					calleeTainted[name] = true
+				}
+			}
+		}
+		if len(calleeTainted) == 0 {
+			continue
+		}
+		first := origin
+		if first == nil {
+			first = call
+		}
+		caller := createWorkflowCall([]*fileparser.WorkflowCall{first})
+		isTainted := func(variable string) bool {
+			m := reInputsContext.FindStringSubmatch(variable)
+			return m != nil && calleeTainted[strings.ToLower(m[1])]
+		}
+		if w, ok := g.Workflows[call.Callee]; ok {
+			for _, job := range w.Jobs {
+				if job == nil {
+					continue
+				}
+				for _, step := range job.Steps {
+					run, ok := step.Exec.(*actionlint.ExecRun)
+					if !ok || run == nil || run.Run == nil {
+						continue
+					}
+					if err := checkVariablesInScript(run.Run.Value, fileparser.GetLineNumber(run.Run.Pos), job,
+						call.Callee, isTainted, caller, pdata); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		if action, ok := g.Actions[call.Callee]; ok {
+			for _, step := range action.Steps {
+				if step.Run == "" {
+					continue
+				}
+				if err := checkVariablesInScript(step.Run, step.RunLine, nil,
+					call.Callee, isTainted, caller, pdata); err != nil {
+					return err
+				}
+			}
+		}
+		visiting[call.Callee] = true
+		err := validateTaintedInputs(g, call.Callee, calleeTainted, first, visiting, pdata)
+		delete(visiting, call.Callee)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var reGitHubExpression = regexp.MustCompile(`\$\{\{.*?\}\}`)
+
+func checkCompositeActionForUntrustedCodeCheckout(action *fileparser.CompositeAction,
+	pdata *checker.DangerousWorkflowData,
+) {
+	for _, step := range action.Steps {
+		if !strings.Contains(step.Uses, "actions/checkout") {
+			continue
+		}
+		ref := step.With["ref"]
+		if strings.Contains(ref, checkoutUntrustedPullRequestRef) ||
+			strings.Contains(ref, checkoutUntrustedWorkflowRunRef) {
+			pdata.Workflows = append(pdata.Workflows,
+				checker.DangerousWorkflow{
+					Type: checker.DangerousWorkflowUntrustedCheckout,
+					File: checker.File{
+						Path:    action.Path,
+						Type:    finding.FileTypeSource,
+						Offset:  step.Line,
+						Snippet: ref,
+					},
+				},
+			)
+		}
+	}
+}
+
+// createWorkflowCall returns the first call of a chain, with the secrets
+// inherited through the whole chain.
+func createWorkflowCall(chain []*fileparser.WorkflowCall) *checker.WorkflowCall {
+	inherit := true
+	for _, call := range chain {
+		inherit = inherit && call.InheritSecrets
+	}
+	first := chain[0]
+	return &checker.WorkflowCall{
+		File: checker.File{
+			Path:    first.Caller,
+			Type:    finding.FileTypeSource,
+			Offset:  first.Line,
+			Snippet: first.Uses,
+		},
+		InheritSecrets: inherit,
+	}
+}
+
+func sortedActionPaths(g *fileparser.WorkflowGraph) []string {
+	paths := make([]string, 0, len(g.Actions))
+	for p := range g.Actions {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func sortedWorkflowPaths(g *fileparser.WorkflowGraph) []string {
+	paths := make([]string, 0, len(g.Workflows))
+	for p := range g.Workflows {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
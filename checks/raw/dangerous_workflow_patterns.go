@@ -0,0 +1,458 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+var (
+	triggerPullRequest  = triggerName("pull_request")
+	triggerIssueComment = triggerName("issue_comment")
+)
+
+// workflowPatternsData is the data shared by the checks of the GitHub workflows.
+type workflowPatternsData struct {
+	results *checker.DangerousWorkflowData
+	// owner is the owner of the repository, used to tell third-party reusable workflows apart.
+	owner string
+	// private is whether the repository is known to be hidden from the public, whose
+	// pull requests then come from trusted contributors.
+	private bool
+}
+
+// isPrivateRepository returns whether the repository is known to be hidden from the
+// public. Unknown visibility is treated as public.
+func isPrivateRepository(c *checker.CheckRequest) bool {
+	private, err := c.RepoClient.IsPrivate()
+	if err != nil {
+		if !errors.Is(err, clients.ErrUnsupportedFeature) {
+			c.Dlogger.Debug(&checker.LogMessage{Text: fmt.Sprintf("IsPrivate: %v", err)})
+		}
+		return false
+	}
+	return private
+}
+
+// repositoryOwner returns the owner of a repository hosted on a forge, or an empty
+// string if unknown.
+func repositoryOwner(c *checker.CheckRequest) string {
+	if c.Repo == nil {
+		return ""
+	}
+	parts := strings.Split(c.Repo.URI(), "/")
+	if len(parts) != 3 {
+		return ""
+	}
+	return parts[1]
+}
+
+// validateAdditionalPatterns checks a workflow for the dangerous patterns besides untrusted
+// code checkouts and script injections.
+func validateAdditionalPatterns(workflow *actionlint.Workflow, path string, data *workflowPatternsData) {
+	pdata := data.results
+	privileged := usesEventTrigger(workflow, triggerPullRequestTarget) || usesEventTrigger(workflow, triggerWorkflowRun)
+	for _, id := range sortedJobIDs(workflow) {
+		job := workflow.Jobs[id]
+		if privileged {
+			checkJobForCachePoisoning(job, path, pdata)
+		}
+		if usesEventTrigger(workflow, triggerWorkflowRun) {
+			checkJobForArtifactDownload(job, path, pdata)
+		}
+		if usesEventTrigger(workflow, triggerPullRequest) && !data.private {
+			checkJobForSelfHostedRunner(job, path, pdata)
+		}
+		if usesEventTrigger(workflow, triggerIssueComment) && isPrivilegedCommentJob(job) &&
+			!isGuardedByAuthorAssociation(workflow, job, nil) {
+			pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+				Type: checker.DangerousWorkflowUnguardedIssueComment,
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  fileparser.GetLineNumber(job.Pos),
+					Snippet: id,
+				},
+				Job: createJob(job),
+			})
+		}
+		checkJobForThirdPartySecrets(job, path, data)
+		checkJobForGitHubScriptInjection(job, path, pdata)
+		checkJobForEnvironmentFileInjection(workflow, job, path, pdata)
+	}
+}
+
+func sortedJobIDs(workflow *actionlint.Workflow) []string {
+	ids := make([]string, 0, len(workflow.Jobs))
+	for id, job := range workflow.Jobs {
+		if job != nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func stepAction(step *actionlint.Step) (*actionlint.ExecAction, bool) {
+	if step == nil {
+		return nil, false
+	}
+	e, ok := step.Exec.(*actionlint.ExecAction)
+	if !ok || e.Uses == nil {
+		return nil, false
+	}
+	return e, true
+}
+
+// actionName returns the name of an action without its version, e.g. `actions/cache/save`.
+func actionName(uses string) string {
+	name, _, _ := strings.Cut(uses, "@")
+	return strings.ToLower(name)
+}
+
+func actionInput(e *actionlint.ExecAction, name string) (string, uint, bool) {
+	input, ok := e.Inputs[name]
+	if !ok || input == nil || input.Value == nil {
+		return "", 0, false
+	}
+	return input.Value.Value, fileparser.GetLineNumber(input.Value.Pos), true
+}
+
+// savesCache returns true if a step saves a cache, which is then restored by the workflows
+// of the default branch.
+func savesCache(e *actionlint.ExecAction) bool {
+	name := actionName(e.Uses.Value)
+	switch {
+	case name == "actions/cache" || name == "actions/cache/save":
+		return true
+	case strings.HasPrefix(name, "actions/setup-"):
+		// The setup actions cache the dependencies when their `cache` input is set.
+		value, _, ok := actionInput(e, "cache")
+		return ok && value != "" && value != "false"
+	}
+	return false
+}
+
+// checkJobForCachePoisoning looks for caches saved after checking out untrusted code in
+// privileged workflows: the untrusted code can write to the cache, which is shared with
+// the workflows of the default branch.
+func checkJobForCachePoisoning(job *actionlint.Job, path string, pdata *checker.DangerousWorkflowData) {
+	var checkedOut bool
+	for _, step := range job.Steps {
+		e, ok := stepAction(step)
+		if !ok {
+			continue
+		}
+		if strings.Contains(e.Uses.Value, "actions/checkout") {
+			if ref, _, ok := actionInput(e, "ref"); ok &&
+				(strings.Contains(ref, checkoutUntrustedPullRequestRef) ||
+					strings.Contains(ref, checkoutUntrustedWorkflowRunRef)) {
+				checkedOut = true
+			}
+			continue
+		}
+		if checkedOut && savesCache(e) {
+			pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+				Type: checker.DangerousWorkflowArtifactPoisoning,
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  fileparser.GetLineNumber(step.Pos),
+					Snippet: e.Uses.Value,
+				},
+				Job: createJob(job),
+			})
+		}
+	}
+}
+
+var reGHRunDownload = regexp.MustCompile(`\bgh\s+run\s+download\b`)
+
+// checkJobForArtifactDownload looks for artifacts downloaded from the run which triggered a
+// workflow_run workflow: the run may have been triggered by an untrusted pull request.
+func checkJobForArtifactDownload(job *actionlint.Job, path string, pdata *checker.DangerousWorkflowData) {
+	for _, step := range job.Steps {
+		if step == nil {
+			continue
+		}
+		var snippet string
+		switch e := step.Exec.(type) {
+		case *actionlint.ExecAction:
+			if e.Uses == nil {
+				continue
+			}
+			name := actionName(e.Uses.Value)
+			_, _, fromRun := actionInput(e, "run-id")
+			script, _, _ := actionInput(e, "script")
+			if (name == "actions/download-artifact" && fromRun) ||
+				name == "dawidd6/action-download-artifact" ||
+				(name == "actions/github-script" && strings.Contains(script, "downloadArtifact")) {
+				snippet = e.Uses.Value
+			}
+		case *actionlint.ExecRun:
+			if e.Run != nil {
+				snippet = reGHRunDownload.FindString(e.Run.Value)
+			}
+		}
+		if snippet == "" {
+			continue
+		}
+		pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+			Type: checker.DangerousWorkflowArtifactPoisoning,
+			File: checker.File{
+				Path:    path,
+				Type:    finding.FileTypeSource,
+				Offset:  fileparser.GetLineNumber(step.Pos),
+				Snippet: snippet,
+			},
+			Job: createJob(job),
+		})
+	}
+}
+
+// checkJobForSelfHostedRunner looks for self-hosted runners running pull_request workflows
+// of public repositories, which run the code of pull requests from forks on the runner.
+func checkJobForSelfHostedRunner(job *actionlint.Job, path string, pdata *checker.DangerousWorkflowData) {
+	if job.RunsOn == nil {
+		return
+	}
+	for _, label := range job.RunsOn.Labels {
+		if label == nil || !strings.EqualFold(label.Value, "self-hosted") {
+			continue
+		}
+		pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+			Type: checker.DangerousWorkflowSelfHostedRunner,
+			File: checker.File{
+				Path:    path,
+				Type:    finding.FileTypeSource,
+				Offset:  fileparser.GetLineNumber(label.Pos),
+				Snippet: label.Value,
+			},
+			Job: createJob(job),
+		})
+		return
+	}
+}
+
+var (
+	reSecretsContext = regexp.MustCompile(`\bsecrets\.([\w-]+)`)
+	reGHPRCheckout   = regexp.MustCompile(`\bgh\s+pr\s+checkout\b`)
+)
+
+// isPrivilegedCommentJob returns true if a job of an issue_comment workflow uses secrets
+// other than GITHUB_TOKEN, or checks out the code of a pull request.
+func isPrivilegedCommentJob(job *actionlint.Job) bool {
+	var texts []string
+	for _, step := range job.Steps {
+		if step == nil {
+			continue
+		}
+		switch e := step.Exec.(type) {
+		case *actionlint.ExecAction:
+			if e.Uses == nil {
+				continue
+			}
+			if ref, _, ok := actionInput(e, "ref"); ok && strings.Contains(e.Uses.Value, "actions/checkout") &&
+				(strings.Contains(ref, "github.event.issue") || strings.Contains(ref, "refs/pull/")) {
+				return true
+			}
+			for _, input := range e.Inputs {
+				if input != nil && input.Value != nil {
+					texts = append(texts, input.Value.Value)
+				}
+			}
+		case *actionlint.ExecRun:
+			if e.Run == nil {
+				continue
+			}
+			if reGHPRCheckout.MatchString(e.Run.Value) {
+				return true
+			}
+			texts = append(texts, e.Run.Value)
+		}
+		if step.Env != nil {
+			for _, v := range step.Env.Vars {
+				if v != nil && v.Value != nil {
+					texts = append(texts, v.Value.Value)
+				}
+			}
+		}
+	}
+	if job.Env != nil {
+		for _, v := range job.Env.Vars {
+			if v != nil && v.Value != nil {
+				texts = append(texts, v.Value.Value)
+			}
+		}
+	}
+	for _, text := range texts {
+		for _, m := range reSecretsContext.FindAllStringSubmatch(text, -1) {
+			if !strings.EqualFold(m[1], "GITHUB_TOKEN") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isGuardedByAuthorAssociation returns true if a job, or a job it needs, only runs for
+// some author associations of the comment, e.g. members of the repository.
+func isGuardedByAuthorAssociation(workflow *actionlint.Workflow, job *actionlint.Job, seen map[string]bool) bool {
+	if job.If != nil && strings.Contains(job.If.Value, "author_association") {
+		return true
+	}
+	if seen == nil {
+		seen = make(map[string]bool)
+	}
+	for _, need := range job.Needs {
+		if need == nil || seen[need.Value] {
+			continue
+		}
+		seen[need.Value] = true
+		if needed, ok := workflow.Jobs[strings.ToLower(need.Value)]; ok && needed != nil &&
+			isGuardedByAuthorAssociation(workflow, needed, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkJobForThirdPartySecrets looks for reusable workflows of other owners which get all the
+// secrets of the repository with `secrets: inherit`.
+func checkJobForThirdPartySecrets(job *actionlint.Job, path string, data *workflowPatternsData) {
+	call := job.WorkflowCall
+	if call == nil || call.Uses == nil || !call.InheritSecrets || strings.HasPrefix(call.Uses.Value, "./") {
+		return
+	}
+	owner, _, _ := strings.Cut(call.Uses.Value, "/")
+	if data.owner != "" && strings.EqualFold(owner, data.owner) {
+		return
+	}
+	data.results.Workflows = append(data.results.Workflows, checker.DangerousWorkflow{
+		Type: checker.DangerousWorkflowSecretsInherit,
+		File: checker.File{
+			Path:    path,
+			Type:    finding.FileTypeSource,
+			Offset:  fileparser.GetLineNumber(call.Uses.Pos),
+			Snippet: call.Uses.Value,
+		},
+		Job: createJob(job),
+	})
+}
+
+// checkJobForGitHubScriptInjection looks for untrusted contexts in the scripts of
+// actions/github-script, which are evaluated as JavaScript.
+func checkJobForGitHubScriptInjection(job *actionlint.Job, path string, pdata *checker.DangerousWorkflowData) {
+	for _, step := range job.Steps {
+		e, ok := stepAction(step)
+		if !ok || actionName(e.Uses.Value) != "actions/github-script" {
+			continue
+		}
+		script, line, ok := actionInput(e, "script")
+		if !ok {
+			continue
+		}
+		for _, expr := range reGitHubExpression.FindAllString(script, -1) {
+			variable := strings.TrimSuffix(strings.TrimPrefix(expr, "${{"), "}}")
+			if !containsUntrustedContextPattern(variable) {
+				continue
+			}
+			pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+				Type: checker.DangerousWorkflowGitHubScriptInjection,
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  line,
+					Snippet: variable,
+				},
+				Job: createJob(job),
+			})
+		}
+	}
+}
+
+var (
+	reEnvironmentFile = regexp.MustCompile(`\$\{?GITHUB_(ENV|OUTPUT|PATH)\b`)
+	reShellVariable   = regexp.MustCompile(`\$\{?([A-Za-z_][A-Za-z0-9_]*)`)
+)
+
+// untrustedEnvVariables returns the names of the environment variables set from untrusted
+// contexts, from the most global to the most local scope.
+func untrustedEnvVariables(envs ...*actionlint.Env) map[string]bool {
+	untrusted := make(map[string]bool)
+	for _, env := range envs {
+		if env == nil {
+			continue
+		}
+		for _, v := range env.Vars {
+			if v == nil || v.Name == nil || v.Value == nil {
+				continue
+			}
+			untrusted[v.Name.Value] = containsUntrustedContextPattern(v.Value.Value)
+		}
+	}
+	return untrusted
+}
+
+// checkJobForEnvironmentFileInjection looks for untrusted data written to the GITHUB_ENV,
+// GITHUB_OUTPUT and GITHUB_PATH files through environment variables, which sets the
+// environment of the next steps or the outputs used by other jobs. Untrusted contexts used
+// directly in the scripts are reported as script injections.
+func checkJobForEnvironmentFileInjection(workflow *actionlint.Workflow, job *actionlint.Job, path string,
+	pdata *checker.DangerousWorkflowData,
+) {
+	for _, step := range job.Steps {
+		if step == nil {
+			continue
+		}
+		run, ok := step.Exec.(*actionlint.ExecRun)
+		if !ok || run.Run == nil {
+			continue
+		}
+		untrusted := untrustedEnvVariables(workflow.Env, job.Env, step.Env)
+		for _, line := range strings.Split(run.Run.Value, "\n") {
+			if !reEnvironmentFile.MatchString(line) {
+				continue
+			}
+			for _, m := range reShellVariable.FindAllStringSubmatch(line, -1) {
+				if !untrusted[m[1]] {
+					continue
+				}
+				pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+					Type: checker.DangerousWorkflowEnvironmentFileInjection,
+					File: checker.File{
+						Path:    path,
+						Type:    finding.FileTypeSource,
+						Offset:  fileparser.GetLineNumber(run.Run.Pos),
+						Snippet: strings.TrimSpace(line),
+					},
+					Job: createJob(job),
+				})
+				break
+			}
+		}
+	}
+}
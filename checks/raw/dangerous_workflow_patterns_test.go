@@ -0,0 +1,69 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"testing"
+
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestThirdPartySecretsInherit(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("../testdata/.github/workflows/github-workflow-dangerous-pattern-secrets-inherit.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	tests := []struct {
+		name  string
+		owner string
+		want  int
+	}{
+		{
+			name: "unknown owner",
+			want: 1,
+		},
+		{
+			name:  "other owner",
+			owner: "ossf",
+			want:  1,
+		},
+		{
+			name:  "same owner",
+			owner: "Other-Org",
+			want:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var results checker.DangerousWorkflowData
+			validateAdditionalPatterns(workflow, "release.yml", &workflowPatternsData{
+				results: &results,
+				owner:   tt.owner,
+			})
+			if got := len(results.Workflows); got != tt.want {
+				t.Errorf("got %d findings, want %d", got, tt.want)
+			}
+		})
+	}
+}
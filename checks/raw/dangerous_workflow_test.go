@@ -26,7 +26,9 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
 	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
 )
 
 func errCmp(e1, e2 error) bool {
@@ -97,6 +99,7 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 	tests := []struct {
 		name     string
 		filename string
+		private  bool
 		expected ret
 	}{
 		{
@@ -149,6 +152,57 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 			filename: ".github/workflows/github-workflow-dangerous-pattern-untrusted-script-injection-wildcard.yml",
 			expected: ret{nb: 1},
 		},
+		{
+			name:     "run cache poisoning",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-cache-poisoning.yml",
+			expected: ret{nb: 3},
+		},
+		{
+			name:     "run artifact poisoning",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-artifact-poisoning.yml",
+			expected: ret{nb: 2},
+		},
+		{
+			name:     "run third-party secrets inherit",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-secrets-inherit.yml",
+			expected: ret{nb: 1},
+		},
+		{
+			name:     "run self-hosted runner",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-self-hosted-runner.yml",
+			expected: ret{nb: 1},
+		},
+		{
+			name:     "run self-hosted runner in private repository",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-self-hosted-runner.yml",
+			private:  true,
+			expected: ret{nb: 0},
+		},
+		{
+			name:     "run environment file injection",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-environment-file-injection.yml",
+			expected: ret{nb: 1},
+		},
+		{
+			name:     "run unguarded issue comment",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-unguarded-issue-comment.yml",
+			expected: ret{nb: 1},
+		},
+		{
+			name:     "run github-script injection",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-github-script-injection.yml",
+			expected: ret{nb: 1},
+		},
+		{
+			name:     "azure pipelines script injection",
+			filename: "azure-pipelines/script-injection.yml",
+			expected: ret{nb: 2},
+		},
+		{
+			name:     "azure pipelines safe script",
+			filename: "azure-pipelines/safe-script.yml",
+			expected: ret{nb: 0},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -156,10 +210,11 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
-			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{tt.filename}, nil)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{tt.filename}, nil).AnyTimes()
+			mockRepoClient.EXPECT().IsPrivate().Return(tt.private, nil).AnyTimes()
 			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
 				return os.Open("../testdata/" + file)
-			})
+			}).AnyTimes()
 
 			req := &checker.CheckRequest{
 				Ctx:        context.Background(),
@@ -182,3 +237,128 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 		})
 	}
 }
+
+// workflowCallsRepoClient serves the reusable workflow and composite action fixtures
+// in testdata/workflow-calls.
+func workflowCallsRepoClient(t *testing.T) *mockrepo.MockRepoClient {
+	t.Helper()
+	files := []string{
+		".github/actions/setup/action.yml",
+		".github/actions/tool/action.yml",
+		".github/workflows/build.yml",
+		".github/workflows/pr-target.yml",
+	}
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+		var matched []string
+		for _, file := range files {
+			ok, err := predicate(file)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				matched = append(matched, file)
+			}
+		}
+		return matched, nil
+	}).AnyTimes()
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+		return os.Open("testdata/workflow-calls/" + file)
+	}).AnyTimes()
+	mockRepoClient.EXPECT().IsPrivate().Return(false, clients.ErrUnsupportedFeature).AnyTimes()
+	return mockRepoClient
+}
+
+func TestDangerousWorkflowCalls(t *testing.T) {
+	t.Parallel()
+
+	req := &checker.CheckRequest{
+		Ctx:        context.Background(),
+		RepoClient: workflowCallsRepoClient(t),
+	}
+	dw, err := DangerousWorkflow(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	caller := func(path, uses string, line uint) *checker.WorkflowCall {
+		return &checker.WorkflowCall{
+			File: checker.File{
+				Path:    path,
+				Type:    finding.FileTypeSource,
+				Offset:  line,
+				Snippet: uses,
+			},
+			InheritSecrets: true,
+		}
+	}
+	prTarget := caller(".github/workflows/pr-target.yml", "./.github/workflows/build.yml", 8)
+	type workflow struct {
+		caller  *checker.WorkflowCall
+		path    string
+		snippet string
+		typ     checker.DangerousWorkflowType
+		line    uint
+	}
+	want := []workflow{
+		{
+			typ:     checker.DangerousWorkflowUntrustedCheckout,
+			path:    ".github/workflows/build.yml",
+			line:    6,
+			snippet: "${{ github.event.pull_request.head.sha }}",
+			caller:  prTarget,
+		},
+		{
+			typ:     checker.DangerousWorkflowUntrustedCheckout,
+			path:    ".github/actions/tool/action.yml",
+			line:    4,
+			snippet: "${{ github.event.pull_request.head.ref }}",
+			caller:  prTarget,
+		},
+		{
+			typ:     checker.DangerousWorkflowScriptInjection,
+			path:    ".github/workflows/build.yml",
+			line:    9,
+			snippet: " inputs.title ",
+			caller:  prTarget,
+		},
+		{
+			typ:     checker.DangerousWorkflowScriptInjection,
+			path:    ".github/actions/setup/action.yml",
+			line:    6,
+			snippet: " inputs.name ",
+			caller:  prTarget,
+		},
+		{
+			typ:     checker.DangerousWorkflowScriptInjection,
+			path:    ".github/actions/setup/action.yml",
+			line:    6,
+			snippet: " github.event.issue.body ",
+			caller:  caller(".github/workflows/build.yml", "./.github/actions/setup", 10),
+		},
+	}
+	got := make([]workflow, 0, len(dw.Workflows))
+	for i := range dw.Workflows {
+		w := &dw.Workflows[i]
+		got = append(got, workflow{
+			typ:     w.Type,
+			path:    w.File.Path,
+			line:    w.File.Offset,
+			snippet: w.File.Snippet,
+			caller:  w.Caller,
+		})
+	}
+	sortWorkflows := cmpopts.SortSlices(func(a, b workflow) bool {
+		if a.path != b.path {
+			return a.path < b.path
+		}
+		if a.line != b.line {
+			return a.line < b.line
+		}
+		return a.snippet < b.snippet
+	})
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(workflow{}), sortWorkflows); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
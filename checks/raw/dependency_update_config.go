@@ -0,0 +1,372 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// Ecosystems of dependency manifests, named after Dependabot's `package-ecosystem`.
+const (
+	ecosystemBundler       = "bundler"
+	ecosystemCargo         = "cargo"
+	ecosystemComposer      = "composer"
+	ecosystemDocker        = "docker"
+	ecosystemGitHubActions = "github-actions"
+	ecosystemGomod         = "gomod"
+	ecosystemGradle        = "gradle"
+	ecosystemMaven         = "maven"
+	ecosystemMix           = "mix"
+	ecosystemNpm           = "npm"
+	ecosystemNuget         = "nuget"
+	ecosystemPip           = "pip"
+	ecosystemPub           = "pub"
+	ecosystemSbt           = "sbt"
+	ecosystemSwift         = "swift"
+	ecosystemTerraform     = "terraform"
+)
+
+var manifestEcosystems = map[string]string{
+	"Cargo.toml":       ecosystemCargo,
+	"Gemfile":          ecosystemBundler,
+	"Package.swift":    ecosystemSwift,
+	"Pipfile":          ecosystemPip,
+	"build.gradle":     ecosystemGradle,
+	"build.gradle.kts": ecosystemGradle,
+	"build.sbt":        ecosystemSbt,
+	"composer.json":    ecosystemComposer,
+	"go.mod":           ecosystemGomod,
+	"mix.exs":          ecosystemMix,
+	"package.json":     ecosystemNpm,
+	"packages.config":  ecosystemNuget,
+	"pom.xml":          ecosystemMaven,
+	"pubspec.yaml":     ecosystemPub,
+	"pyproject.toml":   ecosystemPip,
+	"setup.py":         ecosystemPip,
+}
+
+// Ecosystems of Renovate managers. Managers not listed here update files
+// Scorecard does not look for.
+// https://docs.renovatebot.com/modules/manager/
+var renovateManagerEcosystems = map[string]string{
+	"bundler":          ecosystemBundler,
+	"cargo":            ecosystemCargo,
+	"composer":         ecosystemComposer,
+	"docker-compose":   ecosystemDocker,
+	"dockerfile":       ecosystemDocker,
+	"github-actions":   ecosystemGitHubActions,
+	"gomod":            ecosystemGomod,
+	"gradle":           ecosystemGradle,
+	"maven":            ecosystemMaven,
+	"mix":              ecosystemMix,
+	"npm":              ecosystemNpm,
+	"nuget":            ecosystemNuget,
+	"pep621":           ecosystemPip,
+	"pip-compile":      ecosystemPip,
+	"pip_requirements": ecosystemPip,
+	"pip_setup":        ecosystemPip,
+	"pipenv":           ecosystemPip,
+	"poetry":           ecosystemPip,
+	"pub":              ecosystemPub,
+	"sbt":              ecosystemSbt,
+	"setup-cfg":        ecosystemPip,
+	"swift":            ecosystemSwift,
+	"terraform":        ecosystemTerraform,
+}
+
+// Dependabot ecosystems updating the manifests of another ecosystem.
+var dependabotEcosystemAliases = map[string]string{
+	"bun":            ecosystemNpm,
+	"docker-compose": ecosystemDocker,
+	"uv":             ecosystemPip,
+}
+
+// Paths ignored by Renovate by default, and by its `:ignoreModulesAndTests` preset.
+// https://docs.renovatebot.com/presets-default/#ignoremodulesandtests
+var (
+	renovateDefaultIgnorePaths = []string{"**/node_modules/**", "**/bower_components/**"}
+	renovateModulesAndTests    = []string{
+		"**/node_modules/**",
+		"**/bower_components/**",
+		"**/vendor/**",
+		"**/examples/**",
+		"**/__tests__/**",
+		"**/test/**",
+		"**/tests/**",
+		"**/__fixtures__/**",
+	}
+	renovateModulesAndTestsPresets = []string{
+		"config:base",
+		"config:best-practices",
+		"config:recommended",
+		":ignoreModulesAndTests",
+	}
+)
+
+// dependencyManifest returns the manifest of a file, if it declares dependencies.
+func dependencyManifest(name string) (checker.DependencyManifest, bool) {
+	if isVendoredPath(name) {
+		return checker.DependencyManifest{}, false
+	}
+	dir, base := path.Split(name)
+	ecosystem, ok := manifestEcosystems[base]
+	if !ok {
+		lower := strings.ToLower(base)
+		switch {
+		case strings.HasPrefix(dir, ".github/workflows/") &&
+			(strings.HasSuffix(lower, ".yml") || strings.HasSuffix(lower, ".yaml")):
+			// Dependabot looks for workflows in `.github/workflows` of the `/` directory.
+			ecosystem, dir = ecosystemGitHubActions, ""
+		case strings.HasPrefix(lower, "requirements") && strings.HasSuffix(lower, ".txt"):
+			ecosystem = ecosystemPip
+		case strings.HasSuffix(lower, ".csproj"), strings.HasSuffix(lower, ".fsproj"),
+			strings.HasSuffix(lower, ".vbproj"):
+			ecosystem = ecosystemNuget
+		case lower == "dockerfile", strings.HasPrefix(lower, "dockerfile."),
+			strings.HasSuffix(lower, ".dockerfile"):
+			ecosystem = ecosystemDocker
+		case strings.HasSuffix(lower, ".tf"):
+			ecosystem = ecosystemTerraform
+		default:
+			return checker.DependencyManifest{}, false
+		}
+	}
+	return checker.DependencyManifest{
+		Ecosystem: ecosystem,
+		Directory: normalizeUpdateDirectory(dir),
+		File: checker.File{
+			Path:   name,
+			Type:   finding.FileTypeSource,
+			Offset: checker.OffsetDefault,
+		},
+	}, true
+}
+
+// isVendoredPath returns true for the files of vendored or test dependencies,
+// which are not expected to be updated.
+func isVendoredPath(name string) bool {
+	for _, dir := range []string{"node_modules", "vendor", "third_party", "testdata", "bower_components"} {
+		if strings.HasPrefix(name, dir+"/") || strings.Contains(name, "/"+dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeUpdateDirectory returns a directory with a leading `/` and without trailing `/`.
+func normalizeUpdateDirectory(dir string) string {
+	dir = strings.Trim(strings.TrimSpace(dir), "/")
+	return "/" + dir
+}
+
+// parseDependencyUpdateConfig parses the configuration of a detected tool.
+// Configurations which cannot be parsed are assumed to update every ecosystem.
+func parseDependencyUpdateConfig(tool *checker.Tool, content []byte) checker.DependencyUpdateConfig {
+	config := checker.DependencyUpdateConfig{
+		Tool: tool.Name,
+		File: tool.Files[0],
+	}
+	var err error
+	switch tool.Name {
+	case "Dependabot":
+		err = parseDependabotConfig(content, &config)
+	case "RenovateBot":
+		err = parseRenovateConfig(tool.Files[0].Path, content, &config)
+	case "PyUp":
+		err = parsePyUpConfig(content, &config)
+	case "scala-steward":
+		config.Updates = []checker.DependencyUpdate{{Ecosystem: ecosystemSbt}, {Ecosystem: ecosystemMaven}}
+	default:
+		config.Updates = []checker.DependencyUpdate{{}}
+	}
+	if err != nil {
+		config.Updates = []checker.DependencyUpdate{{}}
+		config.IgnorePaths = nil
+		config.Disabled = false
+	}
+	return config
+}
+
+// https://docs.github.com/en/code-security/dependabot/working-with-dependabot/dependabot-options-reference
+func parseDependabotConfig(content []byte, config *checker.DependencyUpdateConfig) error {
+	var doc struct {
+		Updates []yaml.Node `yaml:"updates"`
+	}
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return fmt.Errorf("unmarshal Dependabot config: %w", err)
+	}
+	for i := range doc.Updates {
+		var update struct {
+			Limit       *int     `yaml:"open-pull-requests-limit"`
+			Ecosystem   string   `yaml:"package-ecosystem"`
+			Directory   string   `yaml:"directory"`
+			Directories []string `yaml:"directories"`
+		}
+		if err := doc.Updates[i].Decode(&update); err != nil {
+			return fmt.Errorf("decode Dependabot update: %w", err)
+		}
+		ecosystem := update.Ecosystem
+		if alias, ok := dependabotEcosystemAliases[ecosystem]; ok {
+			ecosystem = alias
+		}
+		var dirs []string
+		if update.Directory != "" {
+			dirs = append(dirs, normalizeUpdateDirectory(update.Directory))
+		}
+		for _, dir := range update.Directories {
+			dirs = append(dirs, normalizeUpdateDirectory(dir))
+		}
+		if ecosystem == ecosystemGitHubActions {
+			// Workflows are found in `.github/workflows` whether `/` or that directory is configured.
+			for j, dir := range dirs {
+				if dir == "/.github/workflows" {
+					dirs[j] = "/"
+				}
+			}
+		}
+		config.Updates = append(config.Updates, checker.DependencyUpdate{
+			Ecosystem:   ecosystem,
+			Directories: dirs,
+			Offset:      uint(doc.Updates[i].Line),
+			Disabled:    update.Limit != nil && *update.Limit == 0,
+		})
+	}
+	// A configuration without updates only configures security updates, if at all.
+	config.Disabled = len(config.Updates) == 0
+	return nil
+}
+
+// https://docs.renovatebot.com/configuration-options/
+func parseRenovateConfig(pathfn string, content []byte, config *checker.DependencyUpdateConfig) error {
+	type renovateConfig struct {
+		Enabled         *bool    `yaml:"enabled"`
+		EnabledManagers []string `yaml:"enabledManagers"`
+		IgnorePaths     []string `yaml:"ignorePaths"`
+		Extends         []string `yaml:"extends"`
+	}
+	var rc renovateConfig
+	// JSON is valid YAML, and so is JSON5 once comments are removed.
+	content = stripJSONComments(content)
+	if path.Base(pathfn) == "package.json" {
+		var pkg struct {
+			Renovate renovateConfig `yaml:"renovate"`
+		}
+		if err := yaml.Unmarshal(content, &pkg); err != nil {
+			return fmt.Errorf("unmarshal package.json: %w", err)
+		}
+		rc = pkg.Renovate
+	} else if err := yaml.Unmarshal(content, &rc); err != nil {
+		return fmt.Errorf("unmarshal Renovate config: %w", err)
+	}
+
+	config.Disabled = rc.Enabled != nil && !*rc.Enabled
+	seen := map[string]bool{}
+	for _, manager := range rc.EnabledManagers {
+		ecosystem, ok := renovateManagerEcosystems[manager]
+		if !ok || seen[ecosystem] {
+			continue
+		}
+		seen[ecosystem] = true
+		config.Updates = append(config.Updates, checker.DependencyUpdate{Ecosystem: ecosystem})
+	}
+	// Managers Scorecard knows nothing about update other files.
+	if len(rc.EnabledManagers) == 0 {
+		config.Updates = []checker.DependencyUpdate{{}}
+	}
+
+	switch {
+	case rc.IgnorePaths != nil:
+		config.IgnorePaths = rc.IgnorePaths
+	case extendsAny(rc.Extends, renovateModulesAndTestsPresets):
+		config.IgnorePaths = renovateModulesAndTests
+	default:
+		config.IgnorePaths = renovateDefaultIgnorePaths
+	}
+	return nil
+}
+
+func extendsAny(extends, presets []string) bool {
+	for _, e := range extends {
+		for _, p := range presets {
+			if e == p {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// https://pyup.io/docs/bot/config/
+func parsePyUpConfig(content []byte, config *checker.DependencyUpdateConfig) error {
+	var doc struct {
+		Update any `yaml:"update"`
+	}
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return fmt.Errorf("unmarshal PyUp config: %w", err)
+	}
+	config.Updates = []checker.DependencyUpdate{{Ecosystem: ecosystemPip}}
+	config.Disabled = doc.Update == false
+	return nil
+}
+
+// stripJSONComments removes the `//` and `/* */` comments of a JSON5 document.
+func stripJSONComments(content []byte) []byte {
+	var (
+		out   = make([]byte, 0, len(content))
+		quote byte
+	)
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case quote != 0:
+			out = append(out, c)
+			if c == '\\' && i+1 < len(content) {
+				i++
+				out = append(out, content[i])
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			out = append(out, c)
+		case c == '/' && i+1 < len(content) && content[i+1] == '/':
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+			if i < len(content) {
+				out = append(out, '\n')
+			}
+		case c == '/' && i+1 < len(content) && content[i+1] == '*':
+			i += 2
+			for i+1 < len(content) && (content[i] != '*' || content[i+1] != '/') {
+				if content[i] == '\n' {
+					out = append(out, '\n')
+				}
+				i++
+			}
+			i++
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
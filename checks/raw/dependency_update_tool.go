@@ -17,8 +17,12 @@ package raw
 import (
 	"errors"
 	"fmt"
+	"io"
+	"path"
 	"strings"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks/fileparser"
 	"github.com/ossf/scorecard/v5/clients"
@@ -31,14 +35,45 @@ const (
 
 // DependencyUpdateTool is the exported name for Dependency-Update-Tool.
 func DependencyUpdateTool(c clients.RepoClient) (checker.DependencyUpdateToolData, error) {
-	var tools []checker.Tool
-	err := fileparser.OnAllFilesDo(c, checkDependencyFileExists, &tools)
+	var data checker.DependencyUpdateToolData
+	files, err := c.ListFiles(func(string) (bool, error) { return true, nil })
 	if err != nil {
-		return checker.DependencyUpdateToolData{}, fmt.Errorf("%w", err)
+		return checker.DependencyUpdateToolData{}, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+	for _, name := range files {
+		if _, err := checkDependencyFileExists(name, &data.Tools); err != nil {
+			return checker.DependencyUpdateToolData{}, err
+		}
+		if manifest, ok := dependencyManifest(name); ok {
+			data.Manifests = append(data.Manifests, manifest)
+		}
+	}
+
+	// Renovate also reads its configuration from the `renovate` key of the root package.json.
+	for _, name := range files {
+		if name != "package.json" {
+			continue
+		}
+		content, err := readFileContent(c, name)
+		if err != nil {
+			return checker.DependencyUpdateToolData{}, err
+		}
+		if hasRenovateKey(content) {
+			data.Tools = append(data.Tools, renovateTool(name))
+		}
+	}
+
+	for i := range data.Tools {
+		tool := &data.Tools[i]
+		content, err := readFileContent(c, tool.Files[0].Path)
+		if err != nil {
+			return checker.DependencyUpdateToolData{}, err
+		}
+		data.Configs = append(data.Configs, parseDependencyUpdateConfig(tool, content))
 	}
 
-	if len(tools) != 0 {
-		return checker.DependencyUpdateToolData{Tools: tools}, nil
+	if len(data.Tools) != 0 {
+		return data, nil
 	}
 
 	commits, err := c.SearchCommits(clients.SearchCommitsOptions{Author: "dependabot[bot]"})
@@ -47,14 +82,14 @@ func DependencyUpdateTool(c clients.RepoClient) (checker.DependencyUpdateToolDat
 		// some repo clients (e.g. local) don't currently have the ability to search commits,
 		// but some data is better than none.
 		if errors.Is(err, clients.ErrUnsupportedFeature) {
-			return checker.DependencyUpdateToolData{Tools: tools}, nil
+			return data, nil
 		}
 		return checker.DependencyUpdateToolData{}, fmt.Errorf("dependabot commit search: %w", err)
 	}
 
 	for i := range commits {
 		if commits[i].Committer.ID == dependabotID {
-			tools = append(tools, checker.Tool{
+			data.Tools = append(data.Tools, checker.Tool{
 				Name:  "Dependabot",
 				URL:   asPointer("https://github.com/dependabot"),
 				Desc:  asPointer("Automated dependency updates built into GitHub"),
@@ -64,7 +99,42 @@ func DependencyUpdateTool(c clients.RepoClient) (checker.DependencyUpdateToolDat
 		}
 	}
 
-	return checker.DependencyUpdateToolData{Tools: tools}, nil
+	return data, nil
+}
+
+func readFileContent(c clients.RepoClient, name string) ([]byte, error) {
+	r, err := c.GetFileReader(name)
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+	}
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", name, err)
+	}
+	return content, nil
+}
+
+func hasRenovateKey(content []byte) bool {
+	var pkg struct {
+		Renovate any `yaml:"renovate"`
+	}
+	return yaml.Unmarshal(content, &pkg) == nil && pkg.Renovate != nil
+}
+
+func renovateTool(name string) checker.Tool {
+	return checker.Tool{
+		Name: "RenovateBot",
+		URL:  asPointer("https://github.com/renovatebot/renovate"),
+		Desc: asPointer("Automated dependency updates. Multi-platform and multi-language."),
+		Files: []checker.File{
+			{
+				Path:   name,
+				Type:   finding.FileTypeSource,
+				Offset: checker.OffsetDefault,
+			},
+		},
+	}
 }
 
 var checkDependencyFileExists fileparser.DoWhileTrueOnFilename = func(name string, args ...interface{}) (bool, error) {
@@ -102,18 +172,7 @@ var checkDependencyFileExists fileparser.DoWhileTrueOnFilename = func(name strin
 		".renovaterc",
 		".renovaterc.json",
 		".renovaterc.json5":
-		*ptools = append(*ptools, checker.Tool{
-			Name: "RenovateBot",
-			URL:  asPointer("https://github.com/renovatebot/renovate"),
-			Desc: asPointer("Automated dependency updates. Multi-platform and multi-language."),
-			Files: []checker.File{
-				{
-					Path:   name,
-					Type:   finding.FileTypeSource,
-					Offset: checker.OffsetDefault,
-				},
-			},
-		})
+		*ptools = append(*ptools, renovateTool(name))
 	case ".pyup.yml":
 		*ptools = append(*ptools, checker.Tool{
 			Name: "PyUp",
@@ -146,6 +205,28 @@ var checkDependencyFileExists fileparser.DoWhileTrueOnFilename = func(name strin
 				},
 			},
 		})
+	// https://docs.mend.io/wsk/configure-mend-for-github-com-for-your-repository
+	case ".whitesource":
+		*ptools = append(*ptools, checker.Tool{
+			Name: "Mend",
+			URL:  asPointer("https://www.mend.io/renovate/"),
+			Desc: asPointer("Automated dependency updates and vulnerability remediation."),
+			Files: []checker.File{
+				{
+					Path:   name,
+					Type:   finding.FileTypeSource,
+					Offset: checker.OffsetDefault,
+				},
+			},
+		})
+	// https://www.updatecli.io/docs/core/compose/
+	case "updatecli.yaml", "updatecli.yml", "updatecli-compose.yaml", "updatecli-compose.yml":
+		*ptools = append(*ptools, updatecliTool(name))
+	default:
+		dir, base := path.Split(name)
+		if dir == "updatecli.d/" && (strings.HasSuffix(base, ".yaml") || strings.HasSuffix(base, ".yml")) {
+			*ptools = append(*ptools, updatecliTool(name))
+		}
 	}
 
 	// Continue iterating, even if we have found a tool.
@@ -153,6 +234,21 @@ var checkDependencyFileExists fileparser.DoWhileTrueOnFilename = func(name strin
 	return true, nil
 }
 
+func updatecliTool(name string) checker.Tool {
+	return checker.Tool{
+		Name: "updatecli",
+		URL:  asPointer("https://www.updatecli.io/"),
+		Desc: asPointer("Declarative dependency management for files, container images and more."),
+		Files: []checker.File{
+			{
+				Path:   name,
+				Type:   finding.FileTypeSource,
+				Offset: checker.OffsetDefault,
+			},
+		},
+	}
+}
+
 func asPointer(s string) *string {
 	return &s
 }
@@ -15,13 +15,18 @@
 package raw
 
 import (
+	"io"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/ossf/scorecard/v5/checker"
 	clients "github.com/ossf/scorecard/v5/clients"
 	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
 )
 
 func Test_checkDependencyFileExists(t *testing.T) {
@@ -147,6 +152,24 @@ func Test_checkDependencyFileExists(t *testing.T) {
 			want:    false, // support removed
 			wantErr: false,
 		},
+		{
+			name:    ".whitesource",
+			path:    ".whitesource",
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:    "updatecli.d/docker.yaml",
+			path:    "updatecli.d/docker.yaml",
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:    "docs/updatecli.d/docker.yaml",
+			path:    "docs/updatecli.d/docker.yaml",
+			want:    false,
+			wantErr: false,
+		},
 		{
 			name:    ".lift/config.toml",
 			path:    ".lift/config.toml",
@@ -240,6 +263,9 @@ func TestDependencyUpdateTool(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockRepo := mockrepo.NewMockRepoClient(ctrl)
 			mockRepo.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil)
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader("")), nil
+			}).AnyTimes()
 			mockRepo.EXPECT().SearchCommits(gomock.Any()).Return(tt.SearchCommits, nil).Times(tt.CallSearchCommits)
 
 			got, err := DependencyUpdateTool(mockRepo)
@@ -255,3 +281,153 @@ func TestDependencyUpdateTool(t *testing.T) {
 		})
 	}
 }
+
+func TestDependencyUpdateTool_configs(t *testing.T) {
+	t.Parallel()
+	file := func(path string) checker.File {
+		return checker.File{Path: path, Type: finding.FileTypeSource, Offset: checker.OffsetDefault}
+	}
+	manifest := func(ecosystem, dir, path string) checker.DependencyManifest {
+		return checker.DependencyManifest{Ecosystem: ecosystem, Directory: dir, File: file(path)}
+	}
+	tests := []struct {
+		name          string
+		files         map[string]string
+		wantConfigs   []checker.DependencyUpdateConfig
+		wantManifests []checker.DependencyManifest
+	}{
+		{
+			name: "dependabot updates",
+			files: map[string]string{
+				".github/dependabot.yml": `version: 2
+updates:
+  - package-ecosystem: gomod
+    directory: /
+  - package-ecosystem: npm
+    directories: ["/web/*"]
+    open-pull-requests-limit: 0
+  - package-ecosystem: github-actions
+    directory: /.github/workflows
+`,
+				"go.mod":                            "",
+				"web/app/package.json":              "",
+				"web/app/node_modules/package.json": "",
+				".github/workflows/ci.yml":          "",
+				"testdata/go.mod":                   "",
+			},
+			wantConfigs: []checker.DependencyUpdateConfig{
+				{
+					Tool: "Dependabot",
+					File: file(".github/dependabot.yml"),
+					Updates: []checker.DependencyUpdate{
+						{Ecosystem: "gomod", Directories: []string{"/"}, Offset: 3},
+						{Ecosystem: "npm", Directories: []string{"/web/*"}, Offset: 5, Disabled: true},
+						{Ecosystem: "github-actions", Directories: []string{"/"}, Offset: 8},
+					},
+				},
+			},
+			wantManifests: []checker.DependencyManifest{
+				manifest("github-actions", "/", ".github/workflows/ci.yml"),
+				manifest("gomod", "/", "go.mod"),
+				manifest("npm", "/web/app", "web/app/package.json"),
+			},
+		},
+		{
+			name: "dependabot without updates",
+			files: map[string]string{
+				".github/dependabot.yaml": "version: 2\n",
+			},
+			wantConfigs: []checker.DependencyUpdateConfig{
+				{Tool: "Dependabot", File: file(".github/dependabot.yaml"), Disabled: true},
+			},
+		},
+		{
+			name: "renovate json5",
+			files: map[string]string{
+				"renovate.json5": `{
+  // Only Go and Docker.
+  enabledManagers: ['gomod', 'dockerfile', 'docker-compose', 'unknown'],
+  extends: ["config:recommended"], /* presets */
+}
+`,
+				"build/Dockerfile": "",
+			},
+			wantConfigs: []checker.DependencyUpdateConfig{
+				{
+					Tool:        "RenovateBot",
+					File:        file("renovate.json5"),
+					Updates:     []checker.DependencyUpdate{{Ecosystem: "gomod"}, {Ecosystem: "docker"}},
+					IgnorePaths: renovateModulesAndTests,
+				},
+			},
+			wantManifests: []checker.DependencyManifest{
+				manifest("docker", "/build", "build/Dockerfile"),
+			},
+		},
+		{
+			name: "renovate disabled in package.json",
+			files: map[string]string{
+				"package.json": `{"name": "app", "renovate": {"enabled": false, "ignorePaths": ["docs/"]}}`,
+			},
+			wantConfigs: []checker.DependencyUpdateConfig{
+				{
+					Tool:        "RenovateBot",
+					File:        file("package.json"),
+					Updates:     []checker.DependencyUpdate{{}},
+					IgnorePaths: []string{"docs/"},
+					Disabled:    true,
+				},
+			},
+			wantManifests: []checker.DependencyManifest{
+				manifest("npm", "/", "package.json"),
+			},
+		},
+		{
+			name: "pyup disabled and mend",
+			files: map[string]string{
+				".pyup.yml":            "update: false\n",
+				".whitesource":         `{"settingsInheritedFrom": "org/whitesource-config@main"}`,
+				"requirements-dev.txt": "",
+			},
+			wantConfigs: []checker.DependencyUpdateConfig{
+				{
+					Tool:     "PyUp",
+					File:     file(".pyup.yml"),
+					Updates:  []checker.DependencyUpdate{{Ecosystem: "pip"}},
+					Disabled: true,
+				},
+				{Tool: "Mend", File: file(".whitesource"), Updates: []checker.DependencyUpdate{{}}},
+			},
+			wantManifests: []checker.DependencyManifest{
+				manifest("pip", "/", "requirements-dev.txt"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepo := mockrepo.NewMockRepoClient(ctrl)
+			files := make([]string, 0, len(tt.files))
+			for name := range tt.files {
+				files = append(files, name)
+			}
+			sort.Strings(files)
+			mockRepo.EXPECT().ListFiles(gomock.Any()).Return(files, nil)
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(name string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(tt.files[name])), nil
+			}).AnyTimes()
+
+			got, err := DependencyUpdateTool(mockRepo)
+			if err != nil {
+				t.Fatalf("DependencyUpdateTool: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantConfigs, got.Configs); diff != "" {
+				t.Errorf("configs mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantManifests, got.Manifests); diff != "" {
+				t.Errorf("manifests mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,5 +23,7 @@ var (
 	errInternalCommitishNil      = errors.New("commitish is nil")
 	errInvalidArgType            = errors.New("invalid arg type")
 	errInvalidArgLength          = errors.New("invalid arg length")
+	errWorkflowCallCycle         = errors.New("cycle of workflow calls")
 	errInvalidGitHubWorkflow     = errors.New("invalid GitHub workflow")
+	errDigestLookupLimit         = errors.New("image digest lookup limit reached")
 )
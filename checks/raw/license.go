@@ -106,6 +106,26 @@ var reLicenseFileExts = regexp.MustCompile(
 
 // License retrieves the raw data for the License check.
 func License(c *checker.CheckRequest) (checker.LicenseData, error) {
+	results, err := licenseFiles(c)
+	if err != nil || !c.LicenseDeepScan {
+		return results, err
+	}
+	var projectLicenses []string
+	for i := range results.LicenseFiles {
+		if id := results.LicenseFiles[i].LicenseInformation.SpdxID; id != "" && id != "NOASSERTION" {
+			projectLicenses = append(projectLicenses, id)
+		}
+	}
+	results.SourceLicenses, err = sourceLicenses(c.RepoClient, projectLicenses)
+	if err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// licenseFiles looks for the license of the project with the repository API,
+// or among the files at the top of the repository.
+func licenseFiles(c *checker.CheckRequest) (checker.LicenseData, error) {
 	var results checker.LicenseData
 
 	// prepare case insensitive map to map approved licenses matched in repo.
@@ -153,12 +173,17 @@ func License(c *checker.CheckRequest) (checker.LicenseData, error) {
 
 	// no repo API for listing licenses, continue looking for files
 	path := checker.LicenseFile{}
-	err := fileparser.OnAllFilesDo(c.RepoClient, isLicenseFile, &path)
+	onFile := isLicenseFile
+	if c.LicenseDeepScan {
+		onFile = isProjectLicenseFile
+	}
+	err := fileparser.OnAllFilesDo(c.RepoClient, onFile, &path)
 	if err != nil {
 		return results, fmt.Errorf("fileparser.OnAllFilesDo: %w", err)
 	}
 
-	// scorecard search stops at first candidate (isLicenseFile) license file found
+	// scorecard search stops at first candidate (isLicenseFile) license file found,
+	// or keeps the first one in path order for the deep scan (isProjectLicenseFile)
 	if path != (checker.LicenseFile{}) {
 		path.LicenseInformation.Name = fsfOsiApprovedLicenseCiMap[strings.ToUpper(path.LicenseInformation.SpdxID)].Name
 		// these settings (Name, Key) match GH repo API
@@ -200,6 +225,31 @@ var isLicenseFile fileparser.DoWhileTrueOnFilename = func(name string, args ...i
 	return true, nil
 }
 
+// isProjectLicenseFile is isLicenseFile for the license deep scan, which also
+// lists the REUSE sidecar files of the repository.
+var isProjectLicenseFile fileparser.DoWhileTrueOnFilename = func(name string, args ...interface{}) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf("isProjectLicenseFile requires exactly one argument: %w", errInvalidArgLength)
+	}
+	s, ok := args[0].(*checker.LicenseFile)
+	if !ok {
+		return false, fmt.Errorf("isProjectLicenseFile requires argument of type: *checker.LicenseFile: %w",
+			errInvalidArgType)
+	}
+	// REUSE sidecar files (e.g. image.png.license) hold the license of
+	// another file, not of the project.
+	if strings.HasSuffix(name, reuseSidecarSuffix) {
+		return true, nil
+	}
+	lf, ok := checkLicense(name)
+	// keep the first candidate in path order, so the project license
+	// doesn't depend on the order the files are listed in.
+	if ok && (*s == (checker.LicenseFile{}) || lf.File.Path < s.File.Path) {
+		*s = lf
+	}
+	return true, nil
+}
+
 // direct mapping of regex groups by name to index number.
 var reGroupIdxs = make(map[string]int)
 
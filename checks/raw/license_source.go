@@ -0,0 +1,519 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+const (
+	// licenseHeaderLines is the number of lines in which license headers are looked for.
+	licenseHeaderLines = 50
+	reuseDep5File      = ".reuse/dep5"
+	reuseTomlFile      = "REUSE.toml"
+	reuseSidecarSuffix = ".license"
+)
+
+var (
+	reSPDXLicenseIdentifier = regexp.MustCompile(`SPDX-License-Identifier:\s*(.*)`)
+	// SPDX license expressions only use these characters, which keeps the
+	// regular expressions and strings mentioning the tag from matching.
+	reSPDXExpression = regexp.MustCompile(`^[A-Za-z0-9.+\-:() ]+$`)
+	reCopyleft       = regexp.MustCompile(`^(A?GPL|LGPL)-([0-9])\.[0-9](-only|-or-later|\+)?$`)
+
+	// sourceFileExtensions are the extensions of the files expected to
+	// declare their license.
+	sourceFileExtensions = map[string]bool{
+		".c": true, ".cc": true, ".cpp": true, ".cs": true, ".cxx": true, ".dart": true,
+		".ex": true, ".exs": true, ".go": true, ".h": true, ".hpp": true, ".java": true,
+		".js": true, ".jsx": true, ".kt": true, ".kts": true, ".lua": true, ".m": true,
+		".mjs": true, ".mm": true, ".php": true, ".pl": true, ".py": true, ".rb": true,
+		".rs": true, ".scala": true, ".sh": true, ".swift": true, ".ts": true, ".tsx": true,
+		".vue": true, ".zig": true,
+	}
+)
+
+// reuseAnnotation declares the license of the files matching a pattern.
+type reuseAnnotation struct {
+	pattern    *regexp.Regexp
+	file       string
+	expression string
+	source     checker.SourceLicenseSource
+	// override is set for REUSE.toml annotations taking precedence over
+	// the headers of the files.
+	override bool
+}
+
+// sourceLicenses scans the source files of the repository for license
+// declarations, and reports the files declaring a license incompatible with
+// the licenses of the project.
+func sourceLicenses(c clients.RepoClient, projectLicenses []string) (*checker.SourceLicenseData, error) {
+	files, err := c.ListFiles(func(string) (bool, error) { return true, nil })
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+
+	data := &checker.SourceLicenseData{}
+	var annotations []reuseAnnotation
+	var sources []string
+	sidecars := make(map[string]string)
+	for _, name := range files {
+		switch {
+		case name == reuseDep5File:
+			content, err := readFileContent(c, name)
+			if err != nil {
+				return nil, err
+			}
+			data.REUSE = true
+			annotations = append(annotations, parseDep5(name, content)...)
+		case path.Base(name) == reuseTomlFile:
+			content, err := readFileContent(c, name)
+			if err != nil {
+				return nil, err
+			}
+			data.REUSE = true
+			annotations = append(annotations, parseReuseToml(name, content)...)
+		case strings.HasSuffix(name, reuseSidecarSuffix):
+			sidecars[strings.TrimSuffix(name, reuseSidecarSuffix)] = name
+		case isLicensedSourceFile(name):
+			sources = append(sources, name)
+		}
+	}
+	// dep5 declarations have the lowest precedence.
+	sort.SliceStable(annotations, func(i, j int) bool {
+		return annotations[i].source == checker.SourceLicenseSourceDep5 &&
+			annotations[j].source != checker.SourceLicenseSourceDep5
+	})
+	sort.Strings(sources)
+
+	for _, name := range sources {
+		data.ScannedFiles++
+		license, ok, err := sourceLicense(c, name, sidecars[name], annotations)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			data.UnlicensedFiles = append(data.UnlicensedFiles, checker.File{
+				Path: name,
+				Type: finding.FileTypeSource,
+			})
+			continue
+		}
+		data.Licenses = append(data.Licenses, license)
+		if len(projectLicenses) > 0 && !licenseExpressionCompatible(license.Expression, projectLicenses) {
+			data.Conflicts = append(data.Conflicts, checker.LicenseConflict{
+				SourceLicense:  license,
+				ProjectLicense: strings.Join(projectLicenses, " OR "),
+			})
+		}
+	}
+	return data, nil
+}
+
+// isLicensedSourceFile reports whether a file is a source file of the project
+// expected to declare its license.
+func isLicensedSourceFile(name string) bool {
+	return sourceFileExtensions[strings.ToLower(path.Ext(name))] &&
+		!isVendoredPath(name) && !fileparser.IsTestdataFile(name)
+}
+
+// sourceLicense returns the license declared for a source file, following the
+// precedence of the REUSE specification.
+func sourceLicense(c clients.RepoClient, name, sidecar string, annotations []reuseAnnotation,
+) (checker.SourceLicense, bool, error) {
+	file := checker.File{Path: name, Type: finding.FileTypeSource}
+	var matched *reuseAnnotation
+	for i := range annotations {
+		if annotations[i].pattern.MatchString(name) {
+			matched = &annotations[i]
+		}
+	}
+	if matched != nil && matched.override {
+		return matched.license(file), true, nil
+	}
+
+	content, err := readFileContent(c, name)
+	if err != nil {
+		return checker.SourceLicense{}, false, err
+	}
+	if expression, line := licenseHeader(content); expression != "" {
+		file.Offset = line
+		return checker.SourceLicense{
+			File:        file,
+			Declaration: name,
+			Expression:  expression,
+			Source:      checker.SourceLicenseSourceHeader,
+		}, true, nil
+	}
+
+	if sidecar != "" {
+		content, err := readFileContent(c, sidecar)
+		if err != nil {
+			return checker.SourceLicense{}, false, err
+		}
+		if expression, _ := licenseHeader(content); expression != "" {
+			return checker.SourceLicense{
+				File:        file,
+				Declaration: sidecar,
+				Expression:  expression,
+				Source:      checker.SourceLicenseSourceSidecar,
+			}, true, nil
+		}
+	}
+
+	if matched != nil {
+		return matched.license(file), true, nil
+	}
+	return checker.SourceLicense{}, false, nil
+}
+
+func (a *reuseAnnotation) license(file checker.File) checker.SourceLicense {
+	return checker.SourceLicense{
+		File:        file,
+		Declaration: a.file,
+		Expression:  a.expression,
+		Source:      a.source,
+	}
+}
+
+// licenseHeader returns the license expression declared by the
+// SPDX-License-Identifier tags at the top of a file, and the line of the
+// first tag. Several tags are combined with AND.
+func licenseHeader(content []byte) (string, uint) {
+	var expressions []string
+	var first uint
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := uint(1); line <= licenseHeaderLines && scanner.Scan(); line++ {
+		match := reSPDXLicenseIdentifier.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		expression := trimCommentEnd(match[1])
+		if !reSPDXExpression.MatchString(expression) {
+			continue
+		}
+		if first == 0 {
+			first = line
+		}
+		expressions = append(expressions, expression)
+	}
+	if len(expressions) > 1 {
+		for i := range expressions {
+			if strings.Contains(strings.ToUpper(expressions[i]), " OR ") {
+				expressions[i] = "(" + expressions[i] + ")"
+			}
+		}
+	}
+	return strings.Join(expressions, " AND "), first
+}
+
+// trimCommentEnd removes the end of a comment following a license expression.
+func trimCommentEnd(s string) string {
+	s = strings.TrimSpace(s)
+	for _, end := range []string{"*/", "-->", "--%>", "#}", "%}", `"""`, "'''", "*)"} {
+		s = strings.TrimSpace(strings.TrimSuffix(s, end))
+	}
+	return s
+}
+
+// parseDep5 parses the paragraphs of a machine-readable debian/copyright file
+// used by REUSE, in which `*` matches any character, including `/`.
+func parseDep5(name string, content []byte) []reuseAnnotation {
+	var annotations []reuseAnnotation
+	for _, paragraph := range strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n\n") {
+		fields := make(map[string]string)
+		var last string
+		for _, line := range strings.Split(paragraph, "\n") {
+			// Continuation lines list more files, or contain the text of
+			// the license following its expression.
+			if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+				if last == "files" {
+					fields[last] += " " + strings.TrimSpace(line)
+				}
+				continue
+			}
+			key, value, ok := strings.Cut(line, ":")
+			if !ok {
+				continue
+			}
+			last = strings.ToLower(strings.TrimSpace(key))
+			fields[last] = strings.TrimSpace(value)
+		}
+		expression := fields["license"]
+		if fields["files"] == "" || expression == "" {
+			continue
+		}
+		for _, pattern := range strings.Fields(fields["files"]) {
+			annotations = append(annotations, reuseAnnotation{
+				pattern:    globRegexp(strings.TrimPrefix(pattern, "./"), true),
+				file:       name,
+				expression: expression,
+				source:     checker.SourceLicenseSourceDep5,
+			})
+		}
+	}
+	return annotations
+}
+
+type reuseToml struct {
+	Annotations []struct {
+		Path       any    `toml:"path"`
+		Precedence string `toml:"precedence"`
+		License    any    `toml:"SPDX-License-Identifier"`
+	} `toml:"annotations"`
+}
+
+// parseReuseToml parses the annotations of a REUSE.toml file, whose paths are
+// relative to the directory of the file. In them, `*` doesn't match `/`, but
+// `**` does.
+func parseReuseToml(name string, content []byte) []reuseAnnotation {
+	var config reuseToml
+	if _, err := toml.Decode(string(content), &config); err != nil {
+		return nil
+	}
+	dir := path.Dir(name)
+	var annotations []reuseAnnotation
+	for _, a := range config.Annotations {
+		expressions := tomlStrings(a.License)
+		if len(expressions) == 0 {
+			continue
+		}
+		expression := strings.Join(expressions, " AND ")
+		for _, pattern := range tomlStrings(a.Path) {
+			if dir != "." {
+				pattern = dir + "/" + pattern
+			}
+			annotations = append(annotations, reuseAnnotation{
+				pattern:    globRegexp(pattern, false),
+				file:       name,
+				expression: expression,
+				source:     checker.SourceLicenseSourceReuseToml,
+				override:   a.Precedence == "override",
+			})
+		}
+	}
+	return annotations
+}
+
+// tomlStrings returns the values of a TOML field which is either a string or
+// an array of strings.
+func tomlStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var ret []string
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				ret = append(ret, s)
+			}
+		}
+		return ret
+	default:
+		return nil
+	}
+}
+
+// globRegexp converts a REUSE path pattern to a regular expression.
+func globRegexp(pattern string, starMatchesSlash bool) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch ch := pattern[i]; {
+		case ch == '\\' && i+1 < len(pattern):
+			i++
+			b.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		case ch == '*' && i+1 < len(pattern) && pattern[i+1] == '*':
+			i++
+			b.WriteString(".*")
+		case ch == '*' && starMatchesSlash:
+			b.WriteString(".*")
+		case ch == '*':
+			b.WriteString("[^/]*")
+		case ch == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+// licenseExpressionCompatible reports whether the licenses of an SPDX
+// expression can be combined with one of the licenses of the project.
+// Expressions which can't be parsed are assumed compatible.
+func licenseExpressionCompatible(expression string, projectLicenses []string) bool {
+	p := &spdxParser{tokens: tokenizeSPDX(expression)}
+	compatible, ok := p.parseOr(func(id string) bool {
+		for _, project := range projectLicenses {
+			if licensesCompatible(id, project) {
+				return true
+			}
+		}
+		return false
+	})
+	return !ok || p.pos != len(p.tokens) || compatible
+}
+
+func tokenizeSPDX(expression string) []string {
+	expression = strings.ReplaceAll(expression, "(", " ( ")
+	expression = strings.ReplaceAll(expression, ")", " ) ")
+	return strings.Fields(expression)
+}
+
+// spdxParser evaluates SPDX license expressions: the expression is compatible
+// if one of the alternatives of an OR, and all the licenses of an AND are.
+type spdxParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *spdxParser) parseOr(compatible func(string) bool) (bool, bool) {
+	ret, ok := p.parseAnd(compatible)
+	for ok && p.accept("OR") {
+		var right bool
+		right, ok = p.parseAnd(compatible)
+		ret = ret || right
+	}
+	return ret, ok
+}
+
+func (p *spdxParser) parseAnd(compatible func(string) bool) (bool, bool) {
+	ret, ok := p.parseWith(compatible)
+	for ok && p.accept("AND") {
+		var right bool
+		right, ok = p.parseWith(compatible)
+		ret = ret && right
+	}
+	return ret, ok
+}
+
+func (p *spdxParser) parseWith(compatible func(string) bool) (bool, bool) {
+	ret, ok := p.parseAtom(compatible)
+	// Exceptions only grant additional permissions.
+	if ok && p.accept("WITH") {
+		if p.pos >= len(p.tokens) {
+			return false, false
+		}
+		p.pos++
+	}
+	return ret, ok
+}
+
+func (p *spdxParser) parseAtom(compatible func(string) bool) (bool, bool) {
+	if p.pos >= len(p.tokens) {
+		return false, false
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	switch token {
+	case "(":
+		ret, ok := p.parseOr(compatible)
+		if !ok || !p.accept(")") {
+			return false, false
+		}
+		return ret, true
+	case ")":
+		return false, false
+	default:
+		return compatible(token), true
+	}
+}
+
+func (p *spdxParser) accept(operator string) bool {
+	if p.pos < len(p.tokens) && strings.EqualFold(p.tokens[p.pos], operator) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// copyleftLicense is a version of the GPL family of licenses.
+type copyleftLicense struct {
+	family  string
+	version int
+	orLater bool
+}
+
+func parseCopyleft(id string) (copyleftLicense, bool) {
+	match := reCopyleft.FindStringSubmatch(id)
+	if match == nil {
+		return copyleftLicense{}, false
+	}
+	version, err := strconv.Atoi(match[2])
+	if err != nil {
+		return copyleftLicense{}, false
+	}
+	return copyleftLicense{
+		family:  match[1],
+		version: version,
+		orLater: match[3] == "-or-later" || match[3] == "+",
+	}, true
+}
+
+// strong reports whether the license applies to the whole work a file is part of.
+func (l copyleftLicense) strong() bool {
+	return l.family == "GPL" || l.family == "AGPL"
+}
+
+// allows reports whether the license can be applied in the given version.
+func (l copyleftLicense) allows(version int) bool {
+	return version == l.version || (l.orLater && version > l.version)
+}
+
+// licensesCompatible reports whether a file under the given license can be
+// part of a project under the project license. Permissive and weak copyleft
+// licenses are compatible with any license, except for the Apache-2.0 patent
+// clauses which the GPL version 2 forbids; strong copyleft licenses require the
+// project to be under a version of the GPL they allow. Unknown licenses are
+// assumed compatible.
+func licensesCompatible(file, project string) bool {
+	if strings.EqualFold(file, project) {
+		return true
+	}
+	projectCopyleft, projectIsCopyleft := parseCopyleft(project)
+	fileCopyleft, fileIsCopyleft := parseCopyleft(file)
+	if !fileIsCopyleft || !fileCopyleft.strong() {
+		if strings.EqualFold(file, "Apache-2.0") && projectIsCopyleft && projectCopyleft.strong() {
+			return projectCopyleft.allows(3)
+		}
+		return true
+	}
+	if !projectIsCopyleft || !projectCopyleft.strong() {
+		return false
+	}
+	// The AGPL-3.0 and GPL-3.0 explicitly allow being combined.
+	for version := 1; version <= 3; version++ {
+		if fileCopyleft.allows(version) && projectCopyleft.allows(version) {
+			return fileCopyleft.family == projectCopyleft.family || version == 3
+		}
+	}
+	return false
+}
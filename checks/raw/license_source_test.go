@@ -0,0 +1,304 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+func TestLicenseHeader(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		content    string
+		expression string
+		line       uint
+	}{
+		{
+			name:       "go header",
+			content:    "// Copyright 2026 Example\n// SPDX-License-Identifier: Apache-2.0\n\npackage main\n",
+			expression: "Apache-2.0",
+			line:       2,
+		},
+		{
+			name:       "c block comment",
+			content:    "/* SPDX-License-Identifier: GPL-2.0-only WITH Linux-syscall-note */\n",
+			expression: "GPL-2.0-only WITH Linux-syscall-note",
+			line:       1,
+		},
+		{
+			name:       "several tags",
+			content:    "# SPDX-License-Identifier: MIT OR Apache-2.0\n# SPDX-License-Identifier: BSD-3-Clause\n",
+			expression: "(MIT OR Apache-2.0) AND BSD-3-Clause",
+			line:       1,
+		},
+		{
+			name:    "tag in a regular expression",
+			content: "var re = regexp.MustCompile(`SPDX-License-Identifier:\\s*(.*)`)\n",
+		},
+		{
+			name:    "tag after the header",
+			content: strings.Repeat("\n", licenseHeaderLines) + "// SPDX-License-Identifier: MIT\n",
+		},
+		{
+			name:    "no header",
+			content: "package main\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			expression, line := licenseHeader([]byte(tt.content))
+			if expression != tt.expression || line != tt.line {
+				t.Errorf("licenseHeader() = %q, %d, want %q, %d", expression, line, tt.expression, tt.line)
+			}
+		})
+	}
+}
+
+func TestLicenseExpressionCompatible(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		expression string
+		project    []string
+		want       bool
+	}{
+		{expression: "MIT", project: []string{"Apache-2.0"}, want: true},
+		{expression: "GPL-3.0-only", project: []string{"Apache-2.0"}, want: false},
+		{expression: "GPL-2.0-or-later", project: []string{"GPL-3.0-only"}, want: true},
+		{expression: "GPL-2.0-only", project: []string{"GPL-3.0-or-later"}, want: false},
+		{expression: "GPL-2.0+", project: []string{"GPL-2.0"}, want: true},
+		{expression: "AGPL-3.0-or-later", project: []string{"GPL-3.0-only"}, want: true},
+		{expression: "AGPL-3.0-only", project: []string{"LGPL-3.0-only"}, want: false},
+		{expression: "LGPL-2.1-or-later", project: []string{"MIT"}, want: true},
+		{expression: "Apache-2.0", project: []string{"GPL-2.0-only"}, want: false},
+		{expression: "Apache-2.0", project: []string{"GPL-2.0-or-later"}, want: true},
+		{expression: "MIT OR GPL-3.0-only", project: []string{"Apache-2.0"}, want: true},
+		{expression: "MIT AND GPL-3.0-only", project: []string{"Apache-2.0"}, want: false},
+		{expression: "(MIT OR GPL-3.0-only) AND (GPL-2.0-only WITH Classpath-exception-2.0)", project: []string{"BSD-3-Clause"}, want: false},
+		{expression: "GPL-3.0-only", project: []string{"MIT", "GPL-3.0-or-later"}, want: true},
+		{expression: "LicenseRef-Proprietary", project: []string{"MIT"}, want: true},
+		{expression: "GPL-3.0-only AND (", project: []string{"MIT"}, want: true},
+	}
+	for _, tt := range tests {
+		if got := licenseExpressionCompatible(tt.expression, tt.project); got != tt.want {
+			t.Errorf("licenseExpressionCompatible(%q, %v) = %v, want %v", tt.expression, tt.project, got, tt.want)
+		}
+	}
+}
+
+func TestGlobRegexp(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pattern          string
+		path             string
+		starMatchesSlash bool
+		want             bool
+	}{
+		{pattern: "src/*.go", path: "src/main.go", want: true},
+		{pattern: "src/*.go", path: "src/sub/main.go", want: false},
+		{pattern: "src/*.go", path: "src/sub/main.go", starMatchesSlash: true, want: true},
+		{pattern: "src/**", path: "src/sub/main.go", want: true},
+		{pattern: "**/*.py", path: "a/b/c.py", want: true},
+		{pattern: "file\\*.c", path: "file*.c", want: true},
+		{pattern: "file\\*.c", path: "fileX.c", want: false},
+	}
+	for _, tt := range tests {
+		if got := globRegexp(tt.pattern, tt.starMatchesSlash).MatchString(tt.path); got != tt.want {
+			t.Errorf("globRegexp(%q, %v).MatchString(%q) = %v, want %v",
+				tt.pattern, tt.starMatchesSlash, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestLicense_DeepScan(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		"LICENSE":          "",
+		"main.go":          "// SPDX-License-Identifier: Apache-2.0\n\npackage main\n",
+		"gpl/gpl.c":        "/* SPDX-License-Identifier: GPL-3.0-only */\n",
+		"missing.py":       "print('hello')\n",
+		"image.js":         "export default 1;\n",
+		"image.js.license": "SPDX-License-Identifier: MIT\n",
+		"docs/conf.py":     "project = 'x'\n",
+		"gen/api.pb.go":    "// SPDX-License-Identifier: MIT\npackage gen\n",
+		"gen/other.go":     "package gen\n",
+		"vendor/x/x.go":    "package x\n",
+		"testdata/t.go":    "package t\n",
+		"README.md":        "# readme\n",
+		".reuse/dep5": `Format: https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/
+Upstream-Name: example
+
+Files: docs/*
+Copyright: 2026 Example
+License: CC0-1.0
+`,
+		"REUSE.toml": `version = 1
+
+[[annotations]]
+path = "gen/**"
+precedence = "override"
+SPDX-License-Identifier = "BSD-3-Clause"
+`,
+	}
+	ctrl := gomock.NewController(t)
+	mockRepo := mockrepo.NewMockRepoClient(ctrl)
+	mockRepo.EXPECT().ListLicenses().Return(nil, nil)
+	mockRepo.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+		var ret []string
+		for name := range files {
+			if ok, err := predicate(name); err == nil && ok {
+				ret = append(ret, name)
+			}
+		}
+		return ret, nil
+	}).AnyTimes()
+	mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(name string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(files[name])), nil
+	}).AnyTimes()
+
+	req := checker.CheckRequest{RepoClient: mockRepo, Ctx: context.Background(), LicenseDeepScan: true}
+	res, err := License(&req)
+	if err != nil {
+		t.Fatalf("License: %v", err)
+	}
+	if len(res.LicenseFiles) != 1 || res.LicenseFiles[0].File.Path != "LICENSE" {
+		t.Errorf("LicenseFiles = %v, want LICENSE only", res.LicenseFiles)
+	}
+	data := res.SourceLicenses
+	if data == nil {
+		t.Fatal("expected source licenses")
+	}
+	if !data.REUSE || data.ScannedFiles != 7 {
+		t.Errorf("REUSE = %v, ScannedFiles = %d, want true, 7", data.REUSE, data.ScannedFiles)
+	}
+
+	got := make(map[string]string)
+	for _, l := range data.Licenses {
+		got[l.File.Path] = string(l.Source) + ":" + l.Expression
+	}
+	want := map[string]string{
+		"docs/conf.py":  "dep5:CC0-1.0",
+		"gen/api.pb.go": "REUSE.toml:BSD-3-Clause",
+		"gen/other.go":  "REUSE.toml:BSD-3-Clause",
+		"gpl/gpl.c":     "header:GPL-3.0-only",
+		"image.js":      "sidecar:MIT",
+		"main.go":       "header:Apache-2.0",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("licenses mismatch (-want +got):\n%s", diff)
+	}
+	wantUnlicensed := []checker.File{{Path: "missing.py", Type: finding.FileTypeSource}}
+	if diff := cmp.Diff(wantUnlicensed, data.UnlicensedFiles); diff != "" {
+		t.Errorf("unlicensed files mismatch (-want +got):\n%s", diff)
+	}
+	if len(data.Conflicts) != 0 {
+		t.Errorf("unexpected conflicts without a known project license: %v", data.Conflicts)
+	}
+}
+
+func TestLicense_ProjectLicenseOrder(t *testing.T) {
+	t.Parallel()
+	files := []string{"image.png.license", "LICENSE-MIT", "image.png", "COPYING", "LICENSE"}
+	for i := range files {
+		// the project license mustn't depend on the order the files are listed in.
+		listed := append(append([]string{}, files[i:]...), files[:i]...)
+		ctrl := gomock.NewController(t)
+		mockRepo := mockrepo.NewMockRepoClient(ctrl)
+		mockRepo.EXPECT().ListLicenses().Return(nil, clients.ErrUnsupportedFeature)
+		mockRepo.EXPECT().ListFiles(gomock.Any()).Return(listed, nil)
+
+		res, err := licenseFiles(&checker.CheckRequest{RepoClient: mockRepo, LicenseDeepScan: true})
+		if err != nil {
+			t.Fatalf("licenseFiles: %v", err)
+		}
+		if len(res.LicenseFiles) != 1 || res.LicenseFiles[0].File.Path != "COPYING" {
+			t.Errorf("files %v: LicenseFiles = %v, want COPYING only", listed, res.LicenseFiles)
+		}
+	}
+}
+
+func TestLicense_FirstLicenseFile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want  string
+		files []string
+	}{
+		{files: []string{"LICENSE"}, want: "LICENSE"},
+		{files: []string{"LICENSE.md"}, want: "LICENSE.md"},
+		{files: []string{"COPYING"}, want: "COPYING"},
+		{files: []string{"license.txt"}, want: "license.txt"},
+		{files: []string{"docs/index.md", "LICENSE-APACHE", "LICENSE-MIT"}, want: "LICENSE-APACHE"},
+		// without the deep scan, the search stops at the first candidate listed.
+		{files: []string{"LICENSE", "COPYING"}, want: "LICENSE"},
+		{files: []string{"license", "LICENSE.txt"}, want: "license"},
+	}
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+		mockRepo := mockrepo.NewMockRepoClient(ctrl)
+		mockRepo.EXPECT().ListLicenses().Return(nil, clients.ErrUnsupportedFeature)
+		mockRepo.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil)
+
+		res, err := License(&checker.CheckRequest{RepoClient: mockRepo})
+		if err != nil {
+			t.Fatalf("License: %v", err)
+		}
+		if len(res.LicenseFiles) != 1 || res.LicenseFiles[0].File.Path != tt.want {
+			t.Errorf("files %v: LicenseFiles = %v, want %s only", tt.files, res.LicenseFiles, tt.want)
+		}
+	}
+}
+
+func TestSourceLicenses_Conflicts(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		"main.go":   "// SPDX-License-Identifier: Apache-2.0\n\npackage main\n",
+		"gpl/gpl.c": "\n/* SPDX-License-Identifier: GPL-3.0-only */\n",
+	}
+	ctrl := gomock.NewController(t)
+	mockRepo := mockrepo.NewMockRepoClient(ctrl)
+	mockRepo.EXPECT().ListFiles(gomock.Any()).Return([]string{"gpl/gpl.c", "main.go"}, nil)
+	mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(name string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(files[name])), nil
+	}).AnyTimes()
+
+	data, err := sourceLicenses(mockRepo, []string{"Apache-2.0"})
+	if err != nil {
+		t.Fatalf("sourceLicenses: %v", err)
+	}
+	want := []checker.LicenseConflict{{
+		SourceLicense: checker.SourceLicense{
+			File:        checker.File{Path: "gpl/gpl.c", Type: finding.FileTypeSource, Offset: 2},
+			Declaration: "gpl/gpl.c",
+			Expression:  "GPL-3.0-only",
+			Source:      checker.SourceLicenseSourceHeader,
+		},
+		ProjectLicense: "Apache-2.0",
+	}}
+	if diff := cmp.Diff(want, data.Conflicts); diff != "" {
+		t.Errorf("conflicts mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -15,9 +15,11 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
 )
 
 // Maintained checks for maintenance.
@@ -40,7 +42,7 @@ func Maintained(c *checker.CheckRequest) (checker.MaintainedData, error) {
 
 	// Recent issues.
 	issues, err := c.RepoClient.ListIssues()
-	if err != nil {
+	if err != nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
 		return result, fmt.Errorf("%w", err)
 	}
 	result.Issues = issues
@@ -51,5 +53,23 @@ func Maintained(c *checker.CheckRequest) (checker.MaintainedData, error) {
 	}
 	result.CreatedAt = createdAt
 
+	// Recent pull requests, to measure how maintainers respond to them.
+	prs, err := c.RepoClient.ListPullRequests()
+	if err != nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
+		return result, fmt.Errorf("%w", err)
+	}
+	result.PullRequests = prs
+
+	releases, err := c.RepoClient.ListReleases()
+	if err != nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
+		return result, fmt.Errorf("%w", err)
+	}
+	result.Releases = releases
+
+	result.LookBackDays = c.MaintainedLookBackDays
+	if result.LookBackDays <= 0 {
+		result.LookBackDays = checker.DefaultMaintainedLookBackDays
+	}
+
 	return result, nil
 }
@@ -50,11 +50,16 @@ func TestMaintained(t *testing.T) {
 		issues := []clients.Issue{
 			{URI: &issue},
 		}
+		prs := []clients.PullRequest{
+			{Number: 1},
+		}
 
 		mockRepoClient.EXPECT().IsArchived().Return(archived, nil)
 		mockRepoClient.EXPECT().ListCommits().Return(commits, nil)
 		mockRepoClient.EXPECT().ListIssues().Return(issues, nil)
 		mockRepoClient.EXPECT().GetCreatedAt().Return(createdAt, nil)
+		mockRepoClient.EXPECT().ListPullRequests().Return(prs, nil)
+		mockRepoClient.EXPECT().ListReleases().Return(nil, clients.ErrUnsupportedFeature)
 
 		data, err := Maintained(req)
 		if err != nil {
@@ -76,6 +81,27 @@ func TestMaintained(t *testing.T) {
 		if len(data.Issues) != len(issues) {
 			t.Errorf("unexpected number of issues: got %v, want %v", len(data.Issues), len(issues))
 		}
+
+		if len(data.PullRequests) != len(prs) {
+			t.Errorf("unexpected number of pull requests: got %v, want %v", len(data.PullRequests), len(prs))
+		}
+
+		if data.LookBackDays != checker.DefaultMaintainedLookBackDays {
+			t.Errorf("unexpected look-back days: got %v, want %v", data.LookBackDays, checker.DefaultMaintainedLookBackDays)
+		}
+	})
+
+	t.Run("returns error if ListPullRequests fails", func(t *testing.T) {
+		mockRepoClient.EXPECT().IsArchived().Return(false, nil)
+		mockRepoClient.EXPECT().ListCommits().Return([]clients.Commit{}, nil)
+		mockRepoClient.EXPECT().ListIssues().Return([]clients.Issue{}, nil)
+		mockRepoClient.EXPECT().GetCreatedAt().Return(time.Time{}, nil)
+		mockRepoClient.EXPECT().ListPullRequests().Return(nil, fmt.Errorf("some error"))
+
+		_, err := Maintained(req)
+		if err == nil {
+			t.Fatal("expected an error but got none")
+		}
 	})
 
 	t.Run("returns error if IsArchived fails", func(t *testing.T) {
@@ -108,6 +134,23 @@ func TestMaintained(t *testing.T) {
 		}
 	})
 
+	t.Run("ignores unsupported ListIssues", func(t *testing.T) {
+		mockRepoClient.EXPECT().IsArchived().Return(false, nil)
+		mockRepoClient.EXPECT().ListCommits().Return([]clients.Commit{}, nil)
+		mockRepoClient.EXPECT().ListIssues().Return(nil, clients.ErrUnsupportedFeature)
+		mockRepoClient.EXPECT().GetCreatedAt().Return(time.Time{}, nil)
+		mockRepoClient.EXPECT().ListPullRequests().Return(nil, clients.ErrUnsupportedFeature)
+		mockRepoClient.EXPECT().ListReleases().Return(nil, clients.ErrUnsupportedFeature)
+
+		data, err := Maintained(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(data.Issues) != 0 {
+			t.Errorf("unexpected number of issues: got %v, want 0", len(data.Issues))
+		}
+	})
+
 	t.Run("returns error if GetCreatedAt fails", func(t *testing.T) {
 		mockRepoClient.EXPECT().IsArchived().Return(false, nil)
 		mockRepoClient.EXPECT().ListCommits().Return([]clients.Commit{}, nil)
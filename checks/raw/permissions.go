@@ -46,6 +46,8 @@ var permissionsOfInterest = []permission{
 }
 
 type permissionCbData struct {
+	// graph resolves the callers of reusable workflows, nil if unknown.
+	graph   *fileparser.WorkflowGraph
 	results checker.TokenPermissionsData
 }
 
@@ -54,7 +56,13 @@ func TokenPermissions(c *checker.CheckRequest) (checker.TokenPermissionsData, er
 	// data is shared across all GitHub workflows.
 	var data permissionCbData
 
-	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+	graph, err := fileparser.NewWorkflowGraph(c.RepoClient)
+	if err != nil {
+		return data.results, err
+	}
+	data.graph = graph
+
+	err = fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
 		Pattern:       ".github/workflows/*",
 		CaseSensitive: false,
 	}, validateGitHubActionTokenPermissions, &data)
@@ -270,6 +278,25 @@ func validateTopLevelPermissions(workflow *actionlint.Workflow, path string,
 ) error {
 	// Check if permissions are set explicitly.
 	if workflow.Permissions == nil {
+		if callers, ok := inheritedPermissions(workflow, path, pdata); ok {
+			for _, call := range callers {
+				msg := fmt.Sprintf("top-level permissions inherited from the job calling the workflow in %s:%d",
+					call.Caller, call.Line)
+				permLoc := checker.PermissionLocationTop
+				pdata.results.TokenPermissions = append(pdata.results.TokenPermissions,
+					checker.TokenPermission{
+						File: &checker.File{
+							Path:   path,
+							Type:   finding.FileTypeSource,
+							Offset: checker.OffsetDefault,
+						},
+						LocationType: &permLoc,
+						Msg:          &msg,
+						Type:         checker.PermissionLevelUnknown,
+					})
+			}
+			return nil
+		}
 		permLoc := checker.PermissionLocationTop
 		pdata.results.TokenPermissions = append(pdata.results.TokenPermissions,
 			checker.TokenPermission{
@@ -290,6 +317,27 @@ func validateTopLevelPermissions(workflow *actionlint.Workflow, path string,
 		pdata, map[permission]bool{})
 }
 
+// inheritedPermissions returns the calls of a reusable workflow which is only called by other
+// workflows of the repository, if they all declare the permissions of the calling job.
+// The permissions of such a workflow are those of the calling job.
+func inheritedPermissions(workflow *actionlint.Workflow, path string,
+	pdata *permissionCbData,
+) ([]*fileparser.WorkflowCall, bool) {
+	if pdata.graph == nil || !fileparser.IsOnlyCalled(workflow) {
+		return nil, false
+	}
+	callers := pdata.graph.Callers(path)
+	if len(callers) == 0 {
+		return nil, false
+	}
+	for _, call := range callers {
+		if call.Permissions == nil {
+			return nil, false
+		}
+	}
+	return callers, true
+}
+
 func validatejobLevelPermissions(workflow *actionlint.Workflow, path string,
 	pdata *permissionCbData,
 	ignoredPermissions map[permission]bool,
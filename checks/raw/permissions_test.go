@@ -0,0 +1,55 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestTokenPermissionsInheritedFromCaller(t *testing.T) {
+	t.Parallel()
+
+	req := &checker.CheckRequest{
+		Ctx:        context.Background(),
+		RepoClient: workflowCallsRepoClient(t),
+	}
+	data, err := TokenPermissions(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var found bool
+	for i := range data.TokenPermissions {
+		p := &data.TokenPermissions[i]
+		if p.File == nil || p.File.Path != ".github/workflows/build.yml" ||
+			p.LocationType == nil || *p.LocationType != checker.PermissionLocationTop {
+			continue
+		}
+		if p.Type != checker.PermissionLevelUnknown {
+			t.Errorf("top-level permissions of build.yml: got %v, want %v", p.Type, checker.PermissionLevelUnknown)
+		}
+		want := "top-level permissions inherited from the job calling the workflow in .github/workflows/pr-target.yml:8"
+		if p.Msg == nil || *p.Msg != want {
+			t.Errorf("unexpected message: %v", p.Msg)
+		}
+		found = true
+	}
+	if !found {
+		t.Error("no top-level permissions reported for build.yml")
+	}
+}
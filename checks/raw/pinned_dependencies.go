@@ -73,6 +73,21 @@ func PinningDependencies(c *checker.CheckRequest) (checker.PinningDependenciesDa
 		return checker.PinningDependenciesData{}, err
 	}
 
+	// Azure Pipelines tasks, containers and templates.
+	if err := collectAzurePipelinesPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// Actions and script downloads of local composite actions.
+	if err := collectCompositeActionPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// Compose files, Kubernetes manifests, Helm charts, Terraform and devcontainers.
+	if err := collectIaCPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
 	// Nuget Post Processing
 	if err := postProcessNugetDependencies(c, &results); err != nil {
 		return checker.PinningDependenciesData{}, err
@@ -451,6 +466,18 @@ func applyDockerfilePinningRemediations(d []checker.Dependency) {
 	}
 }
 
+func isContainerImage(t checker.DependencyUseType) bool {
+	switch t {
+	case checker.DependencyUseTypeDockerfileContainerImage,
+		checker.DependencyUseTypeComposeImage,
+		checker.DependencyUseTypeKubernetesImage,
+		checker.DependencyUseTypeHelmImage,
+		checker.DependencyUseTypeDevcontainerImage:
+		return true
+	}
+	return false
+}
+
 var validateDockerfilesPinning fileparser.DoWhileTrueOnFileContent = func(
 	pathfn string,
 	content []byte,
@@ -681,6 +708,72 @@ var validateGitHubWorkflowIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFile
 	return true, nil
 }
 
+// collectCompositeActionPinning checks the actions and the scripts of local composite actions,
+// which run as part of the steps of the workflows calling them.
+func collectCompositeActionPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	graph, err := fileparser.NewWorkflowGraph(c.RepoClient)
+	if err != nil {
+		return err
+	}
+	for _, cycle := range graph.Cycles() {
+		r.ProcessingErrors = append(r.ProcessingErrors, checker.ElementError{
+			Err: sce.WithMessage(sce.ErrScorecardInternal,
+				fmt.Sprintf("%v: %s", errWorkflowCallCycle, strings.Join(cycle, " -> "))),
+			Location: finding.Location{
+				Path: cycle[0],
+				Type: finding.FileTypeSource,
+			},
+		})
+	}
+	for _, p := range sortedActionPaths(graph) {
+		start := len(r.Dependencies)
+		validateCompositeActionPinning(graph.Actions[p], r)
+		if callers := graph.Callers(p); len(callers) > 0 {
+			caller := createWorkflowCall(callers[:1])
+			for i := start; i < len(r.Dependencies); i++ {
+				r.Dependencies[i].Caller = caller
+			}
+		}
+	}
+	return nil
+}
+
+func validateCompositeActionPinning(action *fileparser.CompositeAction, pdata *checker.PinningDependenciesData) {
+	githubVarRegex := regexp.MustCompile(`{{[^{}]*}}`)
+	taintedFiles := make(map[string]bool)
+	for _, step := range action.Steps {
+		switch {
+		case step.Uses != "" && !strings.HasPrefix(step.Uses, "./"):
+			dep := checker.Dependency{
+				Location: &checker.File{
+					Path:      action.Path,
+					Type:      finding.FileTypeSource,
+					Offset:    step.UsesLine,
+					EndOffset: step.UsesLine,
+					Snippet:   step.Uses,
+				},
+				Pinned: asBoolPointer(isActionDependencyPinned(step.Uses)),
+				Type:   checker.DependencyUseTypeGHAction,
+			}
+			name, pinnedAt, ok := strings.Cut(step.Uses, "@")
+			dep.Name = asPointer(name)
+			if ok {
+				dep.PinnedAt = asPointer(pinnedAt)
+			}
+			pdata.Dependencies = append(pdata.Dependencies, dep)
+		case step.Run != "" && isSupportedShell(step.Shell):
+			// Composite actions must set the shell of their `run` steps.
+			script := githubVarRegex.ReplaceAll([]byte(step.Run), []byte("GITHUB_REDACTED_VAR"))
+			if err := validateShellFile(action.Path, step.RunLine, step.RunLine,
+				script, taintedFiles, pdata); err != nil {
+				pdata.Dependencies = append(pdata.Dependencies, checker.Dependency{
+					Msg: asPointer(err.Error()),
+				})
+			}
+		}
+	}
+}
+
 // Check pinning of github actions in workflows.
 func collectGitHubActionsWorkflowPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
 	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
@@ -802,3 +895,108 @@ func isActionDependencyPinned(actionUses string) bool {
 	dockerhubActionRegex := regexp.MustCompile(`docker://.*@sha256:[a-fA-F\d]{64}`)
 	return dockerhubActionRegex.MatchString(actionUses)
 }
+
+func collectAzurePipelinesPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	return fileparser.OnAzurePipelinesFileContentDo(c.RepoClient, validateAzurePipelinesPinning, r)
+}
+
+// validateAzurePipelinesPinning checks if the pipeline uses tasks not pinned to a full version,
+// container images not pinned by digest, or templates from repositories not pinned to a commit.
+var validateAzurePipelinesPinning fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsAzurePipelinesFile(pathfn) {
+		return true, nil
+	}
+
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"validateAzurePipelinesPinning requires exactly 1 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+
+	if !fileparser.CheckFileContainsCommands(content, "#") {
+		return true, nil
+	}
+
+	pipeline, err := fileparser.ParseAzurePipeline(content)
+	if err != nil {
+		// Files in pipeline directories are not necessarily pipelines: record and skip them.
+		pdata.ProcessingErrors = append(pdata.ProcessingErrors, checker.ElementError{
+			Err: sce.WithMessage(sce.ErrScorecardInternal, err.Error()),
+			Location: finding.Location{
+				Path: pathfn,
+				Type: finding.FileTypeSource,
+			},
+		})
+		return true, nil
+	}
+
+	addDep := func(line uint, snippet, name, pinnedAt string, pinned bool, depType checker.DependencyUseType) {
+		dep := checker.Dependency{
+			Location: &checker.File{
+				Path:      pathfn,
+				Type:      finding.FileTypeSource,
+				Offset:    line,
+				EndOffset: line,
+				Snippet:   snippet,
+			},
+			Name:   asPointer(name),
+			Pinned: asBoolPointer(pinned),
+			Type:   depType,
+		}
+		if pinnedAt != "" {
+			dep.PinnedAt = asPointer(pinnedAt)
+		}
+		pdata.Dependencies = append(pdata.Dependencies, dep)
+	}
+
+	for _, job := range pipeline.Jobs {
+		for _, step := range job.Steps {
+			if step.Task == "" {
+				continue
+			}
+			name, version := fileparser.SplitAzureTask(step.Task)
+			addDep(step.Line, step.Task, name, version,
+				fileparser.IsAzureTaskPinned(step.Task), checker.DependencyUseTypeAzurePipelinesTask)
+		}
+	}
+
+	digest := regexp.MustCompile(`.*@sha256:[a-f\d]{64}`)
+	for _, container := range pipeline.Containers {
+		name, pinnedAt := splitImageReference(container.Image)
+		addDep(container.Line, container.Image, name, pinnedAt,
+			digest.MatchString(container.Image), checker.DependencyUseTypeDockerfileContainerImage)
+	}
+
+	for _, tmpl := range pipeline.Templates {
+		if tmpl.Repository == "" {
+			// Templates in the same repository are pinned with the pipeline.
+			continue
+		}
+		repo, ok := pipeline.Repositories[tmpl.Repository]
+		if !ok {
+			// The resource is declared by the pipeline including this template.
+			continue
+		}
+		addDep(tmpl.Line, tmpl.Path+"@"+tmpl.Repository, repo.Name, repo.Ref,
+			repo.IsPinned(), checker.DependencyUseTypeAzurePipelinesTemplate)
+	}
+
+	return true, nil
+}
+
+// splitImageReference splits a container image reference into its name and
+// its digest or tag.
+func splitImageReference(image string) (name, pinnedAt string) {
+	if name, digest, ok := strings.Cut(image, "@"); ok {
+		return name, digest
+	}
+	// The registry host may have a port: only look for a tag in the last path component.
+	if i := strings.LastIndexByte(image, ':'); i > strings.LastIndexByte(image, '/') {
+		return image[:i], image[i+1:]
+	}
+	return image, ""
+}
@@ -0,0 +1,676 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/remediation"
+)
+
+var (
+	imageDigest     = regexp.MustCompile(`@sha256:[a-f\d]{64}$`)
+	composeFile     = regexp.MustCompile(`^(docker-)?compose(\.[\w.-]+)?\.ya?ml$`)
+	helmValuesFile  = regexp.MustCompile(`^values([-.][\w.-]+)?\.ya?ml$`)
+	commitSHA       = regexp.MustCompile(`^([a-f\d]{40}|[a-f\d]{64})$`)
+	exactVersion    = regexp.MustCompile(`^=?\s*v?\d+\.\d+\.\d+([-+][\w.+-]*)?$`)
+	hclBlockHeader  = regexp.MustCompile(`^([\w-]+)((?:\s+"[^"]*")*)\s*\{`)
+	hclObject       = regexp.MustCompile(`^([\w-]+)\s*=\s*\{`)
+	hclAttribute    = regexp.MustCompile(`([\w-]+)\s*=\s*"([^"]*)"`)
+	hclBlockLabel   = regexp.MustCompile(`"([^"]*)"`)
+	terraformModule = regexp.MustCompile(`^(?:[\w.-]+\.[\w-]+/)?[\w-]+/[\w-]+/[\w-]+$`)
+	// Top-level keys every Kubernetes manifest has.
+	kubernetesAPIVersion = regexp.MustCompile(`(?m)^apiVersion:[ \t]*\S`)
+	kubernetesKind       = regexp.MustCompile(`(?m)^kind:[ \t]*\S`)
+)
+
+const (
+	helmChartFile       = "Chart.yaml"
+	helmChartLock       = "Chart.lock"
+	helmRequirements    = "requirements.yaml"
+	helmRequirementsLck = "requirements.lock"
+	terraformLockFile   = ".terraform.lock.hcl"
+	terraformRegistry   = "registry.terraform.io"
+	// maxImageDigestLookups bounds the registry lookups of the digests suggested
+	// to pin the images of infrastructure-as-code files.
+	maxImageDigestLookups = 20
+)
+
+// iacPinningData holds the dependencies of infrastructure-as-code files until all the files,
+// and the lock files pinning some of these dependencies, are read.
+type iacPinningData struct {
+	pdata *checker.PinningDependenciesData
+	// Dependencies of Helm charts and Terraform providers, pinned by a lock file of their directory.
+	charts    []lockedDependency
+	providers []lockedDependency
+	// Images of Helm values files, only reported if their directory is a chart.
+	values []lockedDependency
+	// Lock file entries and chart directories, by path.Join(directory, name).
+	chartLocks    map[string]bool
+	providerLocks map[string]bool
+	chartDirs     map[string]bool
+}
+
+type lockedDependency struct {
+	dep checker.Dependency
+	dir string
+	key string
+}
+
+func isIaCFile(pathfn string) bool {
+	base := path.Base(pathfn)
+	switch {
+	case composeFile.MatchString(base),
+		base == helmChartFile, base == helmChartLock, base == helmRequirements, base == helmRequirementsLck,
+		helmValuesFile.MatchString(base),
+		base == terraformLockFile, strings.HasSuffix(base, ".tf"),
+		isDevcontainerFile(pathfn):
+		return true
+	}
+	return isKubernetesManifestCandidate(pathfn)
+}
+
+func isDevcontainerFile(pathfn string) bool {
+	base := path.Base(pathfn)
+	return base == ".devcontainer.json" ||
+		(base == "devcontainer.json" && strings.Contains("/"+path.Dir(pathfn)+"/", "/.devcontainer/"))
+}
+
+// isKubernetesManifestCandidate returns true for the YAML files which may be
+// Kubernetes manifests. Hidden files and directories hold the configurations of
+// CI systems and other tools, e.g. .github or .gitlab-ci.yml, and are skipped, as
+// are the metadata files of GitHub Actions.
+func isKubernetesManifestCandidate(pathfn string) bool {
+	ext := path.Ext(pathfn)
+	if ext != ".yaml" && ext != ".yml" {
+		return false
+	}
+	for _, part := range strings.Split(pathfn, "/") {
+		if strings.HasPrefix(part, ".") {
+			return false
+		}
+	}
+	base := strings.TrimSuffix(path.Base(pathfn), ext)
+	return base != "action"
+}
+
+// isKubernetesManifest returns true if a YAML file has the top-level apiVersion
+// and kind keys of Kubernetes manifests, so that other files aren't parsed.
+func isKubernetesManifest(content []byte) bool {
+	return kubernetesAPIVersion.Match(content) && kubernetesKind.Match(content)
+}
+
+// collectIaCPinning collects the container images, Helm charts and Terraform modules
+// and providers used by infrastructure-as-code files.
+func collectIaCPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	data := newIaCPinningData(r)
+	start := len(r.Dependencies)
+	if err := fileparser.OnPredicateFileContentDo(c.RepoClient, isIaCFile, validateIaCPinning, data); err != nil {
+		return err
+	}
+	data.resolve()
+	applyImagePinningRemediations(r.Dependencies[start:],
+		newCachingDigester(remediation.CraneDigester{}, maxImageDigestLookups))
+	return nil
+}
+
+// applyImagePinningRemediations sets the remediations of the unpinned container
+// images of infrastructure-as-code files.
+func applyImagePinningRemediations(d []checker.Dependency, digester remediation.Digester) {
+	for i := range d {
+		rr := &d[i]
+		if isContainerImage(rr.Type) && !*rr.Pinned {
+			rr.Remediation = remediation.CreateDockerfilePinningRemediation(rr, digester)
+		}
+	}
+}
+
+// cachingDigester looks up the digest of each image once, and of at most max
+// images: infrastructure-as-code files may use many images, and each lookup is
+// a request to a registry.
+type cachingDigester struct {
+	digester remediation.Digester
+	digests  map[string]cachedDigest
+	max      int
+}
+
+type cachedDigest struct {
+	err    error
+	digest string
+}
+
+func newCachingDigester(digester remediation.Digester, maxLookups int) *cachingDigester {
+	return &cachingDigester{
+		digester: digester,
+		digests:  make(map[string]cachedDigest),
+		max:      maxLookups,
+	}
+}
+
+func (c *cachingDigester) Digest(name string) (string, error) {
+	if d, ok := c.digests[name]; ok {
+		return d.digest, d.err
+	}
+	if len(c.digests) >= c.max {
+		return "", errDigestLookupLimit
+	}
+	digest, err := c.digester.Digest(name)
+	c.digests[name] = cachedDigest{digest: digest, err: err}
+	return digest, err //nolint:wrapcheck // error value not used
+}
+
+func newIaCPinningData(r *checker.PinningDependenciesData) *iacPinningData {
+	return &iacPinningData{
+		pdata:         r,
+		chartLocks:    make(map[string]bool),
+		providerLocks: make(map[string]bool),
+		chartDirs:     make(map[string]bool),
+	}
+}
+
+var validateIaCPinning fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"validateIaCPinning requires exactly 1 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	data, ok := args[0].(*iacPinningData)
+	if !ok {
+		return false, fmt.Errorf("%w: validateIaCPinning expects *iacPinningData", errInvalidArgType)
+	}
+	if !isIaCFile(pathfn) || fileIsInVendorDir(pathfn) {
+		return true, nil
+	}
+
+	dir := path.Dir(pathfn)
+	if dir == "." {
+		dir = ""
+	}
+	base := path.Base(pathfn)
+	switch {
+	case composeFile.MatchString(base):
+		data.parseYAML(pathfn, content, data.addComposeImages)
+	case base == helmChartFile || base == helmRequirements:
+		data.chartDirs[dir] = true
+		data.parseYAML(pathfn, content, func(pathfn string, doc *yaml.Node) {
+			data.addHelmChartDependencies(pathfn, dir, doc)
+		})
+	case base == helmChartLock || base == helmRequirementsLck:
+		data.parseYAML(pathfn, content, func(_ string, doc *yaml.Node) {
+			for _, dep := range yamlSequence(yamlValue(doc, "dependencies")) {
+				if name := yamlString(dep, "name"); name != "" {
+					data.chartLocks[path.Join(dir, name)] = true
+				}
+			}
+		})
+	case helmValuesFile.MatchString(base):
+		data.parseYAML(pathfn, content, func(pathfn string, doc *yaml.Node) {
+			data.addHelmValuesImages(pathfn, dir, doc)
+		})
+	case base == terraformLockFile:
+		for _, b := range parseHCLBlocks(content) {
+			if b.kind == "provider" && len(b.labels) == 1 {
+				data.providerLocks[path.Join(dir, terraformProviderAddress(b.labels[0]))] = true
+			}
+		}
+	case strings.HasSuffix(base, ".tf"):
+		data.addTerraformDependencies(pathfn, dir, content)
+	case isDevcontainerFile(pathfn):
+		data.parseYAML(pathfn, stripJSONComments(content), func(pathfn string, doc *yaml.Node) {
+			if image := yamlValue(doc, "image"); image != nil && image.Kind == yaml.ScalarNode {
+				data.addImage(pathfn, image, image.Value, checker.DependencyUseTypeDevcontainerImage)
+			}
+		})
+	default:
+		if isKubernetesManifest(content) {
+			data.parseYAML(pathfn, content, data.addKubernetesImages)
+		}
+	}
+	return true, nil
+}
+
+// parseYAML calls fn on each document of a YAML file. Files which aren't valid YAML,
+// e.g. Helm templates, are skipped.
+func (d *iacPinningData) parseYAML(pathfn string, content []byte, fn func(string, *yaml.Node)) {
+	dec := yaml.NewDecoder(strings.NewReader(string(content)))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			return
+		}
+		if len(doc.Content) == 1 {
+			fn(pathfn, doc.Content[0])
+		}
+	}
+}
+
+func (d *iacPinningData) addImage(pathfn string, node *yaml.Node, image string, depType checker.DependencyUseType) {
+	image = strings.TrimSpace(image)
+	// Images set by variables or templates are resolved elsewhere.
+	if image == "" || strings.Contains(image, "${") || strings.Contains(image, "{{") {
+		return
+	}
+	d.pdata.Dependencies = append(d.pdata.Dependencies, imageDependency(pathfn, node.Line, image, depType))
+}
+
+func imageDependency(pathfn string, line int, image string, depType checker.DependencyUseType) checker.Dependency {
+	name, pinnedAt := splitImageReference(image)
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    uint(line),
+			EndOffset: uint(line),
+			Snippet:   image,
+		},
+		Name:   asPointer(name),
+		Pinned: asBoolPointer(imageDigest.MatchString(image)),
+		Type:   depType,
+	}
+	if pinnedAt != "" {
+		dep.PinnedAt = asPointer(pinnedAt)
+	}
+	return dep
+}
+
+func (d *iacPinningData) addComposeImages(pathfn string, doc *yaml.Node) {
+	services := yamlValue(doc, "services")
+	if services == nil || services.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 1; i < len(services.Content); i += 2 {
+		if image := yamlValue(services.Content[i], "image"); image != nil && image.Kind == yaml.ScalarNode {
+			d.addImage(pathfn, image, image.Value, checker.DependencyUseTypeComposeImage)
+		}
+	}
+}
+
+// addKubernetesImages adds the images of the containers of a Kubernetes manifest,
+// whatever the kind of the workload.
+func (d *iacPinningData) addKubernetesImages(pathfn string, doc *yaml.Node) {
+	if yamlString(doc, "apiVersion") == "" || yamlString(doc, "kind") == "" {
+		return
+	}
+	walkYAML(doc, func(key string, value *yaml.Node) {
+		switch key {
+		case "containers", "initContainers", "ephemeralContainers":
+			for _, container := range yamlSequence(value) {
+				if image := yamlValue(container, "image"); image != nil && image.Kind == yaml.ScalarNode {
+					d.addImage(pathfn, image, image.Value, checker.DependencyUseTypeKubernetesImage)
+				}
+			}
+		}
+	})
+}
+
+func (d *iacPinningData) addHelmChartDependencies(pathfn, dir string, doc *yaml.Node) {
+	for _, node := range yamlSequence(yamlValue(doc, "dependencies")) {
+		name := yamlString(node, "name")
+		repository := yamlString(node, "repository")
+		// Charts without repository or with a file:// repository are part of the project.
+		if name == "" || repository == "" || strings.HasPrefix(repository, "file://") {
+			continue
+		}
+		dep := checker.Dependency{
+			Location: &checker.File{
+				Path:      pathfn,
+				Type:      finding.FileTypeSource,
+				Offset:    uint(node.Line),
+				EndOffset: uint(node.Line),
+				Snippet:   fmt.Sprintf("%s %s", name, repository),
+			},
+			Name: asPointer(name),
+			Type: checker.DependencyUseTypeHelmChart,
+		}
+		if version := yamlString(node, "version"); version != "" {
+			dep.PinnedAt = asPointer(version)
+		}
+		d.charts = append(d.charts, lockedDependency{dep: dep, dir: dir, key: name})
+	}
+}
+
+// addHelmValuesImages adds the images set in the values of a Helm chart, either as
+// `image: name:tag` or as `image: {registry, repository, tag, digest}`.
+func (d *iacPinningData) addHelmValuesImages(pathfn, dir string, doc *yaml.Node) {
+	walkYAML(doc, func(key string, value *yaml.Node) {
+		if key != "image" {
+			return
+		}
+		var image string
+		switch value.Kind {
+		case yaml.ScalarNode:
+			image = value.Value
+		case yaml.MappingNode:
+			repository := yamlString(value, "repository")
+			if repository == "" {
+				return
+			}
+			image = repository
+			if registry := yamlString(value, "registry"); registry != "" {
+				image = registry + "/" + image
+			}
+			if tag := yamlString(value, "tag"); tag != "" {
+				image += ":" + tag
+			}
+			if digest := yamlString(value, "digest"); digest != "" && !strings.Contains(image, "@") {
+				image += "@" + digest
+			}
+		default:
+			return
+		}
+		image = strings.TrimSpace(image)
+		if image == "" || strings.Contains(image, "{{") {
+			return
+		}
+		d.values = append(d.values, lockedDependency{
+			dep: imageDependency(pathfn, value.Line, image, checker.DependencyUseTypeHelmImage),
+			dir: dir,
+		})
+	})
+}
+
+func (d *iacPinningData) addTerraformDependencies(pathfn, dir string, content []byte) {
+	for _, b := range parseHCLBlocks(content) {
+		switch {
+		case b.kind == "module":
+			source := b.attributes["source"]
+			if source == "" || strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../") {
+				continue
+			}
+			d.pdata.Dependencies = append(d.pdata.Dependencies,
+				terraformModuleDependency(pathfn, b.line, source, b.attributes["version"]))
+		case b.parent == "required_providers":
+			address := terraformProviderAddress(b.attributes["source"])
+			if b.attributes["source"] == "" {
+				address = terraformProviderAddress(b.kind)
+			}
+			dep := checker.Dependency{
+				Location: &checker.File{
+					Path:      pathfn,
+					Type:      finding.FileTypeSource,
+					Offset:    uint(b.line),
+					EndOffset: uint(b.line),
+					Snippet:   address,
+				},
+				Name: asPointer(address),
+				Type: checker.DependencyUseTypeTerraformProvider,
+			}
+			if version := b.attributes["version"]; version != "" {
+				dep.PinnedAt = asPointer(version)
+			}
+			d.providers = append(d.providers, lockedDependency{dep: dep, dir: dir, key: address})
+		}
+	}
+}
+
+// terraformModuleDependency returns a module from a registry, pinned to an exact version,
+// or from another repository, pinned to a commit.
+func terraformModuleDependency(pathfn string, line int, source, version string) checker.Dependency {
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    uint(line),
+			EndOffset: uint(line),
+			Snippet:   source,
+		},
+		Type: checker.DependencyUseTypeTerraformModule,
+	}
+	registry := isTerraformRegistryModule(source)
+	if registry {
+		dep.Name = asPointer(source)
+		if version != "" {
+			dep.PinnedAt = asPointer(version)
+		}
+		dep.Pinned = asBoolPointer(exactVersion.MatchString(strings.TrimSpace(version)))
+	} else {
+		name, query, _ := strings.Cut(source, "?")
+		dep.Name = asPointer(name)
+		ref := ""
+		if values, err := url.ParseQuery(query); err == nil {
+			ref = values.Get("ref")
+		}
+		if ref != "" {
+			dep.PinnedAt = asPointer(ref)
+		}
+		dep.Pinned = asBoolPointer(commitSHA.MatchString(ref))
+	}
+	if !*dep.Pinned {
+		dep.Remediation = remediation.CreateTerraformModulePinningRemediation(&dep, registry)
+	}
+	return dep
+}
+
+// isTerraformRegistryModule returns true for `namespace/name/provider` and
+// `hostname/namespace/name/provider` sources.
+func isTerraformRegistryModule(source string) bool {
+	if !terraformModule.MatchString(source) {
+		return false
+	}
+	parts := strings.Split(source, "/")
+	// github.com/org/repo is a repository, not a registry module.
+	return len(parts) == 4 || !strings.Contains(parts[0], ".")
+}
+
+// terraformProviderAddress returns the fully qualified address of a provider source.
+func terraformProviderAddress(source string) string {
+	source = strings.ToLower(strings.TrimSpace(source))
+	switch strings.Count(source, "/") {
+	case 0:
+		return terraformRegistry + "/hashicorp/" + source
+	case 1:
+		return terraformRegistry + "/" + source
+	default:
+		return source
+	}
+}
+
+// resolve adds the dependencies pinned by lock files.
+func (d *iacPinningData) resolve() {
+	for i := range d.charts {
+		c := &d.charts[i]
+		c.dep.Pinned = asBoolPointer(d.chartLocks[path.Join(c.dir, c.key)])
+		if !*c.dep.Pinned {
+			c.dep.Remediation = remediation.CreateHelmChartPinningRemediation(c.dir)
+		}
+		d.pdata.Dependencies = append(d.pdata.Dependencies, c.dep)
+	}
+	for i := range d.providers {
+		p := &d.providers[i]
+		p.dep.Pinned = asBoolPointer(d.providerLocks[path.Join(p.dir, p.key)])
+		if !*p.dep.Pinned {
+			p.dep.Remediation = remediation.CreateTerraformProviderPinningRemediation(p.dir)
+		}
+		d.pdata.Dependencies = append(d.pdata.Dependencies, p.dep)
+	}
+	for i := range d.values {
+		if d.chartDirs[d.values[i].dir] {
+			d.pdata.Dependencies = append(d.pdata.Dependencies, d.values[i].dep)
+		}
+	}
+}
+
+// hclBlock is a block of an HCL file, or an object attribute such as the
+// providers of a `required_providers` block.
+type hclBlock struct {
+	attributes map[string]string
+	kind       string
+	parent     string
+	labels     []string
+	line       int
+}
+
+// parseHCLBlocks returns the blocks of an HCL file with their string attributes. It doesn't
+// evaluate expressions and only supports the subset of HCL used to declare modules and providers.
+func parseHCLBlocks(content []byte) []*hclBlock {
+	var (
+		blocks []*hclBlock
+		stack  []*hclBlock
+	)
+	parent := func() string {
+		if len(stack) == 0 || stack[len(stack)-1] == nil {
+			return ""
+		}
+		return stack[len(stack)-1].kind
+	}
+	inComment := false
+	for i, line := range strings.Split(string(content), "\n") {
+		line, inComment = stripHCLComments(line, inComment)
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		opens, closes := countBraces(line)
+
+		var block *hclBlock
+		if m := hclObject.FindStringSubmatch(line); m != nil {
+			block = &hclBlock{kind: m[1], parent: parent(), line: i + 1}
+		} else if m := hclBlockHeader.FindStringSubmatch(line); m != nil {
+			block = &hclBlock{kind: m[1], parent: parent(), line: i + 1}
+			for _, l := range hclBlockLabel.FindAllStringSubmatch(m[2], -1) {
+				block.labels = append(block.labels, l[1])
+			}
+		}
+		if block != nil {
+			block.attributes = make(map[string]string)
+			blocks = append(blocks, block)
+			// Attributes on the same line, e.g. `aws = { source = "hashicorp/aws" }`.
+			_, body, _ := strings.Cut(line, "{")
+			for _, a := range hclAttribute.FindAllStringSubmatch(body, -1) {
+				block.attributes[a[1]] = a[2]
+			}
+		} else if len(stack) > 0 && stack[len(stack)-1] != nil {
+			if a := hclAttribute.FindStringSubmatch(line); a != nil && strings.HasPrefix(line, a[0]) {
+				stack[len(stack)-1].attributes[a[1]] = a[2]
+			}
+		}
+
+		for n := opens - closes; n > 0; n-- {
+			stack = append(stack, block)
+			block = nil
+		}
+		for n := closes - opens; n > 0 && len(stack) > 0; n-- {
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return blocks
+}
+
+// stripHCLComments removes the comments of an HCL line, given whether the line starts in a
+// multi-line comment.
+func stripHCLComments(line string, inComment bool) (string, bool) {
+	var b strings.Builder
+	inString := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case inComment:
+			if c == '*' && i+1 < len(line) && line[i+1] == '/' {
+				inComment = false
+				i++
+			}
+		case inString:
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(line) {
+				i++
+				b.WriteByte(line[i])
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+			b.WriteByte(c)
+		case c == '#', c == '/' && i+1 < len(line) && line[i+1] == '/':
+			return b.String(), false
+		case c == '/' && i+1 < len(line) && line[i+1] == '*':
+			inComment = true
+			i++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), inComment
+}
+
+func countBraces(line string) (opens, closes int) {
+	inString := false
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case inString && c == '\\':
+			i++
+		case c == '"':
+			inString = !inString
+		case !inString && c == '{':
+			opens++
+		case !inString && c == '}':
+			closes++
+		}
+	}
+	return opens, closes
+}
+
+// yamlValue returns the value of a key of a YAML mapping, or nil.
+func yamlValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func yamlString(node *yaml.Node, key string) string {
+	if v := yamlValue(node, key); v != nil && v.Kind == yaml.ScalarNode {
+		return v.Value
+	}
+	return ""
+}
+
+func yamlSequence(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return node.Content
+}
+
+// walkYAML calls fn on every key of the mappings of a YAML tree.
+func walkYAML(node *yaml.Node, fn func(key string, value *yaml.Node)) {
+	if node == nil {
+		return
+	}
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			fn(node.Content[i].Value, node.Content[i+1])
+		}
+	}
+	for _, child := range node.Content {
+		walkYAML(child, fn)
+	}
+}
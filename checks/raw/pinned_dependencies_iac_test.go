@@ -0,0 +1,286 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestValidateIaCPinning(t *testing.T) {
+	t.Parallel()
+	files := []string{
+		"docker-compose.yml",
+		"k8s/deployment.yaml",
+		"chart/Chart.yaml",
+		"chart/Chart.lock",
+		"chart/values.yaml",
+		"chart/templates/deployment.yaml",
+		"terraform/main.tf",
+		"terraform/.terraform.lock.hcl",
+		".devcontainer/devcontainer.json",
+	}
+	var r checker.PinningDependenciesData
+	data := newIaCPinningData(&r)
+	for _, f := range files {
+		content, err := os.ReadFile(path.Join("testdata/iac", f))
+		if err != nil {
+			t.Fatalf("cannot read file: %v", err)
+		}
+		if _, err := validateIaCPinning(f, content, data); err != nil {
+			t.Fatalf("validateIaCPinning: %v", err)
+		}
+	}
+	data.resolve()
+
+	type dep struct {
+		path        string
+		snippet     string
+		depType     checker.DependencyUseType
+		line        uint
+		pinned      bool
+		remediation bool
+	}
+	want := []dep{
+		{path: "docker-compose.yml", snippet: "nginx:1.25", depType: checker.DependencyUseTypeComposeImage, line: 3},
+		{
+			path:    "docker-compose.yml",
+			snippet: "postgres@sha256:4c3e2f8d0a3b6e2b8c1d5f7a9e0b2c4d6f8a1b3c5e7d9f0a2b4c6d8e0f1a3b5c",
+			depType: checker.DependencyUseTypeComposeImage, line: 5, pinned: true,
+		},
+		{path: "k8s/deployment.yaml", snippet: "busybox:1.36", depType: checker.DependencyUseTypeKubernetesImage, line: 10},
+		{
+			path:    "k8s/deployment.yaml",
+			snippet: "ghcr.io/example/web@sha256:8e6d2ac0cf1d6e5b2c21ac2b3f5e6ac1b2c96a1b8c7b53ecfd9a5ce1c4d1d2a3",
+			depType: checker.DependencyUseTypeKubernetesImage, line: 13, pinned: true,
+		},
+		{
+			path: "k8s/deployment.yaml", snippet: "registry.example.com:5000/tools/cleanup",
+			depType: checker.DependencyUseTypeKubernetesImage, line: 26,
+		},
+		{
+			path: "terraform/main.tf", snippet: "terraform-aws-modules/vpc/aws",
+			depType: checker.DependencyUseTypeTerraformModule, line: 13, remediation: true,
+		},
+		{
+			path: "terraform/main.tf", snippet: "app.terraform.io/example/sg/aws",
+			depType: checker.DependencyUseTypeTerraformModule, line: 18, pinned: true,
+		},
+		{
+			path: "terraform/main.tf", snippet: "git::https://github.com/example/network.git?ref=v1.0.0",
+			depType: checker.DependencyUseTypeTerraformModule, line: 23, remediation: true,
+		},
+		{
+			path: "terraform/main.tf", snippet: "github.com/example/dns?ref=8e6d2ac0cf1d6e5b2c21ac2b3f5e6ac1b2c96a1b",
+			depType: checker.DependencyUseTypeTerraformModule, line: 27, pinned: true,
+		},
+		{
+			path: ".devcontainer/devcontainer.json", snippet: "mcr.microsoft.com/devcontainers/go:1-1.22-bookworm",
+			depType: checker.DependencyUseTypeDevcontainerImage, line: 4,
+		},
+		{
+			path: "chart/Chart.yaml", snippet: "postgresql https://charts.bitnami.com/bitnami",
+			depType: checker.DependencyUseTypeHelmChart, line: 5, pinned: true,
+		},
+		{
+			path: "chart/Chart.yaml", snippet: "redis oci://registry-1.docker.io/bitnamicharts",
+			depType: checker.DependencyUseTypeHelmChart, line: 8, remediation: true,
+		},
+		{
+			path: "terraform/main.tf", snippet: "registry.terraform.io/hashicorp/aws",
+			depType: checker.DependencyUseTypeTerraformProvider, line: 3, pinned: true,
+		},
+		{
+			path: "terraform/main.tf", snippet: "registry.terraform.io/hashicorp/random",
+			depType: checker.DependencyUseTypeTerraformProvider, line: 7, remediation: true,
+		},
+		{path: "chart/values.yaml", snippet: "docker.io/example/app:1.2.3", depType: checker.DependencyUseTypeHelmImage, line: 2},
+		{
+			path:    "chart/values.yaml",
+			snippet: "example/sidecar@sha256:8e6d2ac0cf1d6e5b2c21ac2b3f5e6ac1b2c96a1b8c7b53ecfd9a5ce1c4d1d2a3",
+			depType: checker.DependencyUseTypeHelmImage, line: 7, pinned: true,
+		},
+		{path: "chart/values.yaml", snippet: "prom/statsd-exporter:v0.26.0", depType: checker.DependencyUseTypeHelmImage, line: 10},
+	}
+	got := make([]dep, 0, len(r.Dependencies))
+	for _, d := range r.Dependencies {
+		got = append(got, dep{
+			path:        d.Location.Path,
+			snippet:     d.Location.Snippet,
+			depType:     d.Type,
+			line:        d.Location.Offset,
+			pinned:      *d.Pinned,
+			remediation: d.Remediation != nil,
+		})
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(dep{})); diff != "" {
+		t.Errorf("dependencies mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidateIaCPinning_valuesOutsideChart(t *testing.T) {
+	t.Parallel()
+	var r checker.PinningDependenciesData
+	data := newIaCPinningData(&r)
+	if _, err := validateIaCPinning("config/values.yaml", []byte("image: nginx:1.25\n"), data); err != nil {
+		t.Fatalf("validateIaCPinning: %v", err)
+	}
+	data.resolve()
+	if len(r.Dependencies) != 0 {
+		t.Errorf("expected no dependency, got %v", r.Dependencies)
+	}
+}
+
+func TestIsKubernetesManifestCandidate(t *testing.T) {
+	t.Parallel()
+	for pathfn, want := range map[string]bool{
+		"k8s/deployment.yaml":     true,
+		"deploy/app.yml":          true,
+		".gitlab-ci.yml":          false,
+		".github/dependabot.yml":  false,
+		"ci/.circleci/config.yml": false,
+		"action.yml":              false,
+		"tools/lint/action.yaml":  false,
+		"k8s/deployment.json":     false,
+	} {
+		if got := isKubernetesManifestCandidate(pathfn); got != want {
+			t.Errorf("isKubernetesManifestCandidate(%q) = %v, want %v", pathfn, got, want)
+		}
+	}
+}
+
+func TestValidateIaCPinning_notKubernetesManifest(t *testing.T) {
+	t.Parallel()
+	var r checker.PinningDependenciesData
+	data := newIaCPinningData(&r)
+	content := []byte("jobs:\n  build:\n    containers:\n      - image: nginx:1.25\n")
+	if _, err := validateIaCPinning("deploy/pipeline.yaml", content, data); err != nil {
+		t.Fatalf("validateIaCPinning: %v", err)
+	}
+	data.resolve()
+	if len(r.Dependencies) != 0 {
+		t.Errorf("expected no dependency, got %v", r.Dependencies)
+	}
+}
+
+type countingDigester struct {
+	calls map[string]int
+}
+
+func (d *countingDigester) Digest(name string) (string, error) {
+	d.calls[name]++
+	return "sha256:0123", nil
+}
+
+func TestApplyImagePinningRemediations(t *testing.T) {
+	t.Parallel()
+	var deps []checker.Dependency
+	for _, image := range []string{"nginx:1.25", "redis:7", "nginx:1.25", "postgres:16", "redis:7"} {
+		deps = append(deps, imageDependency("k8s/deployment.yaml", 1, image, checker.DependencyUseTypeKubernetesImage))
+	}
+	digester := &countingDigester{calls: make(map[string]int)}
+	applyImagePinningRemediations(deps, newCachingDigester(digester, 2))
+
+	if want := map[string]int{"nginx:1.25": 1, "redis:7": 1}; !cmp.Equal(want, digester.calls) {
+		t.Errorf("digest lookups mismatch (-want +got):\n%s", cmp.Diff(want, digester.calls))
+	}
+	for _, dep := range deps {
+		// postgres:16 is past the lookup limit.
+		if got, want := dep.Remediation != nil, dep.Location.Snippet != "postgres:16"; got != want {
+			t.Errorf("%s: remediation %v, want %v", dep.Location.Snippet, got, want)
+		}
+	}
+}
+
+func TestTerraformModuleDependency(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		source   string
+		version  string
+		name     string
+		pinnedAt string
+		pinned   bool
+	}{
+		{source: "hashicorp/consul/aws", version: "0.1.0", name: "hashicorp/consul/aws", pinnedAt: "0.1.0", pinned: true},
+		{source: "hashicorp/consul/aws", version: "= 0.1.0", name: "hashicorp/consul/aws", pinnedAt: "= 0.1.0", pinned: true},
+		{source: "hashicorp/consul/aws", version: ">= 0.1.0", name: "hashicorp/consul/aws", pinnedAt: ">= 0.1.0"},
+		{source: "hashicorp/consul/aws", name: "hashicorp/consul/aws"},
+		{source: "github.com/hashicorp/example", name: "github.com/hashicorp/example"},
+		{
+			source: "git::ssh://git@example.com/network.git//modules/vpc?ref=8e6d2ac0cf1d6e5b2c21ac2b3f5e6ac1b2c96a1b",
+			name:   "git::ssh://git@example.com/network.git//modules/vpc", pinnedAt: "8e6d2ac0cf1d6e5b2c21ac2b3f5e6ac1b2c96a1b",
+			pinned: true,
+		},
+		{source: "s3::https://s3-eu-west-1.amazonaws.com/bucket/vpc.zip", name: "s3::https://s3-eu-west-1.amazonaws.com/bucket/vpc.zip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source+" "+tt.version, func(t *testing.T) {
+			t.Parallel()
+			dep := terraformModuleDependency("main.tf", 1, tt.source, tt.version)
+			var pinnedAt string
+			if dep.PinnedAt != nil {
+				pinnedAt = *dep.PinnedAt
+			}
+			if *dep.Name != tt.name || pinnedAt != tt.pinnedAt || *dep.Pinned != tt.pinned {
+				t.Errorf("got (%q, %q, %v), want (%q, %q, %v)",
+					*dep.Name, pinnedAt, *dep.Pinned, tt.name, tt.pinnedAt, tt.pinned)
+			}
+		})
+	}
+}
+
+func TestParseHCLBlocks(t *testing.T) {
+	t.Parallel()
+	content := `
+provider "aws" { region = "us-east-1" }
+terraform {
+  backend "s3" {
+    bucket = "state" // "comment { with braces"
+  }
+  required_providers {
+    google = "~> 4.0"
+    foo = {
+      source = "example.com/acme/foo"
+    }
+  }
+}
+`
+	type block struct {
+		kind, parent string
+		labels       []string
+		attributes   map[string]string
+		line         int
+	}
+	want := []block{
+		{kind: "provider", labels: []string{"aws"}, attributes: map[string]string{"region": "us-east-1"}, line: 2},
+		{kind: "terraform", attributes: map[string]string{}, line: 3},
+		{kind: "backend", parent: "terraform", labels: []string{"s3"}, attributes: map[string]string{"bucket": "state"}, line: 4},
+		{kind: "required_providers", parent: "terraform", attributes: map[string]string{"google": "~> 4.0"}, line: 7},
+		{kind: "foo", parent: "required_providers", attributes: map[string]string{"source": "example.com/acme/foo"}, line: 9},
+	}
+	var got []block
+	for _, b := range parseHCLBlocks([]byte(content)) {
+		got = append(got, block{kind: b.kind, parent: b.parent, labels: b.labels, attributes: b.attributes, line: b.line})
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(block{}), cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("blocks mismatch (-want +got):\n%s", diff)
+	}
+}
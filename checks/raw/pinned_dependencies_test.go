@@ -1853,6 +1853,60 @@ func TestGitHubWorkInsecureDownloadsLineNumber(t *testing.T) {
 	}
 }
 
+func TestAzurePipelinesPinning(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("./testdata/azure-pipelines/pinning.yml")
+	if err != nil {
+		t.Fatalf("cannot read file: %v", err)
+	}
+
+	var r checker.PinningDependenciesData
+	if _, err := validateAzurePipelinesPinning("azure-pipelines/pinning.yml", content, &r); err != nil {
+		t.Fatalf("validateAzurePipelinesPinning: %v", err)
+	}
+
+	type dep struct {
+		snippet string
+		depType checker.DependencyUseType
+		line    uint
+		pinned  bool
+	}
+	want := []dep{
+		{snippet: "UseDotNet@2", depType: checker.DependencyUseTypeAzurePipelinesTask, line: 26},
+		{snippet: "DotNetCoreCLI@2.231.0", depType: checker.DependencyUseTypeAzurePipelinesTask, line: 27, pinned: true},
+		{snippet: "NuGetToolInstaller@1", depType: checker.DependencyUseTypeAzurePipelinesTask, line: 35},
+		{snippet: "AzureCLI@2", depType: checker.DependencyUseTypeAzurePipelinesTask, line: 44},
+		{snippet: "ubuntu:22.04", depType: checker.DependencyUseTypeDockerfileContainerImage, line: 15},
+		{
+			snippet: "mcr.microsoft.com/dotnet/sdk@sha256:8e6d2ac0cf1d6e5b2c21ac2b3f5e6ac1b2c96a1b8c7b53ecfd9a5ce1c4d1d2a3",
+			depType: checker.DependencyUseTypeDockerfileContainerImage, line: 17, pinned: true,
+		},
+		{snippet: "myregistry.example.com:5000/tools:1.0", depType: checker.DependencyUseTypeDockerfileContainerImage, line: 33},
+		{snippet: "steps/lint.yml@pinned", depType: checker.DependencyUseTypeAzurePipelinesTemplate, line: 29, pinned: true},
+		{snippet: "steps/publish.yml@unpinned", depType: checker.DependencyUseTypeAzurePipelinesTemplate, line: 31},
+		{snippet: "stages/release.yml@unpinned", depType: checker.DependencyUseTypeAzurePipelinesTemplate, line: 45},
+	}
+	got := make([]dep, 0, len(r.Dependencies))
+	for _, d := range r.Dependencies {
+		got = append(got, dep{snippet: d.Location.Snippet, depType: d.Type, line: d.Location.Offset, pinned: *d.Pinned})
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(dep{}), cmpopts.SortSlices(func(a, b dep) bool {
+		return a.line < b.line
+	})); diff != "" {
+		t.Errorf("dependencies mismatch (-want +got):\n%s", diff)
+	}
+
+	var tools *checker.Dependency
+	for i := range r.Dependencies {
+		if r.Dependencies[i].Location.Snippet == "myregistry.example.com:5000/tools:1.0" {
+			tools = &r.Dependencies[i]
+		}
+	}
+	if tools == nil || *tools.Name != "myregistry.example.com:5000/tools" || *tools.PinnedAt != "1.0" {
+		t.Errorf("unexpected image name split: %+v", tools)
+	}
+}
+
 func countUnpinned(r []checker.Dependency) int {
 	var unpinned int
 
@@ -2645,3 +2699,46 @@ func TestAnalyseCentralPackageManagementPinned(t *testing.T) {
 func newString(s string) *string {
 	return &s
 }
+
+func TestCollectCompositeActionPinning(t *testing.T) {
+	t.Parallel()
+
+	req := &checker.CheckRequest{
+		RepoClient: workflowCallsRepoClient(t),
+	}
+	var r checker.PinningDependenciesData
+	if err := collectCompositeActionPinning(req, &r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	type dependency struct {
+		path   string
+		typ    checker.DependencyUseType
+		line   uint
+		pinned bool
+	}
+	want := []dependency{
+		{path: ".github/actions/setup/action.yml", typ: checker.DependencyUseTypeGHAction, line: 5, pinned: false},
+		{path: ".github/actions/setup/action.yml", typ: checker.DependencyUseTypeDownloadThenRun, line: 9, pinned: false},
+		{path: ".github/actions/tool/action.yml", typ: checker.DependencyUseTypeGHAction, line: 4, pinned: true},
+	}
+	got := make([]dependency, 0, len(r.Dependencies))
+	for i := range r.Dependencies {
+		d := &r.Dependencies[i]
+		if d.Location == nil || d.Pinned == nil {
+			t.Fatalf("unexpected dependency: %+v", d)
+		}
+		if d.Caller == nil {
+			t.Errorf("missing caller for %s:%d", d.Location.Path, d.Location.Offset)
+		}
+		got = append(got, dependency{
+			path:   d.Location.Path,
+			typ:    d.Type,
+			line:   d.Location.Offset,
+			pinned: *d.Pinned,
+		})
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(dependency{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
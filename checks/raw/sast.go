@@ -22,9 +22,11 @@ import (
 	"io"
 	"path"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/rhysd/actionlint"
+	"gopkg.in/yaml.v3"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks/fileparser"
@@ -37,14 +39,6 @@ const CheckSAST = "SAST"
 
 var errInvalid = errors.New("invalid")
 
-var sastTools = map[string]bool{
-	"github-advanced-security": true,
-	"github-code-scanning":     true,
-	"lgtm-com":                 true,
-	"sonarcloud":               true,
-	"sonarqubecloud":           true,
-}
-
 var allowedConclusions = map[string]bool{"success": true, "neutral": true}
 
 // SAST checks for presence of static analysis tools.
@@ -57,37 +51,431 @@ func SAST(c *checker.CheckRequest) (checker.SASTData, error) {
 	}
 	data.Commits = commits
 
-	codeQLWorkflows, err := getSastUsesWorkflows(c, "^github/codeql-action/analyze$", checker.CodeQLWorkflow)
+	languages, err := sastLanguages(c)
 	if err != nil {
 		return data, err
 	}
-	data.Workflows = append(data.Workflows, codeQLWorkflows...)
+	data.Languages = languages
+
+	scan := newSastScan()
+	if err := getGitHubWorkflowsSastTools(c, scan); err != nil {
+		return data, err
+	}
 
 	sonarWorkflows, err := getSonarWorkflows(c)
 	if err != nil {
 		return data, err
 	}
-	data.Workflows = append(data.Workflows, sonarWorkflows...)
+	scan.workflows = append(scan.workflows, sonarWorkflows...)
 
-	snykWorkflows, err := getSastUsesWorkflows(c, "^snyk/actions/.*", checker.SnykWorkflow)
-	if err != nil {
+	if err := getAzurePipelinesSastWorkflows(c, scan); err != nil {
+		return data, err
+	}
+	if err := getSastConfigWorkflows(c, scan); err != nil {
 		return data, err
 	}
-	data.Workflows = append(data.Workflows, snykWorkflows...)
+	data.Workflows = scan.result()
+
+	return data, nil
+}
 
-	pysaWorkflows, err := getSastUsesWorkflows(c, "^facebook/pysa-action$", checker.PysaWorkflow)
+// sastLanguages returns the prominent languages of the repository which at
+// least one of the SAST tools we detect analyzes.
+func sastLanguages(c *checker.CheckRequest) ([]clients.LanguageName, error) {
+	langs, err := c.RepoClient.ListProgrammingLanguages()
 	if err != nil {
-		return data, err
+		if errors.Is(err, clients.ErrUnsupportedFeature) {
+			return nil, nil
+		}
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("RepoClient.ListProgrammingLanguages: %v", err))
+	}
+	// Languages aren't known, e.g. for local directories.
+	if len(langs) == 1 && langs[0].Name == clients.All {
+		return nil, nil
+	}
+
+	var ret []clients.LanguageName
+	for _, lang := range getProminentLanguages(langs) {
+		if sastTools.analyzable(lang) {
+			ret = append(ret, lang)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret, nil
+}
+
+// sastScan collects the SAST tools detected in the files of the repository.
+type sastScan struct {
+	// runners are the runners of configured tools which run in CI.
+	runners map[string]bool
+	// workflows are the tools which run in CI.
+	workflows []checker.SASTWorkflow
+	// configs are the tools enabled by pre-commit hooks and configuration
+	// files, which only run if their runner runs in CI.
+	configs []sastConfigWorkflow
+}
+
+type sastConfigWorkflow struct {
+	runner   string
+	workflow checker.SASTWorkflow
+}
+
+func newSastScan() *sastScan {
+	return &sastScan{runners: make(map[string]bool)}
+}
+
+// result returns the tools which run in CI, then the configured tools whose
+// runner runs in CI.
+func (s *sastScan) result() []checker.SASTWorkflow {
+	workflows := s.workflows
+	for i := range s.configs {
+		if s.runners[s.configs[i].runner] {
+			workflows = append(workflows, s.configs[i].workflow)
+		}
+	}
+	return workflows
+}
+
+// sastFileTools collects the SAST tools detected in a file, once per tool.
+type sastFileTools struct {
+	seen map[checker.SASTWorkflowType]bool
+	scan *sastScan
+	path string
+}
+
+func newSastFileTools(path string, scan *sastScan) *sastFileTools {
+	return &sastFileTools{
+		path: path,
+		seen: make(map[checker.SASTWorkflowType]bool),
+		scan: scan,
+	}
+}
+
+func (s *sastFileTools) add(tools []*sastTool, offset uint) {
+	for _, tool := range tools {
+		if w, ok := s.workflow(tool, offset); ok {
+			s.scan.workflows = append(s.scan.workflows, w)
+		}
+	}
+}
+
+// addConfig adds the tools enabled by a pre-commit hook or configuration
+// file, if runner runs in CI.
+func (s *sastFileTools) addConfig(tools []*sastTool, runner string, offset uint) {
+	for _, tool := range tools {
+		if w, ok := s.workflow(tool, offset); ok {
+			s.scan.configs = append(s.scan.configs, sastConfigWorkflow{runner: runner, workflow: w})
+		}
+	}
+}
+
+// run records the runners run in CI.
+func (s *sastFileTools) run(runners []string) {
+	for _, runner := range runners {
+		s.scan.runners[runner] = true
+	}
+}
+
+func (s *sastFileTools) workflow(tool *sastTool, offset uint) (checker.SASTWorkflow, bool) {
+	if s.seen[tool.Name] {
+		return checker.SASTWorkflow{}, false
+	}
+	s.seen[tool.Name] = true
+	return checker.SASTWorkflow{
+		Type: tool.Name,
+		File: checker.File{
+			Path:   s.path,
+			Offset: offset,
+			Type:   finding.FileTypeSource,
+		},
+		Languages: tool.Languages,
+	}, true
+}
+
+// getGitHubWorkflowsSastTools looks for the GitHub workflows running a SAST
+// tool, as an action, a container or a command of a `run` step.
+func getGitHubWorkflowsSastTools(c *checker.CheckRequest, scan *sastScan) error {
+	return fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       ".github/workflows/*",
+		CaseSensitive: false,
+	}, searchGitHubWorkflowSastTools, scan)
+}
+
+var searchGitHubWorkflowSastTools fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsWorkflowFile(path) {
+		return true, nil
+	}
+
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"searchGitHubWorkflowSastTools requires exactly 1 arguments: %w", errInvalid)
+	}
+	scan, ok := args[0].(*sastScan)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitHubWorkflowSastTools expects arg[0] of type *sastScan: %w", errInvalid)
+	}
+
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		return false, fileparser.FormatActionlintError(errs)
+	}
+
+	found := newSastFileTools(path, scan)
+	for _, job := range jobsInFileOrder(workflow) {
+		if job.Container != nil && job.Container.Image != nil {
+			found.add(sastTools.byImage(job.Container.Image.Value), checker.OffsetDefault)
+		}
+		for _, step := range job.Steps {
+			switch e := step.Exec.(type) {
+			case *actionlint.ExecAction:
+				if e == nil || e.Uses == nil {
+					continue
+				}
+				uses := strings.TrimPrefix(e.Uses.Value, "actions://")
+				if image, ok := strings.CutPrefix(uses, "docker://"); ok {
+					found.add(sastTools.byImage(image), checker.OffsetDefault)
+					continue
+				}
+				// Parse out repo / SHA.
+				action, _, _ := strings.Cut(uses, "@")
+				found.add(sastTools.byAction(action), checker.OffsetDefault)
+				found.run(sastTools.runnersByAction(action))
+			case *actionlint.ExecRun:
+				if e == nil || e.Run == nil {
+					continue
+				}
+				found.add(sastTools.byCommand(e.Run.Value), checker.OffsetDefault)
+				found.run(sastTools.runnersByCommand(e.Run.Value))
+			}
+		}
+	}
+	return true, nil
+}
+
+// jobsInFileOrder returns the jobs of the workflow in the order they are
+// defined, so the tools found in a file are reported deterministically.
+func jobsInFileOrder(workflow *actionlint.Workflow) []*actionlint.Job {
+	jobs := make([]*actionlint.Job, 0, len(workflow.Jobs))
+	for _, job := range workflow.Jobs {
+		if job != nil {
+			jobs = append(jobs, job)
+		}
 	}
-	data.Workflows = append(data.Workflows, pysaWorkflows...)
+	sort.Slice(jobs, func(i, j int) bool {
+		a, b := jobs[i].Pos, jobs[j].Pos
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Col < b.Col
+	})
+	return jobs
+}
 
-	qodanaWorkflows, err := getSastUsesWorkflows(c, "^JetBrains/qodana-action$", checker.QodanaWorkflow)
+// getAzurePipelinesSastWorkflows looks for the Azure Pipelines files running a
+// SAST tool, as a task, a container or a command of a script step.
+func getAzurePipelinesSastWorkflows(c *checker.CheckRequest, scan *sastScan) error {
+	return fileparser.OnAzurePipelinesFileContentDo(c.RepoClient, searchAzurePipelinesSastTasks, scan)
+}
+
+var searchAzurePipelinesSastTasks fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsAzurePipelinesFile(path) {
+		return true, nil
+	}
+
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"searchAzurePipelinesSastTasks requires exactly 1 arguments: %w", errInvalid)
+	}
+	scan, ok := args[0].(*sastScan)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchAzurePipelinesSastTasks expects arg[0] of type *sastScan: %w", errInvalid)
+	}
+
+	pipeline, err := fileparser.ParseAzurePipeline(content)
 	if err != nil {
-		return data, err
+		// Not a pipeline.
+		return true, nil //nolint:nilerr
+	}
+	found := newSastFileTools(path, scan)
+	for _, container := range pipeline.Containers {
+		found.add(sastTools.byImage(container.Image), container.Line)
+	}
+	for _, job := range pipeline.Jobs {
+		for _, step := range job.Steps {
+			if step.Task != "" {
+				name, _ := fileparser.SplitAzureTask(step.Task)
+				found.add(sastTools.byAzureTask(name), step.Line)
+			}
+			if step.Script != "" {
+				found.add(sastTools.byCommand(step.Script), step.ScriptLine)
+				found.run(sastTools.runnersByCommand(step.Script))
+			}
+		}
 	}
-	data.Workflows = append(data.Workflows, qodanaWorkflows...)
+	return true, nil
+}
 
-	return data, nil
+// getSastConfigWorkflows looks for the GitLab CI files, pre-commit
+// configurations and tool configuration files running or enabling a SAST tool.
+func getSastConfigWorkflows(c *checker.CheckRequest, scan *sastScan) error {
+	return fileparser.OnPredicateFileContentDo(c.RepoClient, isSastConfigFile, searchSastConfig, scan)
+}
+
+func isGitLabCIFile(pathfn string) bool {
+	isFlattened, _ := fileparser.IsGitlabWorkflowFile(pathfn)
+	return isFlattened || strings.EqualFold(pathfn, ".gitlab-ci.yml")
+}
+
+func isPreCommitConfigFile(pathfn string) bool {
+	base := path.Base(pathfn)
+	return base == ".pre-commit-config.yaml" || base == ".pre-commit-config.yml"
+}
+
+func isSastConfigFile(pathfn string) bool {
+	return isGitLabCIFile(pathfn) || isPreCommitConfigFile(pathfn) || sastTools.isConfigFile(pathfn)
+}
+
+var searchSastConfig fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !isSastConfigFile(path) {
+		return true, nil
+	}
+
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"searchSastConfig requires exactly 1 arguments: %w", errInvalid)
+	}
+	scan, ok := args[0].(*sastScan)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchSastConfig expects arg[0] of type *sastScan: %w", errInvalid)
+	}
+
+	found := newSastFileTools(path, scan)
+	switch {
+	case isGitLabCIFile(path):
+		searchGitLabCISastTools(content, found)
+	case isPreCommitConfigFile(path):
+		searchPreCommitSastTools(content, found)
+	}
+	for _, config := range sastTools.byFile(path, content) {
+		found.addConfig([]*sastTool{config.tool}, config.runner, checker.OffsetDefault)
+	}
+	return true, nil
+}
+
+// Top-level keywords of GitLab CI files which aren't jobs.
+var gitlabCIKeywords = map[string]bool{
+	"default": true, "include": true, "stages": true, "variables": true, "workflow": true,
+}
+
+// searchGitLabCISastTools looks for the SAST templates included by a GitLab
+// CI file, and the jobs, images and scripts of its jobs.
+func searchGitLabCISastTools(content []byte, found *sastFileTools) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 {
+		return
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		switch {
+		case key.Value == "include":
+			for _, include := range yamlItems(value) {
+				template := include
+				if include.Kind == yaml.MappingNode {
+					template = yamlMappingValue(include, "template")
+				}
+				if template != nil && template.Kind == yaml.ScalarNode {
+					found.add(sastTools.byGitLabTemplate(template.Value), uint(template.Line))
+				}
+			}
+		case key.Value == "default" || (!gitlabCIKeywords[key.Value] && !strings.HasPrefix(key.Value, ".")):
+			if value.Kind != yaml.MappingNode {
+				continue
+			}
+			if key.Value != "default" {
+				found.add(sastTools.byGitLabJob(key.Value), uint(key.Line))
+			}
+			if image := yamlMappingValue(value, "image"); image != nil {
+				if image.Kind == yaml.MappingNode {
+					image = yamlMappingValue(image, "name")
+				}
+				if image != nil && image.Kind == yaml.ScalarNode {
+					found.add(sastTools.byImage(image.Value), uint(image.Line))
+				}
+			}
+			for _, section := range []string{"before_script", "script", "after_script"} {
+				for _, line := range yamlItems(yamlMappingValue(value, section)) {
+					if line.Kind == yaml.ScalarNode {
+						found.add(sastTools.byCommand(line.Value), uint(line.Line))
+						found.run(sastTools.runnersByCommand(line.Value))
+					}
+				}
+			}
+		}
+	}
+}
+
+// searchPreCommitSastTools looks for the hooks of a pre-commit configuration
+// running a SAST tool, which only run if pre-commit runs in CI.
+func searchPreCommitSastTools(content []byte, found *sastFileTools) {
+	var config struct {
+		Repos []struct {
+			Hooks []yaml.Node `yaml:"hooks"`
+		} `yaml:"repos"`
+	}
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return
+	}
+	for _, repo := range config.Repos {
+		for i := range repo.Hooks {
+			id := yamlMappingValue(&repo.Hooks[i], "id")
+			if id != nil && id.Kind == yaml.ScalarNode {
+				found.addConfig(sastTools.byPreCommitHook(id.Value), preCommitRunner, uint(id.Line))
+			}
+		}
+	}
+}
+
+func yamlMappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// yamlItems returns the items of a sequence, or the node itself otherwise.
+func yamlItems(n *yaml.Node) []*yaml.Node {
+	switch {
+	case n == nil:
+		return nil
+	case n.Kind == yaml.SequenceNode:
+		return n.Content
+	default:
+		return []*yaml.Node{n}
+	}
 }
 
 func sastToolInCheckRuns(c *checker.CheckRequest) ([]checker.SASTCommit, error) {
@@ -125,7 +513,7 @@ func sastToolInCheckRuns(c *checker.CheckRequest) ([]checker.SASTCommit, error)
 			if !allowedConclusions[cr.Conclusion] {
 				continue
 			}
-			if sastTools[cr.App.Slug] {
+			if sastTools.byCheckRun(cr.App.Slug) != nil {
 				c.Dlogger.Debug(&checker.LogMessage{
 					Path: cr.URL,
 					Type: finding.FileTypeURL,
@@ -148,87 +536,6 @@ func sastToolInCheckRuns(c *checker.CheckRequest) ([]checker.SASTCommit, error)
 	return sastCommits, nil
 }
 
-// getSastUsesWorkflows matches if the "uses" field of a GitHub action matches
-// a given regex by way of usesRegex. Each workflow that matches the usesRegex
-// is appended to the slice that is returned.
-func getSastUsesWorkflows(
-	c *checker.CheckRequest,
-	usesRegex string,
-	checkerType checker.SASTWorkflowType,
-) ([]checker.SASTWorkflow, error) {
-	var workflowPaths []string
-	var sastWorkflows []checker.SASTWorkflow
-	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
-		Pattern:       ".github/workflows/*",
-		CaseSensitive: false,
-	}, searchGitHubActionWorkflowUseRegex, &workflowPaths, usesRegex)
-	if err != nil {
-		return sastWorkflows, err
-	}
-	for _, path := range workflowPaths {
-		sastWorkflow := checker.SASTWorkflow{
-			File: checker.File{
-				Path:   path,
-				Offset: checker.OffsetDefault,
-				Type:   finding.FileTypeSource,
-			},
-			Type: checkerType,
-		}
-
-		sastWorkflows = append(sastWorkflows, sastWorkflow)
-	}
-	return sastWorkflows, nil
-}
-
-var searchGitHubActionWorkflowUseRegex fileparser.DoWhileTrueOnFileContent = func(path string,
-	content []byte,
-	args ...interface{},
-) (bool, error) {
-	if !fileparser.IsWorkflowFile(path) {
-		return true, nil
-	}
-
-	if len(args) != 2 {
-		return false, fmt.Errorf(
-			"searchGitHubActionWorkflowUseRegex requires exactly 2 arguments: %w", errInvalid)
-	}
-
-	// Verify the type of the data.
-	paths, ok := args[0].(*[]string)
-	if !ok {
-		return false, fmt.Errorf(
-			"searchGitHubActionWorkflowUseRegex expects arg[0] of type *[]string: %w", errInvalid)
-	}
-
-	usesRegex, ok := args[1].(string)
-	if !ok {
-		return false, fmt.Errorf(
-			"searchGitHubActionWorkflowUseRegex expects arg[1] of type string: %w", errInvalid)
-	}
-
-	workflow, errs := actionlint.Parse(content)
-	if len(errs) > 0 && workflow == nil {
-		return false, fileparser.FormatActionlintError(errs)
-	}
-
-	for _, job := range workflow.Jobs {
-		for _, step := range job.Steps {
-			e, ok := step.Exec.(*actionlint.ExecAction)
-			if !ok || e == nil || e.Uses == nil {
-				continue
-			}
-			// Parse out repo / SHA.
-			uses := strings.TrimPrefix(e.Uses.Value, "actions://")
-			action, _, _ := strings.Cut(uses, "@")
-			re := regexp.MustCompile(usesRegex)
-			if re.MatchString(action) {
-				*paths = append(*paths, path)
-			}
-		}
-	}
-	return true, nil
-}
-
 type sonarConfig struct {
 	url  string
 	file checker.File
@@ -254,7 +561,8 @@ func getSonarWorkflows(c *checker.CheckRequest) ([]checker.SASTWorkflow, error)
 				Type:      result.file.Type,
 				Snippet:   result.url,
 			},
-			Type: checker.SonarWorkflow,
+			Type:      checker.SonarWorkflow,
+			Languages: sastTools.byName(checker.SonarWorkflow).Languages,
 		}
 
 		sastWorkflows = append(sastWorkflows, sastWorkflow)
@@ -32,10 +32,11 @@ func TestSAST(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name     string
-		files    []string
-		commits  []clients.Commit
-		expected checker.SASTData
+		name      string
+		files     []string
+		commits   []clients.Commit
+		languages []clients.Language
+		expected  checker.SASTData
 	}{
 		{
 			name: "has codeql 1",
@@ -53,7 +54,8 @@ func TestSAST(t *testing.T) {
 			expected: checker.SASTData{
 				Workflows: []checker.SASTWorkflow{
 					{
-						Type: checker.CodeQLWorkflow,
+						Type:      checker.CodeQLWorkflow,
+						Languages: sastTools.byName(checker.CodeQLWorkflow).Languages,
 						File: checker.File{
 							Path:   ".github/workflows/workflow-not-pinned.yaml",
 							Offset: checker.OffsetDefault,
@@ -61,7 +63,8 @@ func TestSAST(t *testing.T) {
 						},
 					},
 					{
-						Type: checker.SonarWorkflow,
+						Type:      checker.SonarWorkflow,
+						Languages: sastTools.byName(checker.SonarWorkflow).Languages,
 						File: checker.File{
 							Path:      ".github/workflows/pom.xml",
 							Type:      finding.FileTypeSource,
@@ -86,7 +89,8 @@ func TestSAST(t *testing.T) {
 			expected: checker.SASTData{
 				Workflows: []checker.SASTWorkflow{
 					{
-						Type: checker.CodeQLWorkflow,
+						Type:      checker.CodeQLWorkflow,
+						Languages: sastTools.byName(checker.CodeQLWorkflow).Languages,
 						File: checker.File{
 							Path:   ".github/workflows/github-workflow-multiple-unpinned-uses.yaml",
 							Offset: checker.OffsetDefault,
@@ -116,7 +120,8 @@ func TestSAST(t *testing.T) {
 			expected: checker.SASTData{
 				Workflows: []checker.SASTWorkflow{
 					{
-						Type: checker.CodeQLWorkflow,
+						Type:      checker.CodeQLWorkflow,
+						Languages: sastTools.byName(checker.CodeQLWorkflow).Languages,
 						File: checker.File{
 							Path:   ".github/workflows/airflows-codeql.yaml",
 							Offset: checker.OffsetDefault,
@@ -139,7 +144,8 @@ func TestSAST(t *testing.T) {
 			expected: checker.SASTData{
 				Workflows: []checker.SASTWorkflow{
 					{
-						Type: checker.SnykWorkflow,
+						Type:      checker.SnykWorkflow,
+						Languages: sastTools.byName(checker.SnykWorkflow).Languages,
 						File: checker.File{
 							Path:   ".github/workflows/github-workflow-snyk.yaml",
 							Offset: checker.OffsetDefault,
@@ -149,6 +155,32 @@ func TestSAST(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "Azure Pipelines CodeQL and Microsoft Security DevOps",
+			files: []string{"azure-pipelines/sast.yml"},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type:      checker.CodeQLWorkflow,
+						Languages: sastTools.byName(checker.CodeQLWorkflow).Languages,
+						File: checker.File{
+							Path:   "azure-pipelines/sast.yml",
+							Offset: 7,
+							Type:   finding.FileTypeSource,
+						},
+					},
+					{
+						Type:      checker.MicrosoftSecurityDevOpsWorkflow,
+						Languages: sastTools.byName(checker.MicrosoftSecurityDevOpsWorkflow).Languages,
+						File: checker.File{
+							Path:   "azure-pipelines/sast.yml",
+							Offset: 8,
+							Type:   finding.FileTypeSource,
+						},
+					},
+				},
+			},
+		},
 		{
 			name:  "Has Pysa",
 			files: []string{".github/workflows/github-pysa-workflow.yaml"},
@@ -162,7 +194,8 @@ func TestSAST(t *testing.T) {
 			expected: checker.SASTData{
 				Workflows: []checker.SASTWorkflow{
 					{
-						Type: checker.PysaWorkflow,
+						Type:      checker.PysaWorkflow,
+						Languages: sastTools.byName(checker.PysaWorkflow).Languages,
 						File: checker.File{
 							Path:   ".github/workflows/github-pysa-workflow.yaml",
 							Offset: checker.OffsetDefault,
@@ -185,7 +218,8 @@ func TestSAST(t *testing.T) {
 			expected: checker.SASTData{
 				Workflows: []checker.SASTWorkflow{
 					{
-						Type: checker.QodanaWorkflow,
+						Type:      checker.QodanaWorkflow,
+						Languages: sastTools.byName(checker.QodanaWorkflow).Languages,
 						File: checker.File{
 							Path:   ".github/workflows/github-qodana-workflow.yaml",
 							Offset: checker.OffsetDefault,
@@ -195,6 +229,100 @@ func TestSAST(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "Has Semgrep and gosec",
+			files: []string{".github/workflows/github-workflow-semgrep-gosec.yaml"},
+			languages: []clients.Language{
+				{Name: clients.Go, NumLines: 1000},
+				{Name: clients.Python, NumLines: 800},
+				{Name: clients.Dockerfile, NumLines: 10},
+				{Name: clients.Other, NumLines: 500},
+			},
+			expected: checker.SASTData{
+				Languages: []clients.LanguageName{clients.Go, clients.Python},
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type:      "Semgrep",
+						Languages: sastTools.byName("Semgrep").Languages,
+						File: checker.File{
+							Path:   ".github/workflows/github-workflow-semgrep-gosec.yaml",
+							Offset: checker.OffsetDefault,
+							Type:   finding.FileTypeSource,
+						},
+					},
+					{
+						Type:      "gosec",
+						Languages: []clients.LanguageName{clients.Go},
+						File: checker.File{
+							Path:   ".github/workflows/github-workflow-semgrep-gosec.yaml",
+							Offset: checker.OffsetDefault,
+							Type:   finding.FileTypeSource,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "GitLab SAST template and Bandit",
+			files: []string{".gitlab-ci.yml"},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type:      "GitLabSAST",
+						Languages: sastTools.byName("GitLabSAST").Languages,
+						File: checker.File{
+							Path:   ".gitlab-ci.yml",
+							Offset: 2,
+							Type:   finding.FileTypeSource,
+						},
+					},
+					{
+						Type:      "Bandit",
+						Languages: []clients.LanguageName{clients.Python},
+						File: checker.File{
+							Path:   ".gitlab-ci.yml",
+							Offset: 12,
+							Type:   finding.FileTypeSource,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:     "pre-commit Bandit and ESLint security plugin not run in CI",
+			files:    []string{".pre-commit-config.yaml", "web/package.json"},
+			expected: checker.SASTData{},
+		},
+		{
+			name: "pre-commit Bandit and ESLint security plugin run in CI",
+			files: []string{
+				".github/workflows/github-workflow-lint.yaml",
+				".pre-commit-config.yaml",
+				"web/package.json",
+			},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type:      "Bandit",
+						Languages: []clients.LanguageName{clients.Python},
+						File: checker.File{
+							Path:   ".pre-commit-config.yaml",
+							Offset: 9,
+							Type:   finding.FileTypeSource,
+						},
+					},
+					{
+						Type:      "ESLint",
+						Languages: []clients.LanguageName{clients.JavaScript, clients.TypeScript},
+						File: checker.File{
+							Path:   "web/package.json",
+							Offset: checker.OffsetDefault,
+							Type:   finding.FileTypeSource,
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -209,6 +337,7 @@ func TestSAST(t *testing.T) {
 			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
 				return os.Open("./testdata/" + file)
 			}).AnyTimes()
+			mockRepoClient.EXPECT().ListProgrammingLanguages().Return(tt.languages, nil).AnyTimes()
 			req := checker.CheckRequest{
 				RepoClient: mockRepoClient,
 			}
@@ -0,0 +1,333 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	_ "embed"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+//go:embed sast_tools.yaml
+var sastToolsYAML []byte
+
+// sastTools is the registry of the SAST tools the SAST check detects.
+var sastTools = mustLoadSASTTools(sastToolsYAML)
+
+type sastTool struct {
+	Name            checker.SASTWorkflowType `yaml:"name"`
+	Languages       []clients.LanguageName   `yaml:"languages"`
+	CheckRuns       []string                 `yaml:"checkRuns"`
+	Actions         []string                 `yaml:"actions"`
+	Images          []string                 `yaml:"images"`
+	Commands        []string                 `yaml:"commands"`
+	PreCommitHooks  []string                 `yaml:"preCommitHooks"`
+	AzureTasks      []string                 `yaml:"azureTasks"`
+	GitLabTemplates []string                 `yaml:"gitlabTemplates"`
+	GitLabJobs      []string                 `yaml:"gitlabJobs"`
+	Files           []sastToolFile           `yaml:"files"`
+
+	actions         []*regexp.Regexp
+	commands        []*regexp.Regexp
+	gitlabTemplates []*regexp.Regexp
+	gitlabJobs      []*regexp.Regexp
+}
+
+type sastToolFile struct {
+	Runner  string   `yaml:"runner"`
+	Names   []string `yaml:"names"`
+	Content string   `yaml:"content"`
+
+	content *regexp.Regexp
+}
+
+// preCommitRunner is the runner of the hooks of pre-commit configurations.
+const preCommitRunner = "pre-commit"
+
+type sastToolRegistry struct {
+	Tools []*sastTool `yaml:"tools"`
+	// Runners run the tools enabled by pre-commit hooks and configuration
+	// files, which don't run the tools on their own.
+	Runners []*sastRunner `yaml:"runners"`
+}
+
+type sastRunner struct {
+	Name     string   `yaml:"name"`
+	Actions  []string `yaml:"actions"`
+	Commands []string `yaml:"commands"`
+
+	actions  []*regexp.Regexp
+	commands []*regexp.Regexp
+}
+
+// sastToolConfig is a tool enabled by a configuration file, which only runs
+// if its runner runs in CI.
+type sastToolConfig struct {
+	tool   *sastTool
+	runner string
+}
+
+func mustLoadSASTTools(content []byte) *sastToolRegistry {
+	r, err := loadSASTTools(content)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func loadSASTTools(content []byte) (*sastToolRegistry, error) {
+	var r sastToolRegistry
+	if err := yaml.Unmarshal(content, &r); err != nil {
+		return nil, fmt.Errorf("unmarshal SAST tools: %w", err)
+	}
+	var err error
+	for _, runner := range r.Runners {
+		if runner.Name == "" {
+			return nil, fmt.Errorf("%w: SAST tool runner without name", errInvalid)
+		}
+		if runner.actions, err = compileAll(runner.Actions); err != nil {
+			return nil, fmt.Errorf("%s actions: %w", runner.Name, err)
+		}
+		if runner.commands, err = compileAll(runner.Commands); err != nil {
+			return nil, fmt.Errorf("%s commands: %w", runner.Name, err)
+		}
+	}
+	for _, tool := range r.Tools {
+		if err := tool.compile(); err != nil {
+			return nil, err
+		}
+		if len(tool.PreCommitHooks) > 0 && r.runner(preCommitRunner) == nil {
+			return nil, fmt.Errorf("%w: %s pre-commit hooks without %s runner", errInvalid, tool.Name, preCommitRunner)
+		}
+		for i := range tool.Files {
+			if r.runner(tool.Files[i].Runner) == nil {
+				return nil, fmt.Errorf("%w: %s files with unknown runner %q", errInvalid, tool.Name, tool.Files[i].Runner)
+			}
+		}
+	}
+	return &r, nil
+}
+
+func (t *sastTool) compile() error {
+	if t.Name == "" {
+		return fmt.Errorf("%w: SAST tool without name", errInvalid)
+	}
+	var err error
+	if t.actions, err = compileAll(t.Actions); err != nil {
+		return fmt.Errorf("%s actions: %w", t.Name, err)
+	}
+	if t.commands, err = compileAll(t.Commands); err != nil {
+		return fmt.Errorf("%s commands: %w", t.Name, err)
+	}
+	if t.gitlabTemplates, err = compileAll(t.GitLabTemplates); err != nil {
+		return fmt.Errorf("%s gitlabTemplates: %w", t.Name, err)
+	}
+	if t.gitlabJobs, err = compileAll(t.GitLabJobs); err != nil {
+		return fmt.Errorf("%s gitlabJobs: %w", t.Name, err)
+	}
+	for i := range t.Files {
+		if t.Files[i].content, err = regexp.Compile(t.Files[i].Content); err != nil {
+			return fmt.Errorf("%s files: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+func compileAll(patterns []string) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("compile %q: %w", p, err)
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
+func matchAny(res []*regexp.Regexp, s string) bool {
+	for _, re := range res {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// find returns the tools matching a predicate, in registry order.
+func (r *sastToolRegistry) find(match func(*sastTool) bool) []*sastTool {
+	var tools []*sastTool
+	for _, tool := range r.Tools {
+		if match(tool) {
+			tools = append(tools, tool)
+		}
+	}
+	return tools
+}
+
+// byName returns the tool with the given name, or nil.
+func (r *sastToolRegistry) byName(name checker.SASTWorkflowType) *sastTool {
+	for _, tool := range r.Tools {
+		if tool.Name == name {
+			return tool
+		}
+	}
+	return nil
+}
+
+// byCheckRun returns the tool reporting check runs as the given app, or nil.
+func (r *sastToolRegistry) byCheckRun(slug string) *sastTool {
+	for _, tool := range r.Tools {
+		if containsFold(tool.CheckRuns, slug) {
+			return tool
+		}
+	}
+	return nil
+}
+
+func (r *sastToolRegistry) byAction(action string) []*sastTool {
+	return r.find(func(t *sastTool) bool { return matchAny(t.actions, action) })
+}
+
+func (r *sastToolRegistry) byImage(image string) []*sastTool {
+	name := normalizeImageName(image)
+	return r.find(func(t *sastTool) bool { return containsFold(t.Images, name) })
+}
+
+func (r *sastToolRegistry) byCommand(script string) []*sastTool {
+	return r.find(func(t *sastTool) bool { return matchAny(t.commands, script) })
+}
+
+func (r *sastToolRegistry) byPreCommitHook(id string) []*sastTool {
+	return r.find(func(t *sastTool) bool { return containsFold(t.PreCommitHooks, id) })
+}
+
+func (r *sastToolRegistry) byAzureTask(name string) []*sastTool {
+	return r.find(func(t *sastTool) bool { return containsFold(t.AzureTasks, name) })
+}
+
+func (r *sastToolRegistry) byGitLabTemplate(template string) []*sastTool {
+	return r.find(func(t *sastTool) bool { return matchAny(t.gitlabTemplates, template) })
+}
+
+func (r *sastToolRegistry) byGitLabJob(job string) []*sastTool {
+	return r.find(func(t *sastTool) bool { return matchAny(t.gitlabJobs, job) })
+}
+
+// byFile returns the tools enabled by a configuration file, with their runner.
+func (r *sastToolRegistry) byFile(pathfn string, content []byte) []sastToolConfig {
+	base := path.Base(pathfn)
+	var configs []sastToolConfig
+	for _, tool := range r.Tools {
+		for i := range tool.Files {
+			f := &tool.Files[i]
+			if matchFileName(f.Names, base) && f.content.Match(content) {
+				configs = append(configs, sastToolConfig{tool: tool, runner: f.Runner})
+				break
+			}
+		}
+	}
+	return configs
+}
+
+// runner returns the runner with the given name, or nil.
+func (r *sastToolRegistry) runner(name string) *sastRunner {
+	for _, runner := range r.Runners {
+		if runner.Name == name {
+			return runner
+		}
+	}
+	return nil
+}
+
+// runnersByAction returns the names of the runners run by a GitHub Action.
+func (r *sastToolRegistry) runnersByAction(action string) []string {
+	return r.findRunners(func(runner *sastRunner) bool { return matchAny(runner.actions, action) })
+}
+
+// runnersByCommand returns the names of the runners run by a CI script.
+func (r *sastToolRegistry) runnersByCommand(script string) []string {
+	return r.findRunners(func(runner *sastRunner) bool { return matchAny(runner.commands, script) })
+}
+
+func (r *sastToolRegistry) findRunners(match func(*sastRunner) bool) []string {
+	var names []string
+	for _, runner := range r.Runners {
+		if match(runner) {
+			names = append(names, runner.Name)
+		}
+	}
+	return names
+}
+
+// isConfigFile returns true if a file may enable one of the tools.
+func (r *sastToolRegistry) isConfigFile(pathfn string) bool {
+	base := path.Base(pathfn)
+	for _, tool := range r.Tools {
+		for i := range tool.Files {
+			if matchFileName(tool.Files[i].Names, base) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// analyzable returns true if one of the tools analyzes the language.
+func (r *sastToolRegistry) analyzable(lang clients.LanguageName) bool {
+	for _, tool := range r.Tools {
+		for _, l := range tool.Languages {
+			if l == lang {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchFileName(globs []string, base string) bool {
+	for _, glob := range globs {
+		if ok, err := path.Match(glob, base); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeImageName strips the default registry, tag and digest of a container image.
+func normalizeImageName(image string) string {
+	name, _ := splitImageReference(strings.TrimSpace(image))
+	name = strings.ToLower(name)
+	for _, prefix := range []string{"docker.io/", "index.docker.io/", "registry.hub.docker.com/"} {
+		name = strings.TrimPrefix(name, prefix)
+	}
+	return strings.TrimPrefix(name, "library/")
+}
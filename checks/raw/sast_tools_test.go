@@ -0,0 +1,109 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestLoadSASTTools_invalid(t *testing.T) {
+	t.Parallel()
+	for _, content := range []string{
+		"tools:\n  - languages: [go]\n",
+		"tools:\n  - name: Broken\n    commands: ['(']\n",
+		"tools: [\n",
+		"tools:\n  - name: Orphan\n    files:\n      - name: .orphan.yml\n        runner: orphan\n",
+	} {
+		if _, err := loadSASTTools([]byte(content)); err == nil {
+			t.Errorf("loadSASTTools(%q) succeeded, want error", content)
+		}
+	}
+}
+
+func TestSASTToolsLookup(t *testing.T) {
+	t.Parallel()
+	names := func(tools []*sastTool) []checker.SASTWorkflowType {
+		var ret []checker.SASTWorkflowType
+		for _, tool := range tools {
+			ret = append(ret, tool.Name)
+		}
+		return ret
+	}
+	tests := []struct {
+		name string
+		got  []*sastTool
+		want checker.SASTWorkflowType
+	}{
+		{name: "image with registry and tag", got: sastTools.byImage("docker.io/semgrep/semgrep:latest"), want: "Semgrep"},
+		{name: "image with digest", got: sastTools.byImage("aquasec/trivy@sha256:0123"), want: "Trivy"},
+		{name: "command", got: sastTools.byCommand("pip install bandit\nbandit -r src"), want: "Bandit"},
+		{name: "azure task", got: sastTools.byAzureTask("advancedsecurity-codeql-analyze"), want: checker.CodeQLWorkflow},
+		{name: "gitlab job", got: sastTools.byGitLabJob("semgrep-sast"), want: "GitLabSAST"},
+		{name: "bundled command", got: sastTools.byCommand("bundle exec brakeman -q"), want: "Brakeman"},
+		{name: "command run by xargs", got: sastTools.byCommand("git ls-files '*.cpp' | xargs clang-tidy -p build"), want: "clang-tidy"},
+	}
+	for _, tt := range tests {
+		if got := names(tt.got); len(got) != 1 || got[0] != tt.want {
+			t.Errorf("%s: got %v, want [%s]", tt.name, got, tt.want)
+		}
+	}
+	if tool := sastTools.byCheckRun("sonarcloud"); tool == nil || tool.Name != checker.SonarWorkflow {
+		t.Errorf("byCheckRun(sonarcloud) = %v, want Sonar", tool)
+	}
+	for _, command := range []string{
+		"go vet ./...",
+		"gem install brakeman",
+		"sudo apt-get install -y clang-tidy",
+		"pip install checkov bandit",
+		"npm install --save-dev eslint-plugin-security",
+	} {
+		if got := sastTools.byCommand(command); len(got) != 0 {
+			t.Errorf("byCommand(%q) = %v, want none", command, names(got))
+		}
+	}
+}
+
+func TestSASTToolsByFile(t *testing.T) {
+	t.Parallel()
+	configs := sastTools.byFile("src/.golangci.yml", []byte("linters:\n  enable:\n    - gosec\n"))
+	if len(configs) != 1 || configs[0].tool.Name != "gosec" || configs[0].runner != "golangci-lint" {
+		t.Errorf("byFile(.golangci.yml) = %v, want gosec run by golangci-lint", configs)
+	}
+}
+
+func TestSASTRunnersLookup(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{name: "pre-commit action", got: sastTools.runnersByAction("pre-commit/action"), want: []string{"pre-commit"}},
+		{name: "pre-commit command", got: sastTools.runnersByCommand("pre-commit run --all-files"), want: []string{"pre-commit"}},
+		{name: "npx eslint", got: sastTools.runnersByCommand("npx eslint ."), want: []string{"eslint"}},
+		{name: "golangci-lint action", got: sastTools.runnersByAction("golangci/golangci-lint-action"), want: []string{"golangci-lint"}},
+		{name: "maven spotbugs", got: sastTools.runnersByCommand("mvn -B verify spotbugs:check"), want: []string{"spotbugs"}},
+		{name: "pre-commit install", got: sastTools.runnersByCommand("pip install pre-commit"), want: nil},
+		{name: "eslint install", got: sastTools.runnersByCommand("npm install eslint eslint-plugin-security"), want: nil},
+		{name: "unknown action", got: sastTools.runnersByAction("actions/checkout"), want: nil},
+	}
+	for _, tt := range tests {
+		if len(tt.got) != len(tt.want) || (len(tt.want) == 1 && tt.got[0] != tt.want[0]) {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
@@ -15,6 +15,7 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 
@@ -45,7 +46,7 @@ func SBOM(c *checker.CheckRequest) (checker.SBOMData, error) {
 
 	// Look for SBOMs in source
 	repoFiles, err := c.RepoClient.ListFiles(func(file string) (bool, error) {
-		return reSBOMFile.MatchString(file) && reRootFile.MatchString(file), nil
+		return IsSBOMFile(file), nil
 	})
 	if err != nil {
 		return results, fmt.Errorf("error during ListFiles: %w", err)
@@ -53,9 +54,48 @@ func SBOM(c *checker.CheckRequest) (checker.SBOMData, error) {
 
 	results.SBOMFiles = append(results.SBOMFiles, checkSBOMSource(repoFiles)...)
 
+	var parsed []*checker.SBOMDocument
+	for i := range results.SBOMFiles {
+		sbom := &results.SBOMFiles[i]
+		if sbom.File.Type != finding.FileTypeSource {
+			continue
+		}
+		content, err := readFileContent(c.RepoClient, sbom.File.Path)
+		if err != nil {
+			return results, err
+		}
+		doc, err := ParseSBOM(sbom.Name, content)
+		if errors.Is(err, errUnsupportedSBOMFormat) {
+			continue
+		}
+		if err != nil {
+			sbom.ParseError = err.Error()
+			continue
+		}
+		doc.Quality = sbomQuality(doc)
+		sbom.Document = doc
+		parsed = append(parsed, doc)
+	}
+	if len(parsed) == 0 {
+		return results, nil
+	}
+
+	results.DeclaredDependencies, err = declaredDependencies(c.RepoClient)
+	if err != nil {
+		return results, err
+	}
+	for _, doc := range parsed {
+		doc.MissingDependencies = missingDependencies(doc, results.DeclaredDependencies)
+	}
+
 	return results, nil
 }
 
+// IsSBOMFile reports whether the file is an SBOM at the root of the repository.
+func IsSBOMFile(file string) bool {
+	return reSBOMFile.MatchString(file) && reRootFile.MatchString(file)
+}
+
 func checkSBOMReleases(releases []clients.Release) []checker.SBOM {
 	var foundSBOMs []checker.SBOM
 
@@ -91,7 +131,6 @@ func checkSBOMSource(fileList []string) []checker.SBOM {
 	var foundSBOMs []checker.SBOM
 
 	for _, file := range fileList {
-		// TODO: parse matching file contents to determine schema & version
 		foundSBOMs = append(foundSBOMs,
 			checker.SBOM{
 				File: checker.File{
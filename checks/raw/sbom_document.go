@@ -0,0 +1,371 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/osv-scanner/pkg/lockfile"
+	spdxjson "github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/rdf"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/tagvalue"
+	spdxyaml "github.com/spdx/tools-golang/yaml"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const (
+	sbomFormatSPDX      = "SPDX"
+	sbomFormatCycloneDX = "CycloneDX"
+
+	spdxNoAssertion = "NOASSERTION"
+
+	// Number of NTIA minimum elements: supplier, component name, version,
+	// unique identifier, dependency relationship, author and timestamp.
+	ntiaElements = 7
+)
+
+var (
+	errUnsupportedSBOMFormat = errors.New("unsupported SBOM format")
+
+	rePythonNameSeparators = regexp.MustCompile(`[-_.]+`)
+)
+
+// ParseSBOM parses an SBOM document, based on the extension of its file.
+func ParseSBOM(name string, content []byte) (*checker.SBOMDocument, error) {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, ".cdx.json"):
+		return parseCycloneDX(content, cdx.BOMFileFormatJSON)
+	case strings.HasSuffix(lower, ".cdx.xml"):
+		return parseCycloneDX(content, cdx.BOMFileFormatXML)
+	case strings.HasSuffix(lower, ".spdx.json"):
+		return parseSPDX(content, spdxjson.Read)
+	case strings.HasSuffix(lower, ".spdx.yaml"), strings.HasSuffix(lower, ".spdx.yml"):
+		return parseSPDX(content, spdxyaml.Read)
+	case strings.HasSuffix(lower, ".spdx.rdf"), strings.HasSuffix(lower, ".spdx.rdf.xml"):
+		return parseSPDX(content, rdf.Read)
+	case strings.HasSuffix(lower, ".spdx"):
+		return parseSPDX(content, tagvalue.Read)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedSBOMFormat, name)
+	}
+}
+
+func parseSPDX(content []byte, read func(io.Reader) (*spdx.Document, error)) (*checker.SBOMDocument, error) {
+	doc, err := read(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("parsing SPDX document: %w", err)
+	}
+
+	ret := checker.SBOMDocument{
+		Format:      sbomFormatSPDX,
+		SpecVersion: strings.TrimPrefix(doc.SPDXVersion, "SPDX-"),
+	}
+	if doc.CreationInfo != nil {
+		for _, c := range doc.CreationInfo.Creators {
+			ret.Authors = append(ret.Authors, c.Creator)
+		}
+		ret.Timestamp = doc.CreationInfo.Created
+	}
+	for _, p := range doc.Packages {
+		if p == nil {
+			continue
+		}
+		component := checker.SBOMComponent{
+			Name:    p.PackageName,
+			Version: spdxValue(p.PackageVersion),
+		}
+		switch {
+		case p.PackageSupplier != nil && spdxValue(p.PackageSupplier.Supplier) != "":
+			component.Supplier = p.PackageSupplier.Supplier
+		case p.PackageOriginator != nil && spdxValue(p.PackageOriginator.Originator) != "":
+			component.Supplier = p.PackageOriginator.Originator
+		}
+		for _, ref := range p.PackageExternalReferences {
+			if ref == nil {
+				continue
+			}
+			switch ref.RefType {
+			case "purl", "cpe22Type", "cpe23Type", "swid":
+				component.Identifiers = append(component.Identifiers, ref.Locator)
+			}
+		}
+		ret.Components = append(ret.Components, component)
+	}
+	for _, r := range doc.Relationships {
+		if r == nil {
+			continue
+		}
+		// DESCRIBES relates the document to its packages, not packages to one another.
+		switch strings.ToUpper(r.Relationship) {
+		case "DESCRIBES", "DESCRIBED_BY":
+			continue
+		}
+		ret.Relationships++
+	}
+	return &ret, nil
+}
+
+func spdxValue(v string) string {
+	if v == spdxNoAssertion {
+		return ""
+	}
+	return v
+}
+
+func parseCycloneDX(content []byte, format cdx.BOMFileFormat) (*checker.SBOMDocument, error) {
+	var bom cdx.BOM
+	if err := cdx.NewBOMDecoder(bytes.NewReader(content), format).Decode(&bom); err != nil {
+		return nil, fmt.Errorf("parsing CycloneDX document: %w", err)
+	}
+
+	ret := checker.SBOMDocument{
+		Format:      sbomFormatCycloneDX,
+		SpecVersion: bom.SpecVersion.String(),
+	}
+	if m := bom.Metadata; m != nil {
+		ret.Timestamp = m.Timestamp
+		if m.Authors != nil {
+			for _, a := range *m.Authors {
+				ret.Authors = appendNonEmpty(ret.Authors, a.Name)
+			}
+		}
+		for _, e := range []*cdx.OrganizationalEntity{m.Manufacturer, m.Manufacture, m.Supplier} {
+			if e != nil {
+				ret.Authors = appendNonEmpty(ret.Authors, e.Name)
+			}
+		}
+		if m.Tools != nil {
+			if m.Tools.Tools != nil {
+				for _, t := range *m.Tools.Tools {
+					ret.Authors = appendNonEmpty(ret.Authors, t.Name)
+				}
+			}
+			if m.Tools.Components != nil {
+				for i := range *m.Tools.Components {
+					ret.Authors = appendNonEmpty(ret.Authors, (*m.Tools.Components)[i].Name)
+				}
+			}
+		}
+	}
+	if bom.Components != nil {
+		ret.Components = cycloneDXComponents(*bom.Components)
+	}
+	if bom.Dependencies != nil {
+		for _, d := range *bom.Dependencies {
+			if d.Dependencies != nil {
+				ret.Relationships += len(*d.Dependencies)
+			}
+		}
+	}
+	return &ret, nil
+}
+
+// cycloneDXComponents flattens the components of a BOM and their sub-components.
+func cycloneDXComponents(components []cdx.Component) []checker.SBOMComponent {
+	var ret []checker.SBOMComponent
+	for i := range components {
+		c := &components[i]
+		component := checker.SBOMComponent{
+			Name:    c.Name,
+			Version: c.Version,
+		}
+		switch {
+		case c.Supplier != nil && c.Supplier.Name != "":
+			component.Supplier = c.Supplier.Name
+		case c.Publisher != "":
+			component.Supplier = c.Publisher
+		}
+		component.Identifiers = appendNonEmpty(component.Identifiers, c.PackageURL)
+		component.Identifiers = appendNonEmpty(component.Identifiers, c.CPE)
+		if c.SWID != nil {
+			component.Identifiers = appendNonEmpty(component.Identifiers, c.SWID.TagID)
+		}
+		ret = append(ret, component)
+		if c.Components != nil {
+			ret = append(ret, cycloneDXComponents(*c.Components)...)
+		}
+	}
+	return ret
+}
+
+func appendNonEmpty(values []string, v string) []string {
+	if v == "" {
+		return values
+	}
+	return append(values, v)
+}
+
+// sbomQuality returns the share of NTIA minimum elements an SBOM provides, between 0 and 10.
+// Component elements count in proportion to the components providing them.
+func sbomQuality(doc *checker.SBOMDocument) int {
+	var elements float64
+	if n := len(doc.Components); n > 0 {
+		var named, versioned, supplied, identified int
+		for i := range doc.Components {
+			c := &doc.Components[i]
+			if c.Name != "" {
+				named++
+			}
+			if c.Version != "" {
+				versioned++
+			}
+			if c.Supplier != "" {
+				supplied++
+			}
+			if len(c.Identifiers) > 0 {
+				identified++
+			}
+		}
+		elements += float64(named+versioned+supplied+identified) / float64(n)
+	}
+	for _, ok := range []bool{len(doc.Authors) > 0, doc.Timestamp != "", doc.Relationships > 0} {
+		if ok {
+			elements++
+		}
+	}
+	return int(math.Round(checker.MaxResultScore * elements / ntiaElements))
+}
+
+// declaredDependencies returns the names of the dependencies declared by the
+// lockfiles and manifests of the repository.
+func declaredDependencies(c clients.RepoClient) ([]string, error) {
+	packages, err := LockfilePackages(c)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var names []string
+	for _, p := range packages {
+		if seen[p.Name] {
+			continue
+		}
+		seen[p.Name] = true
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// LockfilePackages returns the packages declared by the lockfiles and manifests
+// of the repository, skipping vendored and test dependencies.
+func LockfilePackages(c clients.RepoClient) ([]lockfile.PackageDetails, error) {
+	files, err := c.ListFiles(func(name string) (bool, error) {
+		extractor, _ := lockfile.FindExtractor(name, "")
+		return extractor != nil && !isVendoredPath(name), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+
+	var packages []lockfile.PackageDetails
+	for _, name := range files {
+		extractor, _ := lockfile.FindExtractor(name, "")
+		if extractor == nil || isVendoredPath(name) {
+			continue
+		}
+		content, err := readFileContent(c, name)
+		if err != nil {
+			return nil, err
+		}
+		extracted, err := extractor.Extract(depFile{Reader: bytes.NewReader(content), path: name})
+		if err != nil {
+			// Malformed lockfiles don't declare anything we can check.
+			continue
+		}
+		for _, p := range extracted {
+			// go.mod files declare the version of the Go toolchain as a package.
+			if p.Name == "" || p.Name == "stdlib" {
+				continue
+			}
+			packages = append(packages, p)
+		}
+	}
+	return packages, nil
+}
+
+// depFile is a lockfile read through a RepoClient.
+type depFile struct {
+	io.Reader
+	path string
+}
+
+func (f depFile) Open(string) (lockfile.NestedDepFile, error) {
+	return nil, lockfile.ErrOpenNotSupported
+}
+
+func (f depFile) Path() string {
+	return f.path
+}
+
+// missingDependencies returns the declared dependencies an SBOM doesn't list.
+func missingDependencies(doc *checker.SBOMDocument, declared []string) []string {
+	listed := make(map[string]bool)
+	for i := range doc.Components {
+		c := &doc.Components[i]
+		listed[normalizeDependencyName(c.Name)] = true
+		for _, id := range c.Identifiers {
+			if name, ok := purlName(id); ok {
+				listed[normalizeDependencyName(name)] = true
+			}
+		}
+	}
+	var missing []string
+	for _, name := range declared {
+		if !listed[normalizeDependencyName(name)] {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// purlName returns the namespace and name of a package URL, e.g. `github.com/foo/bar`
+// for `pkg:golang/github.com/foo/bar@v1.0.0`.
+func purlName(purl string) (string, bool) {
+	rest, ok := strings.CutPrefix(purl, "pkg:")
+	if !ok {
+		return "", false
+	}
+	rest, _, _ = strings.Cut(rest, "#")
+	rest, _, _ = strings.Cut(rest, "?")
+	rest, _, _ = strings.Cut(rest, "@")
+	_, name, ok := strings.Cut(rest, "/")
+	if !ok {
+		return "", false
+	}
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		name = unescaped
+	}
+	return name, true
+}
+
+// normalizeDependencyName normalizes the names of dependencies across lockfiles and
+// SBOMs: Maven `group:artifact` names and Python names differing by separators.
+func normalizeDependencyName(name string) string {
+	name = strings.ToLower(strings.ReplaceAll(name, ":", "/"))
+	return rePythonNameSeparators.ReplaceAllString(name, "-")
+}
@@ -16,10 +16,15 @@ package raw
 
 import (
 	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
@@ -106,6 +111,9 @@ func TestSbom(t *testing.T) {
 			mockRepo.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
 				return tt.files, nil
 			}).AnyTimes()
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(string) (io.ReadCloser, error) {
+				return os.Open("testdata/sbom/complete.spdx.json")
+			}).AnyTimes()
 
 			dl := scut.TestDetailLogger{}
 			req := checker.CheckRequest{
@@ -120,9 +128,190 @@ func TestSbom(t *testing.T) {
 				}
 			}
 
-			if !cmp.Equal(res, tt.expected) {
+			// The parsed documents are tested by TestSBOMDocuments.
+			opts := cmpopts.IgnoreFields(checker.SBOM{}, "Document")
+			if !cmp.Equal(res.SBOMFiles, tt.expected.SBOMFiles, opts) {
 				t.Errorf("Expected %v, got %v for %v", tt.expected, res, tt.name)
 			}
 		})
 	}
 }
+
+func TestSBOMDocuments(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		file    string
+		want    *checker.SBOMDocument
+		wantErr bool
+	}{
+		{
+			name: "SPDX JSON with all elements",
+			file: "complete.spdx.json",
+			want: &checker.SBOMDocument{
+				Format:      "SPDX",
+				SpecVersion: "2.3",
+				Authors:     []string{"Example", "syft-1.0.0"},
+				Timestamp:   "2026-01-02T03:04:05Z",
+				Components: []checker.SBOMComponent{
+					{
+						Name:        "github.com/example/app",
+						Version:     "v1.2.3",
+						Supplier:    "Example",
+						Identifiers: []string{"pkg:golang/github.com/example/app@v1.2.3"},
+					},
+					{
+						Name:        "github.com/google/go-cmp",
+						Version:     "v0.7.0",
+						Supplier:    "Google",
+						Identifiers: []string{"pkg:golang/github.com/google/go-cmp@v0.7.0"},
+					},
+				},
+				Relationships: 1,
+				Quality:       10,
+			},
+		},
+		{
+			name: "SPDX tag-value without assertions",
+			file: "minimal.spdx",
+			want: &checker.SBOMDocument{
+				Format:      "SPDX",
+				SpecVersion: "2.3",
+				Authors:     []string{"handmade"},
+				Timestamp:   "2026-01-02T03:04:05Z",
+				Components:  []checker.SBOMComponent{{Name: "app"}},
+				Quality:     4,
+			},
+		},
+		{
+			name: "CycloneDX JSON with nested components",
+			file: "app.cdx.json",
+			want: &checker.SBOMDocument{
+				Format:      "CycloneDX",
+				SpecVersion: "1.5",
+				Authors:     []string{"cdxgen"},
+				Timestamp:   "2026-01-02T03:04:05Z",
+				Components: []checker.SBOMComponent{
+					{Name: "node", Version: "20.0.0", Identifiers: []string{"pkg:npm/%40types/node@20.0.0"}},
+					{Name: "undici-types", Version: "5.26.5", Supplier: "Node.js"},
+				},
+				Quality: 7,
+			},
+		},
+		{
+			name: "CycloneDX XML",
+			file: "app.cdx.xml",
+			want: &checker.SBOMDocument{
+				Format:      "CycloneDX",
+				SpecVersion: "1.4",
+				Authors:     []string{"Jane Doe"},
+				Timestamp:   "2026-01-02T03:04:05Z",
+				Components: []checker.SBOMComponent{
+					{Name: "requests", Version: "2.31.0", Supplier: "PSF", Identifiers: []string{"pkg:pypi/requests@2.31.0"}},
+				},
+				Relationships: 1,
+				Quality:       10,
+			},
+		},
+		{
+			name:    "SPDX XML is not supported",
+			file:    "app.spdx.xml",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile(filepath.Join("testdata", "sbom", tt.file))
+			if err != nil && !tt.wantErr {
+				t.Fatalf("reading test file: %v", err)
+			}
+			got, err := ParseSBOM(tt.file, content)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSBOM() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			got.Quality = sbomQuality(got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseSBOM() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSBOMDeclaredDependencies(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		"complete.spdx.json": "",
+		"go.mod": `module github.com/example/app
+
+go 1.23
+
+require (
+	github.com/google/go-cmp v0.7.0
+	golang.org/x/text v0.25.0
+)
+`,
+		"web/package-lock.json": `{
+  "lockfileVersion": 3,
+  "packages": {
+    "node_modules/@types/node": {"version": "20.0.0"}
+  }
+}
+`,
+		"vendor/github.com/foo/bar/go.mod": "module github.com/foo/bar\n\nrequire github.com/baz/qux v1.0.0\n",
+	}
+	ctrl := gomock.NewController(t)
+	mockRepo := mockrepo.NewMockRepoClient(ctrl)
+	mockRepo.EXPECT().ListReleases().Return(nil, nil)
+	mockRepo.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+		var ret []string
+		for name := range files {
+			if ok, err := predicate(name); err == nil && ok {
+				ret = append(ret, name)
+			}
+		}
+		return ret, nil
+	}).Times(2)
+	mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(name string) (io.ReadCloser, error) {
+		if strings.HasSuffix(name, ".spdx.json") {
+			return os.Open(filepath.Join("testdata", "sbom", name))
+		}
+		return io.NopCloser(strings.NewReader(files[name])), nil
+	}).AnyTimes()
+
+	req := checker.CheckRequest{RepoClient: mockRepo, Ctx: context.Background()}
+	res, err := SBOM(&req)
+	if err != nil {
+		t.Fatalf("SBOM: %v", err)
+	}
+	wantDeclared := []string{"@types/node", "github.com/google/go-cmp", "golang.org/x/text"}
+	if diff := cmp.Diff(wantDeclared, res.DeclaredDependencies); diff != "" {
+		t.Errorf("declared dependencies mismatch (-want +got):\n%s", diff)
+	}
+	if len(res.SBOMFiles) != 1 || res.SBOMFiles[0].Document == nil {
+		t.Fatalf("expected one parsed SBOM, got %v", res.SBOMFiles)
+	}
+	wantMissing := []string{"@types/node", "golang.org/x/text"}
+	if diff := cmp.Diff(wantMissing, res.SBOMFiles[0].Document.MissingDependencies); diff != "" {
+		t.Errorf("missing dependencies mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPurlName(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"pkg:golang/github.com/google/go-cmp@v0.7.0": "github.com/google/go-cmp",
+		"pkg:npm/%40types/node@20.0.0?arch=x64":      "@types/node",
+		"pkg:maven/org.apache/commons-lang3@3.0#sub": "org.apache/commons-lang3",
+		"cpe:2.3:a:example:app:1.0:*:*:*:*:*:*:*":    "",
+	}
+	for purl, want := range tests {
+		got, ok := purlName(purl)
+		if got != want || ok != (want != "") {
+			t.Errorf("purlName(%q) = %q, %v, want %q", purl, got, ok, want)
+		}
+	}
+}
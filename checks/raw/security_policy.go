@@ -16,6 +16,7 @@ package raw
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
 	"path"
@@ -34,15 +35,60 @@ type securityPolicyFilesWithURI struct {
 	files []checker.SecurityPolicyFile
 }
 
+var (
+	// pattern for a supported versions heading or a version table header.
+	reSupportedVersions = regexp.MustCompile(`(?i)^[#=\s]*supported versions\s*[:#=]*\s*$|^\s*\|\s*versions?\s*\|`)
+	// pattern for verbs that introduce a response timeline.
+	reResponseVerb = regexp.MustCompile(`(?i)respon|reply|acknowledg|triag|\bfix|patch|release`)
+	// pattern for a time frame, e.g. "48 hours" or "two business days".
+	reTimeFrame = regexp.MustCompile(
+		`(?i)\b([0-9]{1,3}|one|two|three|four|five|six|seven|eight|nine|ten|a)\s+` +
+			`((business|working|calendar)\s+)?(hours?|days?|weeks?|months?)\b`)
+)
+
 // SecurityPolicy checks for presence of security policy
 // and applicable content discovered by checkSecurityPolicyFileContent().
+// It also collects the security.txt file and whether private vulnerability
+// reporting is enabled on the forge.
 func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error) {
+	files, err := securityPolicyFiles(c)
+	if err != nil {
+		return checker.SecurityPolicyData{}, err
+	}
+
+	var txt *checker.SecurityTxt
+	err = fileparser.OnPredicateFileContentDo(c.RepoClient, isSecurityTxtFilename, parseSecurityTxt, &txt)
+	if err != nil {
+		return checker.SecurityPolicyData{}, err
+	}
+
+	var privateReporting *bool
+	enabled, err := c.RepoClient.IsPrivateVulnerabilityReportingEnabled()
+	switch {
+	case err == nil:
+		privateReporting = &enabled
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+		break
+	default:
+		// The setting may be hidden from the token, e.g. with a 403 or 404.
+		// It only informs the findings, so don't fail the check.
+		c.Dlogger.Debug(&checker.LogMessage{Text: fmt.Sprintf("IsPrivateVulnerabilityReportingEnabled: %v", err)})
+	}
+
+	return checker.SecurityPolicyData{
+		PolicyFiles:                   files,
+		SecurityTxt:                   txt,
+		PrivateVulnerabilityReporting: privateReporting,
+	}, nil
+}
+
+func securityPolicyFiles(c *checker.CheckRequest) ([]checker.SecurityPolicyFile, error) {
 	data := securityPolicyFilesWithURI{
 		uri: "", files: make([]checker.SecurityPolicyFile, 0),
 	}
 	err := fileparser.OnAllFilesDo(c.RepoClient, isSecurityPolicyFile, &data)
 	if err != nil {
-		return checker.SecurityPolicyData{}, err
+		return nil, err
 	}
 	// If we found files in the repo, return immediately.
 	if len(data.files) > 0 {
@@ -52,10 +98,10 @@ func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error)
 				CaseSensitive: false,
 			}, checkSecurityPolicyFileContent, &data.files[idx].File, &data.files[idx].Information)
 			if err != nil {
-				return checker.SecurityPolicyData{}, err
+				return nil, err
 			}
 		}
-		return checker.SecurityPolicyData{PolicyFiles: data.files}, nil
+		return data.files, nil
 	}
 
 	// Check if present in parent org.
@@ -68,13 +114,13 @@ func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error)
 		data.uri = client.URI()
 		err = fileparser.OnAllFilesDo(client, isSecurityPolicyFile, &data)
 		if err != nil {
-			return checker.SecurityPolicyData{}, fmt.Errorf("unable to create github client: %w", err)
+			return nil, fmt.Errorf("unable to create github client: %w", err)
 		}
 
 	case errors.Is(err, sce.ErrRepoUnreachable), errors.Is(err, clients.ErrUnsupportedFeature):
 		break
 	default:
-		return checker.SecurityPolicyData{}, err
+		return nil, err
 	}
 
 	// Return raw results.
@@ -91,11 +137,11 @@ func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error)
 				CaseSensitive: false,
 			}, checkSecurityPolicyFileContent, &data.files[idx].File, &data.files[idx].Information)
 			if err != nil {
-				return checker.SecurityPolicyData{}, err
+				return nil, err
 			}
 		}
 	}
-	return checker.SecurityPolicyData{PolicyFiles: data.files}, nil
+	return data.files, nil
 }
 
 // Check repository for repository-specific policy.
@@ -152,6 +198,78 @@ func isSecurityPolicyFilename(name string) bool {
 		strings.EqualFold(name, "docs/security.rst")
 }
 
+// isSecurityTxtFilename reports whether name is a security.txt location
+// defined by RFC 9116, including the legacy top-level location.
+func isSecurityTxtFilename(name string) bool {
+	return strings.EqualFold(name, ".well-known/security.txt") ||
+		strings.EqualFold(name, "security.txt")
+}
+
+// parseSecurityTxt parses a security.txt file. A file at the
+// .well-known location takes precedence over the legacy one.
+var parseSecurityTxt fileparser.DoWhileTrueOnFileContent = func(path string, content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf("parseSecurityTxt requires exactly one argument: %w", errInvalidArgLength)
+	}
+	ptxt, ok := args[0].(**checker.SecurityTxt)
+	if !ok {
+		return false, fmt.Errorf("parseSecurityTxt requires argument of type **checker.SecurityTxt: %w", errInvalidArgType)
+	}
+	wellKnown := strings.EqualFold(path, ".well-known/security.txt")
+	if *ptxt != nil && !wellKnown {
+		return true, nil
+	}
+	*ptxt = securityTxtFrom(path, content)
+	return !wellKnown, nil
+}
+
+func securityTxtFrom(path string, content []byte) *checker.SecurityTxt {
+	txt := &checker.SecurityTxt{
+		File: checker.File{
+			Path:     path,
+			Type:     finding.FileTypeText,
+			Offset:   checker.OffsetDefault,
+			FileSize: uint(len(content)),
+		},
+	}
+	inSignature := false
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch line {
+		case "-----BEGIN PGP SIGNED MESSAGE-----":
+			txt.Signed = true
+			continue
+		case "-----BEGIN PGP SIGNATURE-----":
+			inSignature = true
+		case "-----END PGP SIGNATURE-----":
+			inSignature = false
+			continue
+		}
+		if inSignature || line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// Lines of a cleartext signed message may be dash-escaped.
+		line = strings.TrimPrefix(line, "- ")
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "contact":
+			txt.Contacts = append(txt.Contacts, value)
+		case "expires":
+			txt.Expires = value
+		case "policy":
+			txt.Policies = append(txt.Policies, value)
+		}
+	}
+	return txt
+}
+
 var checkSecurityPolicyFileContent fileparser.DoWhileTrueOnFileContent = func(path string, content []byte,
 	args ...interface{},
 ) (bool, error) {
@@ -244,6 +362,27 @@ func collectPolicyHits(policyContent []byte) []checker.SecurityPolicyInformation
 					},
 				})
 			}
+			if reSupportedVersions.Match(token) {
+				hits = append(hits, checker.SecurityPolicyInformation{
+					InformationType: checker.SecurityPolicyInformationTypeSupportedVersions,
+					InformationValue: checker.SecurityPolicyValueType{
+						Match:      strings.TrimSpace(string(token)),
+						LineNumber: uint(lineNum),
+					},
+				})
+			}
+			if reResponseVerb.Match(token) {
+				for _, indexes := range reTimeFrame.FindAllIndex(token, -1) {
+					hits = append(hits, checker.SecurityPolicyInformation{
+						InformationType: checker.SecurityPolicyInformationTypeResponseTimeline,
+						InformationValue: checker.SecurityPolicyValueType{
+							Match:      string(token[indexes[0]:indexes[1]]),
+							LineNumber: uint(lineNum),
+							Offset:     uint(indexes[0]),
+						},
+					})
+				}
+			}
 		}
 		if advance <= len(policyContent) {
 			policyContent = policyContent[advance:]
@@ -15,14 +15,18 @@
 package raw
 
 import (
+	"errors"
 	"io"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
 	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
@@ -137,6 +141,7 @@ func TestSecurityPolicy(t *testing.T) {
 			mockRepo := mockrepo.NewMockRepo(ctrl)
 
 			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil).AnyTimes()
+			mockRepoClient.EXPECT().IsPrivateVulnerabilityReportingEnabled().Return(false, clients.ErrUnsupportedFeature).AnyTimes()
 			// the revised Security Policy will immediate go for the
 			// file contents once found. This test will return that
 			// mock file, but this specific unit test is not testing
@@ -173,3 +178,158 @@ func TestSecurityPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestSecurityPolicy_privateReporting(t *testing.T) {
+	t.Parallel()
+	enabled := true
+	//nolint:govet
+	tests := []struct {
+		name    string
+		enabled bool
+		err     error
+		want    *bool
+	}{
+		{
+			name:    "enabled",
+			enabled: true,
+			want:    &enabled,
+		},
+		{
+			name: "unsupported",
+			err:  clients.ErrUnsupportedFeature,
+		},
+		{
+			name: "forbidden",
+			err:  errors.New("403 Forbidden"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(nil, nil).AnyTimes()
+			mockRepoClient.EXPECT().IsPrivateVulnerabilityReportingEnabled().Return(tt.enabled, tt.err)
+			mockRepoClient.EXPECT().GetOrgRepoClient(gomock.Any()).Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+			mockRepo := mockrepo.NewMockRepo(ctrl)
+
+			dl := scut.TestDetailLogger{}
+			c := checker.CheckRequest{
+				RepoClient: mockRepoClient,
+				Repo:       mockRepo,
+				Dlogger:    &dl,
+			}
+			res, err := SecurityPolicy(&c)
+			if err != nil {
+				t.Fatalf("SecurityPolicy() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, res.PrivateVulnerabilityReporting); diff != "" {
+				t.Errorf("PrivateVulnerabilityReporting mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_securityTxtFrom(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name    string
+		content string
+		want    checker.SecurityTxt
+	}{
+		{
+			name: "plain",
+			content: `# Our security contacts
+Contact: mailto:security@ossf.dev
+Contact: https://ossf.dev/report
+Expires: 2030-01-01T00:00:00.000Z
+Policy: https://ossf.dev/security-policy
+`,
+			want: checker.SecurityTxt{
+				Contacts: []string{"mailto:security@ossf.dev", "https://ossf.dev/report"},
+				Expires:  "2030-01-01T00:00:00.000Z",
+				Policies: []string{"https://ossf.dev/security-policy"},
+			},
+		},
+		{
+			name: "signed",
+			content: `-----BEGIN PGP SIGNED MESSAGE-----
+Hash: SHA256
+
+Contact: mailto:security@ossf.dev
+Expires: 2030-01-01T00:00:00.000Z
+-----BEGIN PGP SIGNATURE-----
+Contact: mailto:not-a-field@ossf.dev
+-----END PGP SIGNATURE-----
+`,
+			want: checker.SecurityTxt{
+				Contacts: []string{"mailto:security@ossf.dev"},
+				Expires:  "2030-01-01T00:00:00.000Z",
+				Signed:   true,
+			},
+		},
+		{
+			name:    "no fields",
+			content: "# nothing here\n",
+			want:    checker.SecurityTxt{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := securityTxtFrom(".well-known/security.txt", []byte(tt.content))
+			if diff := cmp.Diff(tt.want, *got, cmpopts.IgnoreFields(checker.SecurityTxt{}, "File")); diff != "" {
+				t.Errorf("securityTxtFrom() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_collectPolicyHits(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		content  string
+		infoType checker.SecurityPolicyInformationType
+		want     []string
+	}{
+		{
+			name:     "supported versions heading",
+			content:  "# Security\n\n## Supported Versions\n\nOnly the latest release.\n",
+			infoType: checker.SecurityPolicyInformationTypeSupportedVersions,
+			want:     []string{"## Supported Versions"},
+		},
+		{
+			name:     "supported versions table",
+			content:  "| Version | Supported |\n| ------- | --------- |\n| 5.x     | yes       |\n",
+			infoType: checker.SecurityPolicyInformationTypeSupportedVersions,
+			want:     []string{"| Version | Supported |"},
+		},
+		{
+			name:     "response timeline",
+			content:  "We will acknowledge your report within 48 hours and aim to fix it within two weeks.\n",
+			infoType: checker.SecurityPolicyInformationTypeResponseTimeline,
+			want:     []string{"48 hours", "two weeks"},
+		},
+		{
+			name:     "time frame without a response",
+			content:  "This project is 10 years old.\n",
+			infoType: checker.SecurityPolicyInformationTypeResponseTimeline,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, hit := range collectPolicyHits([]byte(tt.content)) {
+				if hit.InformationType == tt.infoType {
+					got = append(got, hit.InformationValue.Match)
+				}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("collectPolicyHits() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+    random = { source = "hashicorp/random" }
+  }
+}
+
+/* Modules
+   of the project. */
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws" # registry module
+  version = "~> 5.1"
+}
+
+module "sg" {
+  source  = "app.terraform.io/example/sg/aws"
+  version = "1.4.2"
+}
+
+module "network" {
+  source = "git::https://github.com/example/network.git?ref=v1.0.0"
+}
+
+module "dns" {
+  source = "github.com/example/dns?ref=8e6d2ac0cf1d6e5b2c21ac2b3f5e6ac1b2c96a1b"
+}
+
+module "local" {
+  source = "./modules/local"
+}
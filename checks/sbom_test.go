@@ -16,6 +16,8 @@ package checks
 
 import (
 	"context"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -62,7 +64,7 @@ func TestSbom(t *testing.T) {
 			expected: scut.TestReturn{
 				Score:        5,
 				NumberOfInfo: 1,
-				NumberOfWarn: 1,
+				NumberOfWarn: 2,
 			},
 		},
 		{
@@ -96,6 +98,10 @@ func TestSbom(t *testing.T) {
 				return tt.files, nil
 			}).AnyTimes()
 
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(name string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(`{"spdxVersion": "SPDX-2.3", "SPDXID": "SPDXRef-DOCUMENT"}`)), nil
+			}).AnyTimes()
+
 			dl := scut.TestDetailLogger{}
 			req := checker.CheckRequest{
 				RepoClient: mockRepo,
@@ -26,11 +26,14 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/filecontainer"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/projectanalysis"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/search"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
 
 	"github.com/ossf/scorecard/v5/clients"
@@ -54,11 +57,14 @@ type Client struct {
 	contributors  *contributorsHandler
 	languages     *languagesHandler
 	policy        *policyHandler
+	releases      *releasesHandler
 	search        *searchHandler
 	searchCommits *searchCommitsHandler
 	servicehooks  *servicehooksHandler
+	wikis         *wikisHandler
 	workItems     *workItemsHandler
 	zip           *zipHandler
+	token         string
 	commitDepth   int
 }
 
@@ -112,12 +118,16 @@ func (c *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitDepth
 
 	c.policy.init(c.ctx, c.repourl)
 
+	c.releases.init(c.ctx, c.repourl)
+
 	c.search.init(c.ctx, c.repourl)
 
 	c.searchCommits.init(c.ctx, c.repourl)
 
 	c.servicehooks.init(c.ctx, c.repourl)
 
+	c.wikis.init(c.ctx, c.repourl)
+
 	c.workItems.init(c.ctx, c.repourl)
 
 	c.zip.init(c.ctx, c.repourl)
@@ -174,9 +184,29 @@ func (c *Client) GetDefaultBranch() (*clients.BranchRef, error) {
 	return c.branches.getDefaultBranch()
 }
 
-// Org repository, AKA the <org>/.github repository, is a GitHub-specific feature.
-func (c *Client) GetOrgRepoClient(context.Context) (clients.RepoClient, error) {
-	return nil, clients.ErrUnsupportedFeature
+// The project wiki is the closest equivalent of the <org>/.github repository,
+// it's where project-wide documents such as a security policy are kept.
+func (c *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, error) {
+	wikiRepoID, err := c.wikis.getProjectWikiRepositoryID()
+	if err != nil {
+		return nil, err
+	}
+
+	wikiRepo, err := MakeAzureDevOpsRepo(fmt.Sprintf("%s/%s/%s/_git/%s",
+		c.repourl.host, c.repourl.organization, c.repourl.project, wikiRepoID))
+	if err != nil {
+		return nil, fmt.Errorf("error during MakeAzureDevOpsRepo: %w", err)
+	}
+
+	client, err := CreateAzureDevOpsClientWithToken(ctx, c.token, wikiRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create org repoclient: %w", err)
+	}
+	if err := client.InitRepo(wikiRepo, clients.HeadSHA, 0); err != nil {
+		return nil, fmt.Errorf("error during InitRepo: %w", err)
+	}
+
+	return client, nil
 }
 
 func (c *Client) ListCommits() ([]clients.Commit, error) {
@@ -194,7 +224,7 @@ func (c *Client) ListLicenses() ([]clients.License, error) {
 }
 
 func (c *Client) ListReleases() ([]clients.Release, error) {
-	return nil, clients.ErrUnsupportedFeature
+	return c.releases.listReleases()
 }
 
 func (c *Client) ListContributors() ([]clients.User, error) {
@@ -213,6 +243,18 @@ func (c *Client) ListStatuses(ref string) ([]clients.Status, error) {
 	return c.commits.listStatuses(ref)
 }
 
+func (c *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, fmt.Errorf("IsPrivateVulnerabilityReportingEnabled (AzureDevOps): %w", clients.ErrUnsupportedFeature)
+}
+
+func (c *Client) IsPrivate() (bool, error) {
+	return false, fmt.Errorf("IsPrivate (AzureDevOps): %w", clients.ErrUnsupportedFeature)
+}
+
+func (c *Client) ListPullRequests() ([]clients.PullRequest, error) {
+	return nil, fmt.Errorf("ListPullRequests (AzureDevOps): %w", clients.ErrUnsupportedFeature)
+}
+
 func (c *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return c.servicehooks.listWebhooks()
 }
@@ -260,6 +302,8 @@ func CreateAzureDevOpsClientWithToken(ctx context.Context, token string, repo cl
 		return nil, fmt.Errorf("could not create azure devops git client with error: %w", err)
 	}
 
+	fileContainerClient := filecontainer.NewClient(ctx, connection)
+
 	policyClient, err := policy.NewClient(ctx, connection)
 	if err != nil {
 		return nil, fmt.Errorf("could not create azure devops policy client with error: %w", err)
@@ -270,6 +314,11 @@ func CreateAzureDevOpsClientWithToken(ctx context.Context, token string, repo cl
 		return nil, fmt.Errorf("could not create azure devops project analysis client with error: %w", err)
 	}
 
+	releaseClient, err := release.NewClient(ctx, connection)
+	if err != nil {
+		return nil, fmt.Errorf("could not create azure devops release client with error: %w", err)
+	}
+
 	searchClient, err := search.NewClient(ctx, connection)
 	if err != nil {
 		return nil, fmt.Errorf("could not create azure devops search client with error: %w", err)
@@ -277,6 +326,11 @@ func CreateAzureDevOpsClientWithToken(ctx context.Context, token string, repo cl
 
 	servicehooksClient := servicehooks.NewClient(ctx, connection)
 
+	wikiClient, err := wiki.NewClient(ctx, connection)
+	if err != nil {
+		return nil, fmt.Errorf("could not create azure devops wiki client with error: %w", err)
+	}
+
 	workItemsClient, err := workitemtracking.NewClient(ctx, connection)
 	if err != nil {
 		return nil, fmt.Errorf("could not create azure devops work item tracking client with error: %w", err)
@@ -285,6 +339,7 @@ func CreateAzureDevOpsClientWithToken(ctx context.Context, token string, repo cl
 	return &Client{
 		ctx:        ctx,
 		azdoClient: gitClient,
+		token:      token,
 		audit: &auditHandler{
 			auditClient: auditClient,
 		},
@@ -307,6 +362,12 @@ func CreateAzureDevOpsClientWithToken(ctx context.Context, token string, repo cl
 			gitClient:    gitClient,
 			policyClient: policyClient,
 		},
+		releases: &releasesHandler{
+			gitClient:     gitClient,
+			releaseClient: releaseClient,
+			buildClient:   buildClient,
+			fileContainer: fileContainerClient,
+		},
 		search: &searchHandler{
 			searchClient: searchClient,
 		},
@@ -316,6 +377,9 @@ func CreateAzureDevOpsClientWithToken(ctx context.Context, token string, repo cl
 		servicehooks: &servicehooksHandler{
 			servicehooksClient: servicehooksClient,
 		},
+		wikis: &wikisHandler{
+			wikiClient: wikiClient,
+		},
 		workItems: &workItemsHandler{
 			workItemsClient: workItemsClient,
 		},
@@ -171,6 +171,9 @@ func (c *commitsHandler) listCommits() ([]clients.Commit, error) {
 				Login: *commit.Committer.Email,
 			},
 		}
+		if commit.Author != nil && commit.Author.Email != nil {
+			commits[i].Author = clients.User{Login: *commit.Author.Email}
+		}
 	}
 
 	// Associate pull requests with commits
@@ -24,21 +24,31 @@ import (
 )
 
 type contributorsHandler struct {
-	ctx          context.Context
-	once         *sync.Once
-	repourl      *Repo
-	gitClient    git.Client
-	errSetup     error
-	getCommits   fnGetCommits
-	contributors []clients.User
+	ctx             context.Context
+	once            *sync.Once
+	repourl         *Repo
+	gitClient       git.Client
+	errSetup        error
+	getCommits      fnGetCommits
+	getPullRequests fnGetPullRequests
+	contributors    []clients.User
 }
 
+type fnGetPullRequests func(ctx context.Context, args git.GetPullRequestsArgs) (*[]git.GitPullRequest, error)
+
+// Votes of reviewers approving a pull request.
+const (
+	voteApproved                = 10
+	voteApprovedWithSuggestions = 5
+)
+
 func (c *contributorsHandler) init(ctx context.Context, repourl *Repo) {
 	c.ctx = ctx
 	c.once = new(sync.Once)
 	c.repourl = repourl
 	c.errSetup = nil
 	c.getCommits = c.gitClient.GetCommits
+	c.getPullRequests = c.gitClient.GetPullRequests
 	c.contributors = nil
 }
 
@@ -71,22 +81,17 @@ func (c *contributorsHandler) setup() error {
 				if login == nil {
 					login = commit.Author.Name
 				}
-				if _, ok := contributors[*login]; ok {
-					user := contributors[*login]
-					user.NumContributions++
-					contributors[*login] = user
-				} else {
-					contributors[*login] = clients.User{
-						Login:            *login,
-						NumContributions: 1,
-						Companies:        []string{c.repourl.organization},
-					}
-				}
+				c.addContribution(contributors, *login)
 			}
 
 			skip += commitsPageSize
 		}
 
+		if err := c.addReviewers(contributors); err != nil {
+			c.errSetup = err
+			return
+		}
+
 		for _, contributor := range contributors {
 			c.contributors = append(c.contributors, contributor)
 		}
@@ -94,6 +99,65 @@ func (c *contributorsHandler) setup() error {
 	return c.errSetup
 }
 
+// addReviewers counts the approvals of completed pull requests as contributions,
+// so that maintainers who mostly review changes are reported too. Authors are
+// already accounted for by their commits.
+func (c *contributorsHandler) addReviewers(contributors map[string]clients.User) error {
+	pullRequestsPageSize := 1000
+	skip := 0
+	status := git.PullRequestStatusValues.Completed
+	for {
+		args := git.GetPullRequestsArgs{
+			RepositoryId: &c.repourl.id,
+			SearchCriteria: &git.GitPullRequestSearchCriteria{
+				Status: &status,
+			},
+			Top:  &pullRequestsPageSize,
+			Skip: &skip,
+		}
+		pullRequests, err := c.getPullRequests(c.ctx, args)
+		if err != nil {
+			return err
+		}
+
+		if pullRequests == nil || len(*pullRequests) == 0 {
+			return nil
+		}
+
+		for i := range *pullRequests {
+			pr := (*pullRequests)[i]
+			if pr.Reviewers == nil {
+				continue
+			}
+			for _, reviewer := range *pr.Reviewers {
+				if reviewer.Vote == nil || reviewer.UniqueName == nil ||
+					(reviewer.IsContainer != nil && *reviewer.IsContainer) {
+					continue
+				}
+				if *reviewer.Vote != voteApproved && *reviewer.Vote != voteApprovedWithSuggestions {
+					continue
+				}
+				c.addContribution(contributors, *reviewer.UniqueName)
+			}
+		}
+
+		skip += pullRequestsPageSize
+	}
+}
+
+func (c *contributorsHandler) addContribution(contributors map[string]clients.User, login string) {
+	if user, ok := contributors[login]; ok {
+		user.NumContributions++
+		contributors[login] = user
+		return
+	}
+	contributors[login] = clients.User{
+		Login:            login,
+		NumContributions: 1,
+		Companies:        []string{c.repourl.organization},
+	}
+}
+
 func (c *contributorsHandler) listContributors() ([]clients.User, error) {
 	if err := c.setup(); err != nil {
 		return nil, err
@@ -29,10 +29,11 @@ import (
 func Test_listContributors(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name         string
-		getCommits   fnGetCommits
-		wantContribs []clients.User
-		wantErr      bool
+		name            string
+		getCommits      fnGetCommits
+		getPullRequests fnGetPullRequests
+		wantContribs    []clients.User
+		wantErr         bool
 	}{
 		{
 			name: "no commits",
@@ -105,17 +106,67 @@ func Test_listContributors(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "pull request approvers",
+			getCommits: func(ctx context.Context, args git.GetCommitsArgs) (*[]git.GitCommitRef, error) {
+				if *args.SearchCriteria.Skip == 0 {
+					return &[]git.GitCommitRef{
+						{
+							Author: &git.GitUserDate{
+								Email: toPtr("test@example.com"),
+							},
+						},
+					}, nil
+				}
+				return &[]git.GitCommitRef{}, nil
+			},
+			getPullRequests: func(ctx context.Context, args git.GetPullRequestsArgs) (*[]git.GitPullRequest, error) {
+				if *args.Skip > 0 {
+					return &[]git.GitPullRequest{}, nil
+				}
+				return &[]git.GitPullRequest{
+					{
+						Reviewers: &[]git.IdentityRefWithVote{
+							{UniqueName: toPtr("test@example.com"), Vote: toPtr(voteApproved)},
+							{UniqueName: toPtr("reviewer@example.com"), Vote: toPtr(voteApprovedWithSuggestions)},
+							{UniqueName: toPtr("rejecter@example.com"), Vote: toPtr(-10)},
+							{UniqueName: toPtr("[testOrg]\\Reviewers"), Vote: toPtr(voteApproved), IsContainer: toPtr(true)},
+						},
+					},
+				}, nil
+			},
+			wantContribs: []clients.User{
+				{
+					Login:            "reviewer@example.com",
+					Companies:        []string{"testOrg"},
+					NumContributions: 1,
+				},
+				{
+					Login:            "test@example.com",
+					Companies:        []string{"testOrg"},
+					NumContributions: 2,
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
+			getPullRequests := tt.getPullRequests
+			if getPullRequests == nil {
+				getPullRequests = func(ctx context.Context, args git.GetPullRequestsArgs) (*[]git.GitPullRequest, error) {
+					return &[]git.GitPullRequest{}, nil
+				}
+			}
 			c := contributorsHandler{
 				ctx:  context.Background(),
 				once: new(sync.Once),
 				repourl: &Repo{
 					organization: "testOrg",
 				},
-				getCommits: tt.getCommits,
+				getCommits:      tt.getCommits,
+				getPullRequests: getPullRequests,
 			}
 			err := c.setup()
 			if (err != nil) != tt.wantErr {
@@ -0,0 +1,299 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevopsrepo
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/filecontainer"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// Number of release pipeline releases considered.
+const maxPipelineReleases = 30
+
+type releasesHandler struct {
+	ctx               context.Context
+	once              *sync.Once
+	repourl           *Repo
+	gitClient         git.Client
+	releaseClient     release.Client
+	buildClient       build.Client
+	fileContainer     filecontainer.Client
+	errSetup          error
+	getRefs           fnGetRefs
+	getReleases       fnGetReleases
+	getArtifacts      fnGetArtifacts
+	getContainerItems fnGetContainerItems
+	releases          []clients.Release
+}
+
+type (
+	fnGetReleases       func(ctx context.Context, args release.GetReleasesArgs) (*release.GetReleasesResponseValue, error)
+	fnGetArtifacts      func(ctx context.Context, args build.GetArtifactsArgs) (*[]build.BuildArtifact, error)
+	fnGetContainerItems func(
+		ctx context.Context,
+		args filecontainer.GetItemsArgs,
+	) (*[]filecontainer.FileContainerItem, error)
+)
+
+func (r *releasesHandler) init(ctx context.Context, repourl *Repo) {
+	r.ctx = ctx
+	r.once = new(sync.Once)
+	r.repourl = repourl
+	r.errSetup = nil
+	r.releases = nil
+	r.getRefs = r.gitClient.GetRefs
+	r.getReleases = r.releaseClient.GetReleases
+	r.getArtifacts = r.buildClient.GetArtifacts
+	r.getContainerItems = r.fileContainer.GetItems
+}
+
+// setup collects the releases of the release pipelines deploying artifacts
+// built from the repository, most recent first, followed by the Git tags
+// which no such release was created for.
+func (r *releasesHandler) setup() error {
+	r.once.Do(func() {
+		pipelineReleases, err := r.listPipelineReleases()
+		if err != nil {
+			r.errSetup = err
+			return
+		}
+		tagReleases, err := r.listTagReleases()
+		if err != nil {
+			r.errSetup = err
+			return
+		}
+
+		released := make(map[string]bool, len(pipelineReleases))
+		for i := range pipelineReleases {
+			released[pipelineReleases[i].TargetCommitish] = true
+		}
+		r.releases = pipelineReleases
+		for i := range tagReleases {
+			if !released[tagReleases[i].TargetCommitish] {
+				r.releases = append(r.releases, tagReleases[i])
+			}
+		}
+	})
+	return r.errSetup
+}
+
+func (r *releasesHandler) listReleases() ([]clients.Release, error) {
+	if err := r.setup(); err != nil {
+		return nil, err
+	}
+	return r.releases, nil
+}
+
+// listTagReleases maps the Git tags of the repository to releases. The API
+// doesn't return tag dates, so the tags are returned in reverse name order
+// which is the most recent first for most versioning schemes.
+func (r *releasesHandler) listTagReleases() ([]clients.Release, error) {
+	var refs []git.GitRef
+	filter := "tags/"
+	peelTags := true
+	continuationToken := ""
+	for {
+		args := git.GetRefsArgs{
+			Project:           &r.repourl.project,
+			RepositoryId:      &r.repourl.id,
+			Filter:            &filter,
+			PeelTags:          &peelTags,
+			ContinuationToken: &continuationToken,
+		}
+		response, err := r.getRefs(r.ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("request for tags failed with error %w", err)
+		}
+		refs = append(refs, response.Value...)
+		if response.ContinuationToken == "" {
+			break
+		}
+		continuationToken = response.ContinuationToken
+	}
+
+	releases := make([]clients.Release, 0, len(refs))
+	for i := len(refs) - 1; i >= 0; i-- {
+		ref := refs[i]
+		if ref.Name == nil || ref.ObjectId == nil {
+			continue
+		}
+		tag := strings.TrimPrefix(*ref.Name, "refs/tags/")
+		target := *ref.ObjectId
+		// Annotated tags are peeled to the tagged commit.
+		if ref.PeeledObjectId != nil && *ref.PeeledObjectId != "" {
+			target = *ref.PeeledObjectId
+		}
+		releases = append(releases, clients.Release{
+			TagName:         tag,
+			TargetCommitish: target,
+			URL:             fmt.Sprintf("https://%s?version=GT%s", r.repourl.URI(), tag),
+		})
+	}
+	return releases, nil
+}
+
+// listPipelineReleases returns the releases of classic release pipelines whose
+// artifacts come from the repository, either directly or through a build.
+// The files published by the build are reported as release assets.
+func (r *releasesHandler) listPipelineReleases() ([]clients.Release, error) {
+	top := maxPipelineReleases
+	order := release.ReleaseQueryOrderValues.Descending
+	expand := release.ReleaseExpandsValues.Artifacts
+	response, err := r.getReleases(r.ctx, release.GetReleasesArgs{
+		Project:    &r.repourl.project,
+		Top:        &top,
+		QueryOrder: &order,
+		Expand:     &expand,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("request for releases failed with error %w", err)
+	}
+
+	var releases []clients.Release
+	for i := range response.Value {
+		rel := response.Value[i]
+		if rel.Id == nil || rel.Name == nil || rel.Artifacts == nil {
+			continue
+		}
+		for _, artifact := range *rel.Artifacts {
+			commit, buildID, ok := r.artifactSource(&artifact)
+			if !ok {
+				continue
+			}
+			ret := clients.Release{
+				TagName:         *rel.Name,
+				TargetCommitish: commit,
+				URL: fmt.Sprintf("https://%s/%s/%s/_releaseProgress?releaseId=%d",
+					r.repourl.host, r.repourl.organization, r.repourl.project, *rel.Id),
+			}
+			if buildID != 0 {
+				ret.Assets, err = r.listBuildAssets(buildID)
+				if err != nil {
+					return nil, err
+				}
+			}
+			releases = append(releases, ret)
+			break
+		}
+	}
+	return releases, nil
+}
+
+// artifactSource returns the commit and, for build artifacts, the build ID
+// of a release artifact coming from the repository.
+func (r *releasesHandler) artifactSource(artifact *release.Artifact) (string, int, bool) {
+	if artifact.Type == nil || artifact.DefinitionReference == nil {
+		return "", 0, false
+	}
+	refs := *artifact.DefinitionReference
+	refID := func(key string) string {
+		if ref, ok := refs[key]; ok && ref.Id != nil {
+			return *ref.Id
+		}
+		return ""
+	}
+	switch *artifact.Type {
+	case "Build":
+		if !strings.EqualFold(refID("repository"), r.repourl.id) {
+			return "", 0, false
+		}
+		buildID, err := strconv.Atoi(refID("version"))
+		if err != nil {
+			return "", 0, false
+		}
+		return refID("sourceVersion"), buildID, true
+	case "Git":
+		if !strings.EqualFold(refID("definition"), r.repourl.id) {
+			return "", 0, false
+		}
+		return refID("version"), 0, true
+	default:
+		return "", 0, false
+	}
+}
+
+// listBuildAssets returns the files published by a build. Pipeline artifacts
+// can't be listed, so they are reported by artifact name.
+func (r *releasesHandler) listBuildAssets(buildID int) ([]clients.ReleaseAsset, error) {
+	artifacts, err := r.getArtifacts(r.ctx, build.GetArtifactsArgs{
+		Project: &r.repourl.project,
+		BuildId: &buildID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("request for build artifacts failed with error %w", err)
+	}
+
+	var assets []clients.ReleaseAsset
+	for _, artifact := range *artifacts {
+		if artifact.Name == nil || artifact.Resource == nil {
+			continue
+		}
+		containerID, itemPath, ok := containerResource(artifact.Resource)
+		if !ok {
+			asset := clients.ReleaseAsset{Name: *artifact.Name}
+			if artifact.Resource.DownloadUrl != nil {
+				asset.URL = *artifact.Resource.DownloadUrl
+			}
+			assets = append(assets, asset)
+			continue
+		}
+
+		items, err := r.getContainerItems(r.ctx, filecontainer.GetItemsArgs{
+			ContainerId: &containerID,
+			ItemPath:    &itemPath,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("request for build artifact files failed with error %w", err)
+		}
+		for _, item := range *items {
+			if item.Path == nil || item.ItemType == nil || *item.ItemType != filecontainer.ContainerItemTypeValues.File {
+				continue
+			}
+			asset := clients.ReleaseAsset{Name: path.Base(*item.Path)}
+			if item.ContentLocation != nil {
+				asset.URL = *item.ContentLocation
+			}
+			assets = append(assets, asset)
+		}
+	}
+	return assets, nil
+}
+
+// containerResource parses the data of a file container artifact, `#/<containerId>/<path>`.
+func containerResource(resource *build.ArtifactResource) (uint64, string, bool) {
+	if resource.Type == nil || !strings.EqualFold(*resource.Type, "Container") || resource.Data == nil {
+		return 0, "", false
+	}
+	id, itemPath, ok := strings.Cut(strings.TrimPrefix(*resource.Data, "#/"), "/")
+	if !ok {
+		return 0, "", false
+	}
+	containerID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, "", false
+	}
+	return containerID, itemPath, true
+}
@@ -0,0 +1,218 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevopsrepo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/filecontainer"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_listReleases(t *testing.T) {
+	t.Parallel()
+	noReleases := func(ctx context.Context, args release.GetReleasesArgs) (*release.GetReleasesResponseValue, error) {
+		return &release.GetReleasesResponseValue{}, nil
+	}
+	noRefs := func(ctx context.Context, args git.GetRefsArgs) (*git.GetRefsResponseValue, error) {
+		return &git.GetRefsResponseValue{}, nil
+	}
+	tests := []struct {
+		name        string
+		getRefs     fnGetRefs
+		getReleases fnGetReleases
+		want        []clients.Release
+		wantErr     bool
+	}{
+		{
+			name:        "no releases",
+			getRefs:     noRefs,
+			getReleases: noReleases,
+			want:        nil,
+		},
+		{
+			name: "tags",
+			getRefs: func(ctx context.Context, args git.GetRefsArgs) (*git.GetRefsResponseValue, error) {
+				if *args.ContinuationToken == "" {
+					return &git.GetRefsResponseValue{
+						Value: []git.GitRef{
+							{Name: toPtr("refs/tags/v1.0.0"), ObjectId: toPtr("sha1")},
+						},
+						ContinuationToken: "next",
+					}, nil
+				}
+				return &git.GetRefsResponseValue{
+					Value: []git.GitRef{
+						{Name: toPtr("refs/tags/v1.1.0"), ObjectId: toPtr("tag2"), PeeledObjectId: toPtr("sha2")},
+					},
+				}, nil
+			},
+			getReleases: noReleases,
+			want: []clients.Release{
+				{
+					TagName:         "v1.1.0",
+					TargetCommitish: "sha2",
+					URL:             "https://dev.azure.com/org/project/_git/repo?version=GTv1.1.0",
+				},
+				{
+					TagName:         "v1.0.0",
+					TargetCommitish: "sha1",
+					URL:             "https://dev.azure.com/org/project/_git/repo?version=GTv1.0.0",
+				},
+			},
+		},
+		{
+			name: "release pipeline",
+			getRefs: func(ctx context.Context, args git.GetRefsArgs) (*git.GetRefsResponseValue, error) {
+				return &git.GetRefsResponseValue{
+					Value: []git.GitRef{
+						{Name: toPtr("refs/tags/v1.0.0"), ObjectId: toPtr("sha1")},
+						{Name: toPtr("refs/tags/v2.0.0"), ObjectId: toPtr("sha2")},
+					},
+				}, nil
+			},
+			getReleases: func(ctx context.Context, args release.GetReleasesArgs) (*release.GetReleasesResponseValue, error) {
+				return &release.GetReleasesResponseValue{
+					Value: []release.Release{
+						{
+							Id:   toPtr(7),
+							Name: toPtr("Release-7"),
+							Artifacts: &[]release.Artifact{
+								{
+									Type: toPtr("Build"),
+									DefinitionReference: &map[string]release.ArtifactSourceReference{
+										"repository":    {Id: toPtr("repo-id")},
+										"version":       {Id: toPtr("42")},
+										"sourceVersion": {Id: toPtr("sha2")},
+									},
+								},
+							},
+						},
+						{
+							Id:   toPtr(6),
+							Name: toPtr("Release-6"),
+							Artifacts: &[]release.Artifact{
+								{
+									Type: toPtr("Build"),
+									DefinitionReference: &map[string]release.ArtifactSourceReference{
+										"repository":    {Id: toPtr("other-repo-id")},
+										"version":       {Id: toPtr("41")},
+										"sourceVersion": {Id: toPtr("sha0")},
+									},
+								},
+							},
+						},
+					},
+				}, nil
+			},
+			want: []clients.Release{
+				{
+					TagName:         "Release-7",
+					TargetCommitish: "sha2",
+					URL:             "https://dev.azure.com/org/project/_releaseProgress?releaseId=7",
+					Assets: []clients.ReleaseAsset{
+						{Name: "app.tar.gz", URL: "https://example.com/app.tar.gz"},
+						{Name: "app.tar.gz.sig", URL: "https://example.com/app.tar.gz.sig"},
+						{Name: "packages"},
+					},
+				},
+				{
+					TagName:         "v1.0.0",
+					TargetCommitish: "sha1",
+					URL:             "https://dev.azure.com/org/project/_git/repo?version=GTv1.0.0",
+				},
+			},
+		},
+		{
+			name:    "error",
+			getRefs: noRefs,
+			getReleases: func(ctx context.Context, args release.GetReleasesArgs) (*release.GetReleasesResponseValue, error) {
+				return nil, fmt.Errorf("error")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := releasesHandler{
+				ctx:  context.Background(),
+				once: new(sync.Once),
+				repourl: &Repo{
+					host:         "dev.azure.com",
+					organization: "org",
+					project:      "project",
+					name:         "repo",
+					id:           "repo-id",
+				},
+				getRefs:     tt.getRefs,
+				getReleases: tt.getReleases,
+				getArtifacts: func(ctx context.Context, args build.GetArtifactsArgs) (*[]build.BuildArtifact, error) {
+					return &[]build.BuildArtifact{
+						{
+							Name: toPtr("drop"),
+							Resource: &build.ArtifactResource{
+								Type: toPtr("Container"),
+								Data: toPtr("#/1234/drop"),
+							},
+						},
+						{
+							Name: toPtr("packages"),
+							Resource: &build.ArtifactResource{
+								Type: toPtr("PipelineArtifact"),
+							},
+						},
+					}, nil
+				},
+				getContainerItems: func(
+					ctx context.Context,
+					args filecontainer.GetItemsArgs,
+				) (*[]filecontainer.FileContainerItem, error) {
+					if *args.ContainerId != 1234 || *args.ItemPath != "drop" {
+						return nil, fmt.Errorf("unexpected container %d/%s", *args.ContainerId, *args.ItemPath)
+					}
+					return &[]filecontainer.FileContainerItem{
+						{Path: toPtr("drop"), ItemType: toPtr(filecontainer.ContainerItemTypeValues.Folder)},
+						{
+							Path:            toPtr("drop/app.tar.gz"),
+							ItemType:        toPtr(filecontainer.ContainerItemTypeValues.File),
+							ContentLocation: toPtr("https://example.com/app.tar.gz"),
+						},
+						{
+							Path:            toPtr("drop/app.tar.gz.sig"),
+							ItemType:        toPtr(filecontainer.ContainerItemTypeValues.File),
+							ContentLocation: toPtr("https://example.com/app.tar.gz.sig"),
+						},
+					}, nil
+				},
+			}
+			got, err := r.listReleases()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("releasesHandler.listReleases() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("releasesHandler.listReleases() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevopsrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+type wikisHandler struct {
+	ctx              context.Context
+	once             *sync.Once
+	repourl          *Repo
+	wikiClient       wiki.Client
+	getAllWikis      fnGetAllWikis
+	errSetup         error
+	projectWikiRepID string
+}
+
+type fnGetAllWikis func(ctx context.Context, args wiki.GetAllWikisArgs) (*[]wiki.WikiV2, error)
+
+func (w *wikisHandler) init(ctx context.Context, repourl *Repo) {
+	w.ctx = ctx
+	w.once = new(sync.Once)
+	w.repourl = repourl
+	w.errSetup = nil
+	w.projectWikiRepID = ""
+	w.getAllWikis = w.wikiClient.GetAllWikis
+}
+
+func (w *wikisHandler) setup() error {
+	w.once.Do(func() {
+		args := wiki.GetAllWikisArgs{
+			Project: &w.repourl.project,
+		}
+		wikis, err := w.getAllWikis(w.ctx, args)
+		if err != nil {
+			w.errSetup = err
+			return
+		}
+
+		for i := range *wikis {
+			wk := (*wikis)[i]
+			if wk.Type == nil || *wk.Type != wiki.WikiTypeValues.ProjectWiki || wk.RepositoryId == nil {
+				continue
+			}
+			w.projectWikiRepID = wk.RepositoryId.String()
+			return
+		}
+		w.errSetup = sce.WithMessage(sce.ErrRepoUnreachable, "project wiki not found")
+	})
+	return w.errSetup
+}
+
+// getProjectWikiRepositoryID returns the ID of the Git repository backing the project wiki.
+func (w *wikisHandler) getProjectWikiRepositoryID() (string, error) {
+	if err := w.setup(); err != nil {
+		return "", err
+	}
+	return w.projectWikiRepID, nil
+}
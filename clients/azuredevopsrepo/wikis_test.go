@@ -0,0 +1,86 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevopsrepo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+func Test_getProjectWikiRepositoryID(t *testing.T) {
+	t.Parallel()
+	codeWikiRepoID := uuid.New()
+	projectWikiRepoID := uuid.New()
+	tests := []struct {
+		name        string
+		getAllWikis fnGetAllWikis
+		want        string
+		wantErr     error
+	}{
+		{
+			name: "project wiki",
+			getAllWikis: func(ctx context.Context, args wiki.GetAllWikisArgs) (*[]wiki.WikiV2, error) {
+				return &[]wiki.WikiV2{
+					{Type: toPtr(wiki.WikiTypeValues.CodeWiki), RepositoryId: &codeWikiRepoID},
+					{Type: toPtr(wiki.WikiTypeValues.ProjectWiki), RepositoryId: &projectWikiRepoID},
+				}, nil
+			},
+			want: projectWikiRepoID.String(),
+		},
+		{
+			name: "no project wiki",
+			getAllWikis: func(ctx context.Context, args wiki.GetAllWikisArgs) (*[]wiki.WikiV2, error) {
+				return &[]wiki.WikiV2{
+					{Type: toPtr(wiki.WikiTypeValues.CodeWiki), RepositoryId: &codeWikiRepoID},
+				}, nil
+			},
+			wantErr: sce.ErrRepoUnreachable,
+		},
+		{
+			name: "error",
+			getAllWikis: func(ctx context.Context, args wiki.GetAllWikisArgs) (*[]wiki.WikiV2, error) {
+				return nil, errTest
+			},
+			wantErr: errTest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			w := wikisHandler{
+				ctx:         context.Background(),
+				once:        new(sync.Once),
+				repourl:     &Repo{project: "project"},
+				getAllWikis: tt.getAllWikis,
+			}
+			got, err := w.getProjectWikiRepositoryID()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("wikisHandler.getProjectWikiRepositoryID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("wikisHandler.getProjectWikiRepositoryID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+var errTest = errors.New("test error")
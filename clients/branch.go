@@ -29,7 +29,67 @@ type BranchProtectionRule struct {
 	RequireLinearHistory    *bool
 	EnforceAdmins           *bool
 	RequireLastPushApproval *bool
-	CheckRules              StatusChecksRule
+	RequireSignedCommits    *bool
+	// RequireMergeQueue is true if changes must be merged with a merge queue (or merge train).
+	RequireMergeQueue *bool
+	// RequiredDeployments are the environments to which changes must be deployed
+	// successfully before they can be merged.
+	RequiredDeployments []string
+	// BypassActors are the actors allowed to bypass the rules.
+	BypassActors []BypassActor
+	// Sources are the rule sources which apply to the branch.
+	Sources    []RuleSource
+	CheckRules StatusChecksRule
+}
+
+// RuleSourceType is the type of a source of branch rules.
+type RuleSourceType string
+
+const (
+	// RuleSourceBranchProtection is a classic GitHub branch protection rule.
+	RuleSourceBranchProtection RuleSourceType = "branchProtection"
+	// RuleSourceRepositoryRuleset is a GitHub ruleset of the repository.
+	RuleSourceRepositoryRuleset RuleSourceType = "repositoryRuleset"
+	// RuleSourceOrganizationRuleset is a GitHub ruleset of the organization.
+	RuleSourceOrganizationRuleset RuleSourceType = "organizationRuleset"
+	// RuleSourceProtectedBranch is a GitLab protected branch.
+	RuleSourceProtectedBranch RuleSourceType = "protectedBranch"
+	// RuleSourcePushRules are the GitLab push rules of the project.
+	RuleSourcePushRules RuleSourceType = "pushRules"
+	// RuleSourceApprovalRule is a GitLab merge request approval rule.
+	RuleSourceApprovalRule RuleSourceType = "approvalRule"
+)
+
+// RuleSource is a source of the rules of a branch.
+type RuleSource struct {
+	Name string
+	Type RuleSourceType
+	// Evaluate is true for rules in evaluate mode, which only report the changes which
+	// would be blocked. Their settings aren't part of the BranchProtectionRule.
+	Evaluate bool
+}
+
+// BypassActorType is the type of an actor allowed to bypass the rules of a branch.
+type BypassActorType string
+
+const (
+	BypassActorApp               BypassActorType = "app"
+	BypassActorTeam              BypassActorType = "team"
+	BypassActorUser              BypassActorType = "user"
+	BypassActorRepositoryRole    BypassActorType = "repositoryRole"
+	BypassActorOrganizationAdmin BypassActorType = "organizationAdmin"
+	BypassActorDeployKey         BypassActorType = "deployKey"
+	BypassActorAccessLevel       BypassActorType = "accessLevel"
+)
+
+// BypassActor is an actor allowed to bypass the rules of a branch.
+type BypassActor struct {
+	Name string
+	Type BypassActorType
+	// Source is the name of the rule source which the actor can bypass.
+	Source string
+	// PullRequestOnly is true if the actor can only bypass the rules when merging pull requests.
+	PullRequestOnly bool
 }
 
 // StatusChecksRule captures settings on status checks.
@@ -0,0 +1,330 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle implements offline data bundles. A bundle is a snapshot of
+// everything Scorecard's clients return for a repository, which can be
+// replayed later without network access.
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/internal/packageclient"
+)
+
+// FormatVersion is the version of the bundle layout written by Export.
+const FormatVersion = 1
+
+// Forges a bundle can be exported from.
+const (
+	ForgeGitHub      = "github"
+	ForgeGitLab      = "gitlab"
+	ForgeAzureDevOps = "azuredevops"
+)
+
+const (
+	manifestName = "bundle.json"
+	repoFilesDir = "files"
+	orgFilesDir  = "org"
+)
+
+var (
+	errRecorded        = errors.New("recorded error")
+	errInvalidBundle   = errors.New("invalid bundle")
+	errUnsupportedVers = errors.New("unsupported bundle version")
+)
+
+// CallError is an error returned by a client call at export time.
+// It is replayed when the same call is made against the bundle.
+type CallError struct {
+	Message     string `json:"message"`
+	Unsupported bool   `json:"unsupported,omitempty"`
+}
+
+func newCallError(err error) *CallError {
+	return &CallError{
+		Message:     err.Error(),
+		Unsupported: errors.Is(err, clients.ErrUnsupportedFeature),
+	}
+}
+
+func (e *CallError) toError(call string) error {
+	if e == nil {
+		return nil
+	}
+	if e.Unsupported {
+		return fmt.Errorf("%s: %w", call, clients.ErrUnsupportedFeature)
+	}
+	return fmt.Errorf("%s: %w: %s", call, errRecorded, e.Message)
+}
+
+// RepoInfo identifies the repository a bundle was exported from.
+type RepoInfo struct {
+	URI      string   `json:"uri"`
+	Host     string   `json:"host"`
+	Path     string   `json:"path"`
+	Metadata []string `json:"metadata,omitempty"`
+}
+
+// RepoData holds the RepoClient responses for a repository.
+// Responses for calls which take an argument are keyed by that argument.
+//
+//nolint:govet
+type RepoData struct {
+	URI               string                           `json:"uri"`
+	Files             []string                         `json:"files"`
+	FilesOnly         bool                             `json:"filesOnly,omitempty"`
+	Archived          bool                             `json:"archived"`
+	CreatedAt         time.Time                        `json:"createdAt"`
+	DefaultBranchName string                           `json:"defaultBranchName"`
+	DefaultBranch     *clients.BranchRef               `json:"defaultBranch,omitempty"`
+	Branches          map[string]*clients.BranchRef    `json:"branches,omitempty"`
+	Commits           []clients.Commit                 `json:"commits,omitempty"`
+	Issues            []clients.Issue                  `json:"issues,omitempty"`
+	PullRequests      []clients.PullRequest            `json:"pullRequests,omitempty"`
+	Licenses          []clients.License                `json:"licenses,omitempty"`
+	Releases          []clients.Release                `json:"releases,omitempty"`
+	Contributors      []clients.User                   `json:"contributors,omitempty"`
+	Webhooks          []clients.Webhook                `json:"webhooks,omitempty"`
+	Languages         []clients.Language               `json:"languages,omitempty"`
+	WorkflowRuns      map[string][]clients.WorkflowRun `json:"workflowRuns,omitempty"`
+	CheckRuns         map[string][]clients.CheckRun    `json:"checkRuns,omitempty"`
+	Statuses          map[string][]clients.Status      `json:"statuses,omitempty"`
+	SearchCommits     map[string][]clients.Commit      `json:"searchCommits,omitempty"`
+	// PrivateVulnerabilityReporting is whether vulnerabilities can be
+	// reported privately through the forge.
+	PrivateVulnerabilityReporting bool `json:"privateVulnerabilityReporting,omitempty"`
+	// Private is whether the repository is hidden from the public.
+	Private bool `json:"private,omitempty"`
+	// Errors maps a call (e.g. "ListCommits" or "GetBranch:main") to the
+	// error it returned.
+	Errors map[string]*CallError `json:"errors,omitempty"`
+}
+
+func (d *RepoData) err(call string) error {
+	if d.FilesOnly {
+		return fmt.Errorf("%s: %w", call, clients.ErrUnsupportedFeature)
+	}
+	return d.Errors[call].toError(call)
+}
+
+func (d *RepoData) record(call string, err error) bool {
+	if err == nil {
+		return true
+	}
+	if d.Errors == nil {
+		d.Errors = make(map[string]*CallError)
+	}
+	d.Errors[call] = newCallError(err)
+	return false
+}
+
+// SearchRecord is a recorded Search call.
+type SearchRecord struct {
+	Error    *CallError             `json:"error,omitempty"`
+	Request  clients.SearchRequest  `json:"request"`
+	Response clients.SearchResponse `json:"response"`
+}
+
+// BadgeRecord is a recorded CIIBestPracticesClient call.
+type BadgeRecord struct {
+	Error *CallError         `json:"error,omitempty"`
+	URI   string             `json:"uri"`
+	Level clients.BadgeLevel `json:"level"`
+}
+
+// PackageVersionsRecord is a recorded ProjectPackageClient call.
+type PackageVersionsRecord struct {
+	Error    *CallError                            `json:"error,omitempty"`
+	Versions *packageclient.ProjectPackageVersions `json:"versions,omitempty"`
+	Host     string                                `json:"host"`
+	Project  string                                `json:"project"`
+}
+
+// VulnerabilitiesRecord is a recorded VulnerabilitiesClient call.
+type VulnerabilitiesRecord struct {
+	Error    *CallError                      `json:"error,omitempty"`
+	Commit   string                          `json:"commit"`
+	Response clients.VulnerabilitiesResponse `json:"response"`
+}
+
+// Manifest is the JSON document at the root of a bundle.
+//
+//nolint:govet
+type Manifest struct {
+	Version         int                    `json:"version"`
+	CreatedAt       time.Time              `json:"createdAt"`
+	Forge           string                 `json:"forge"`
+	Repo            RepoInfo               `json:"repo"`
+	CommitSHA       string                 `json:"commitSHA"`
+	Repository      RepoData               `json:"repository"`
+	Org             *RepoData              `json:"org,omitempty"`
+	OSSFuzz         []SearchRecord         `json:"ossFuzz,omitempty"`
+	BestPractices   *BadgeRecord           `json:"bestPractices,omitempty"`
+	PackageVersions *PackageVersionsRecord `json:"packageVersions,omitempty"`
+	Vulnerabilities *VulnerabilitiesRecord `json:"vulnerabilities,omitempty"`
+}
+
+// Bundle is a bundle extracted to a local directory.
+type Bundle struct {
+	Manifest *Manifest
+	dir      string
+}
+
+// Open extracts the bundle archive at `archivePath` to a temporary
+// directory. Callers should Close the bundle when finished.
+func Open(archivePath string) (*Bundle, error) {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("os.Open: %w", err)
+	}
+	defer f.Close()
+
+	dir, err := os.MkdirTemp("", "scorecard-bundle")
+	if err != nil {
+		return nil, fmt.Errorf("os.MkdirTemp: %w", err)
+	}
+	b := &Bundle{dir: dir}
+	if err := b.extract(f); err != nil {
+		b.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+// Close removes the extracted bundle.
+func (b *Bundle) Close() error {
+	if err := os.RemoveAll(b.dir); err != nil {
+		return fmt.Errorf("os.RemoveAll: %w", err)
+	}
+	return nil
+}
+
+func (b *Bundle) extract(r io.Reader) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("gzip.NewReader: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("tar.Next: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name, err := sanitizeName(hdr.Name)
+		if err != nil {
+			return err
+		}
+		if name == manifestName {
+			var m Manifest
+			if err := json.NewDecoder(tr).Decode(&m); err != nil {
+				return fmt.Errorf("%w: decoding %s: %w", errInvalidBundle, manifestName, err)
+			}
+			b.Manifest = &m
+			continue
+		}
+		if err := writeFile(filepath.Join(b.dir, filepath.FromSlash(name)), tr); err != nil {
+			return err
+		}
+	}
+
+	if b.Manifest == nil {
+		return fmt.Errorf("%w: missing %s", errInvalidBundle, manifestName)
+	}
+	if b.Manifest.Version != FormatVersion {
+		return fmt.Errorf("%w: %d", errUnsupportedVers, b.Manifest.Version)
+	}
+	return nil
+}
+
+// sanitizeName rejects archive entries which would escape the bundle directory.
+func sanitizeName(name string) (string, error) {
+	cleaned := path.Clean(name)
+	if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("%w: illegal path %q", errInvalidBundle, name)
+	}
+	return cleaned, nil
+}
+
+func writeFile(fn string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(fn), 0o755); err != nil {
+		return fmt.Errorf("os.MkdirAll: %w", err)
+	}
+	f, err := os.OpenFile(fn, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("os.OpenFile: %w", err)
+	}
+	defer f.Close()
+	//nolint:gosec // bundle size is bounded by the exported repository.
+	if _, err := io.Copy(f, r); err != nil {
+		return fmt.Errorf("io.Copy: %w", err)
+	}
+	return nil
+}
+
+// archiveWriter writes bundle entries to a gzipped tar stream.
+type archiveWriter struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+}
+
+func newArchiveWriter(w io.Writer) *archiveWriter {
+	gz := gzip.NewWriter(w)
+	return &archiveWriter{gz: gz, tw: tar.NewWriter(gz)}
+}
+
+func (a *archiveWriter) add(name string, content []byte) error {
+	hdr := &tar.Header{
+		Name:     name,
+		Mode:     0o644,
+		Size:     int64(len(content)),
+		Typeflag: tar.TypeReg,
+	}
+	if err := a.tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("tar.WriteHeader: %w", err)
+	}
+	if _, err := a.tw.Write(content); err != nil {
+		return fmt.Errorf("tar.Write: %w", err)
+	}
+	return nil
+}
+
+func (a *archiveWriter) close() error {
+	if err := a.tw.Close(); err != nil {
+		return fmt.Errorf("tar.Close: %w", err)
+	}
+	if err := a.gz.Close(); err != nil {
+		return fmt.Errorf("gzip.Close: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/localdir"
+	"github.com/ossf/scorecard/v5/log"
+)
+
+var errAPI = errors.New("api error")
+
+// forgeClient adds forge data on top of a local directory client.
+type forgeClient struct {
+	clients.RepoClient
+}
+
+func (f *forgeClient) ListCommits() ([]clients.Commit, error) {
+	return []clients.Commit{
+		{SHA: "sha1", AssociatedMergeRequest: clients.PullRequest{Number: 1, HeadSHA: "head1"}},
+		{SHA: "sha2"},
+	}, nil
+}
+
+func (f *forgeClient) ListReleases() ([]clients.Release, error) {
+	return []clients.Release{{TagName: "v1", TargetCommitish: "release-1"}}, nil
+}
+
+func (f *forgeClient) GetBranch(branch string) (*clients.BranchRef, error) {
+	name := branch
+	return &clients.BranchRef{Name: &name}, nil
+}
+
+func (f *forgeClient) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
+	return []clients.CheckRun{{Status: "completed", Conclusion: "success", App: clients.CheckRunApp{Slug: "ci"}}}, nil
+}
+
+func (f *forgeClient) ListStatuses(ref string) ([]clients.Status, error) {
+	return nil, errAPI
+}
+
+type ossFuzzStub struct {
+	clients.RepoClient
+}
+
+func (o *ossFuzzStub) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
+	return clients.SearchResponse{Hits: 1}, nil
+}
+
+type ciiStub struct{}
+
+func (c ciiStub) GetBadgeLevel(ctx context.Context, uri string) (clients.BadgeLevel, error) {
+	return clients.Silver, nil
+}
+
+func writeRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"README.md":                "hello",
+		".github/workflows/ci.yml": "on: push",
+		".github/dependabot.yml":   "version: 2",
+		"src/main.go":              "package main",
+	}
+	for name, content := range files {
+		fn := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(fn), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fn, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+//nolint:gocognit
+func TestExportAndReplay(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo, err := localdir.MakeLocalDirRepo(writeRepo(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	f, err := os.Create(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Export(ctx, f, repo, &ExportConfig{
+		RepoClient:    &forgeClient{localdir.CreateLocalDirClient(ctx, log.NewLogger(log.DefaultLevel))},
+		OSSFuzzClient: &ossFuzzStub{},
+		CIIClient:     ciiStub{},
+		Forge:         ForgeGitHub,
+	})
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	f.Close()
+
+	b, err := Open(archive)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer b.Close()
+
+	replayRepo := b.Repo()
+	if replayRepo.URI() != repo.URI() {
+		t.Errorf("Repo().URI() = %q, want %q", replayRepo.URI(), repo.URI())
+	}
+	c := b.RepoClient()
+	if err := c.InitRepo(replayRepo, clients.HeadSHA, 0); err != nil {
+		t.Fatalf("InitRepo: %v", err)
+	}
+	if err := c.InitRepo(replayRepo, "deadbeef", 0); !errors.Is(err, errCommitMismatch) {
+		t.Errorf("InitRepo with another commit: got %v, want %v", err, errCommitMismatch)
+	}
+	if got := c.(*Client).Forge(); got != ForgeGitHub {
+		t.Errorf("Forge() = %q, want %q", got, ForgeGitHub)
+	}
+
+	workflows, err := c.ListFiles(func(fn string) (bool, error) {
+		return filepath.Dir(fn) == ".github/workflows", nil
+	})
+	if err != nil {
+		t.Fatalf("ListFiles: %v", err)
+	}
+	if diff := cmp.Diff([]string{".github/workflows/ci.yml"}, workflows); diff != "" {
+		t.Errorf("ListFiles mismatch (-want +got):\n%s", diff)
+	}
+	r, err := c.GetFileReader("src/main.go")
+	if err != nil {
+		t.Fatalf("GetFileReader: %v", err)
+	}
+	content, err := io.ReadAll(r)
+	r.Close()
+	if err != nil || string(content) != "package main" {
+		t.Errorf("GetFileReader content = %q, %v", content, err)
+	}
+	if _, err := c.GetFileReader("../outside"); !errors.Is(err, errInvalidBundle) {
+		t.Errorf("GetFileReader outside the bundle: got %v, want %v", err, errInvalidBundle)
+	}
+
+	commits, err := c.ListCommits()
+	if err != nil || len(commits) != 2 {
+		t.Errorf("ListCommits() = %v, %v", commits, err)
+	}
+	branch, err := c.GetBranch("release-1")
+	if err != nil || branch == nil || *branch.Name != "release-1" {
+		t.Errorf("GetBranch() = %v, %v", branch, err)
+	}
+	runs, err := c.ListCheckRunsForRef("head1")
+	if err != nil || len(runs) != 1 {
+		t.Errorf("ListCheckRunsForRef() = %v, %v", runs, err)
+	}
+	if _, err := c.ListStatuses("head1"); !errors.Is(err, errRecorded) {
+		t.Errorf("ListStatuses: got %v, want recorded error", err)
+	}
+	if _, err := c.ListWebhooks(); !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Errorf("ListWebhooks: got %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+	if _, err := c.GetOrgRepoClient(ctx); !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Errorf("GetOrgRepoClient: got %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+
+	resp, err := b.OSSFuzzClient().Search(clients.SearchRequest{Query: c.URI(), Filename: "project.yaml"})
+	if err != nil || resp.Hits != 1 {
+		t.Errorf("OSS-Fuzz Search() = %v, %v", resp, err)
+	}
+	level, err := b.CIIClient().GetBadgeLevel(ctx, repo.URI())
+	if err != nil || level != clients.Silver {
+		t.Errorf("GetBadgeLevel() = %v, %v", level, err)
+	}
+	if _, err := b.ProjectClient().GetProjectPackageVersions(ctx, "", ""); !errors.Is(err, errNoRecord) {
+		t.Errorf("GetProjectPackageVersions: got %v, want %v", err, errNoRecord)
+	}
+}
+
+func TestSanitizeName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "files/a/b.txt", want: "files/a/b.txt"},
+		{name: "files/./a/../b.txt", want: "files/b.txt"},
+		{name: "../etc/passwd", wantErr: true},
+		{name: "/etc/passwd", wantErr: true},
+		{name: "files/../../x", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := sanitizeName(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sanitizeName(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("sanitizeName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,400 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/internal/packageclient"
+)
+
+var (
+	_ clients.RepoClient             = &Client{}
+	_ clients.CIIBestPracticesClient = &ciiClient{}
+	_ clients.VulnerabilitiesClient  = &vulnClient{}
+	_ clients.Repo                   = &Repo{}
+
+	errRepoMismatch   = errors.New("repo does not match bundle")
+	errCommitMismatch = errors.New("commit does not match bundle")
+	errNoRecord       = errors.New("no recorded response")
+)
+
+// Client implements RepoClient by replaying recorded responses from a bundle.
+//
+//nolint:govet
+type Client struct {
+	data     *RepoData
+	org      *RepoData
+	dir      string
+	orgDir   string
+	searches []SearchRecord
+	bundle   *Bundle
+}
+
+// RepoClient returns a RepoClient replaying the repository data of the bundle.
+func (b *Bundle) RepoClient() clients.RepoClient {
+	return &Client{
+		bundle: b,
+		data:   &b.Manifest.Repository,
+		org:    b.Manifest.Org,
+		dir:    filepath.Join(b.dir, repoFilesDir),
+		orgDir: filepath.Join(b.dir, orgFilesDir),
+	}
+}
+
+// OSSFuzzClient returns a RepoClient replaying the recorded OSS-Fuzz searches.
+func (b *Bundle) OSSFuzzClient() clients.RepoClient {
+	return &Client{
+		data:     &RepoData{FilesOnly: true},
+		searches: b.Manifest.OSSFuzz,
+	}
+}
+
+// CIIClient returns a CIIBestPracticesClient replaying the recorded badge level.
+func (b *Bundle) CIIClient() clients.CIIBestPracticesClient {
+	return &ciiClient{record: b.Manifest.BestPractices}
+}
+
+// ProjectClient returns a ProjectPackageClient replaying the recorded package versions.
+func (b *Bundle) ProjectClient() packageclient.ProjectPackageClient {
+	return &projectClient{record: b.Manifest.PackageVersions}
+}
+
+// VulnerabilitiesClient returns a VulnerabilitiesClient replaying the
+// recorded vulnerabilities.
+func (b *Bundle) VulnerabilitiesClient() clients.VulnerabilitiesClient {
+	return &vulnClient{record: b.Manifest.Vulnerabilities}
+}
+
+// Forge returns the forge the bundle was exported from, e.g. ForgeGitHub.
+func (client *Client) Forge() string {
+	if client.bundle == nil {
+		return ""
+	}
+	return client.bundle.Manifest.Forge
+}
+
+// InitRepo implements RepoClient.InitRepo.
+func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitDepth int) error {
+	if client.bundle == nil {
+		return nil
+	}
+	m := client.bundle.Manifest
+	if inputRepo.URI() != m.Repo.URI {
+		return fmt.Errorf("%w: %s, bundle has %s", errRepoMismatch, inputRepo.URI(), m.Repo.URI)
+	}
+	if !strings.EqualFold(commitSHA, clients.HeadSHA) && !strings.EqualFold(commitSHA, m.CommitSHA) {
+		return fmt.Errorf("%w: %s, bundle has %s", errCommitMismatch, commitSHA, m.CommitSHA)
+	}
+	return nil
+}
+
+// URI implements RepoClient.URI.
+func (client *Client) URI() string {
+	return client.data.URI
+}
+
+// IsArchived implements RepoClient.IsArchived.
+func (client *Client) IsArchived() (bool, error) {
+	if err := client.data.err("IsArchived"); err != nil {
+		return false, err
+	}
+	return client.data.Archived, nil
+}
+
+// LocalPath implements RepoClient.LocalPath.
+func (client *Client) LocalPath() (string, error) {
+	if client.dir == "" {
+		return "", fmt.Errorf("LocalPath: %w", clients.ErrUnsupportedFeature)
+	}
+	return client.dir, nil
+}
+
+// ListFiles implements RepoClient.ListFiles.
+func (client *Client) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
+	if client.dir == "" {
+		return nil, fmt.Errorf("ListFiles: %w", clients.ErrUnsupportedFeature)
+	}
+	files := []string{}
+	for _, fn := range client.data.Files {
+		matches, err := predicate(fn)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			files = append(files, fn)
+		}
+	}
+	return files, nil
+}
+
+// GetFileReader implements RepoClient.GetFileReader.
+func (client *Client) GetFileReader(filename string) (io.ReadCloser, error) {
+	if client.dir == "" {
+		return nil, fmt.Errorf("GetFileReader: %w", clients.ErrUnsupportedFeature)
+	}
+	name, err := sanitizeName(filename)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(client.dir, filepath.FromSlash(name)))
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	return f, nil
+}
+
+// GetBranch implements RepoClient.GetBranch.
+func (client *Client) GetBranch(branch string) (*clients.BranchRef, error) {
+	if err := client.data.err("GetBranch:" + branch); err != nil {
+		return nil, err
+	}
+	if branch == client.data.DefaultBranchName && client.data.DefaultBranch != nil {
+		return client.data.DefaultBranch, nil
+	}
+	return client.data.Branches[branch], nil
+}
+
+// GetDefaultBranch implements RepoClient.GetDefaultBranch.
+func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
+	if err := client.data.err("GetDefaultBranch"); err != nil {
+		return nil, err
+	}
+	return client.data.DefaultBranch, nil
+}
+
+// GetDefaultBranchName implements RepoClient.GetDefaultBranchName.
+func (client *Client) GetDefaultBranchName() (string, error) {
+	if err := client.data.err("GetDefaultBranchName"); err != nil {
+		return "", err
+	}
+	return client.data.DefaultBranchName, nil
+}
+
+// GetOrgRepoClient implements RepoClient.GetOrgRepoClient.
+func (client *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, error) {
+	if client.org == nil {
+		return nil, fmt.Errorf("GetOrgRepoClient: %w", clients.ErrUnsupportedFeature)
+	}
+	return &Client{
+		data: client.org,
+		dir:  client.orgDir,
+	}, nil
+}
+
+// ListCommits implements RepoClient.ListCommits.
+func (client *Client) ListCommits() ([]clients.Commit, error) {
+	if err := client.data.err("ListCommits"); err != nil {
+		return nil, err
+	}
+	return client.data.Commits, nil
+}
+
+// ListIssues implements RepoClient.ListIssues.
+func (client *Client) ListIssues() ([]clients.Issue, error) {
+	if err := client.data.err("ListIssues"); err != nil {
+		return nil, err
+	}
+	return client.data.Issues, nil
+}
+
+// ListLicenses implements RepoClient.ListLicenses.
+func (client *Client) ListLicenses() ([]clients.License, error) {
+	if err := client.data.err("ListLicenses"); err != nil {
+		return nil, err
+	}
+	return client.data.Licenses, nil
+}
+
+// ListReleases implements RepoClient.ListReleases.
+func (client *Client) ListReleases() ([]clients.Release, error) {
+	if err := client.data.err("ListReleases"); err != nil {
+		return nil, err
+	}
+	return client.data.Releases, nil
+}
+
+// ListContributors implements RepoClient.ListContributors.
+func (client *Client) ListContributors() ([]clients.User, error) {
+	if err := client.data.err("ListContributors"); err != nil {
+		return nil, err
+	}
+	return client.data.Contributors, nil
+}
+
+// ListSuccessfulWorkflowRuns implements RepoClient.ListSuccessfulWorkflowRuns.
+func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
+	if err := client.data.err("ListSuccessfulWorkflowRuns:" + filename); err != nil {
+		return nil, err
+	}
+	return client.data.WorkflowRuns[filename], nil
+}
+
+// ListCheckRunsForRef implements RepoClient.ListCheckRunsForRef.
+func (client *Client) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
+	if err := client.data.err("ListCheckRunsForRef:" + ref); err != nil {
+		return nil, err
+	}
+	return client.data.CheckRuns[ref], nil
+}
+
+// ListStatuses implements RepoClient.ListStatuses.
+func (client *Client) ListStatuses(ref string) ([]clients.Status, error) {
+	if err := client.data.err("ListStatuses:" + ref); err != nil {
+		return nil, err
+	}
+	return client.data.Statuses[ref], nil
+}
+
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled.
+func (client *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	if err := client.data.err("IsPrivateVulnerabilityReportingEnabled"); err != nil {
+		return false, err
+	}
+	return client.data.PrivateVulnerabilityReporting, nil
+}
+
+// IsPrivate implements RepoClient.IsPrivate.
+func (client *Client) IsPrivate() (bool, error) {
+	if err := client.data.err("IsPrivate"); err != nil {
+		return false, err
+	}
+	return client.data.Private, nil
+}
+
+// ListPullRequests implements RepoClient.ListPullRequests.
+func (client *Client) ListPullRequests() ([]clients.PullRequest, error) {
+	if err := client.data.err("ListPullRequests"); err != nil {
+		return nil, err
+	}
+	return client.data.PullRequests, nil
+}
+
+// ListWebhooks implements RepoClient.ListWebhooks.
+func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
+	if err := client.data.err("ListWebhooks"); err != nil {
+		return nil, err
+	}
+	return client.data.Webhooks, nil
+}
+
+// ListProgrammingLanguages implements RepoClient.ListProgrammingLanguages.
+func (client *Client) ListProgrammingLanguages() ([]clients.Language, error) {
+	if err := client.data.err("ListProgrammingLanguages"); err != nil {
+		return nil, err
+	}
+	return client.data.Languages, nil
+}
+
+// GetCreatedAt implements RepoClient.GetCreatedAt.
+func (client *Client) GetCreatedAt() (time.Time, error) {
+	if err := client.data.err("GetCreatedAt"); err != nil {
+		return time.Time{}, err
+	}
+	return client.data.CreatedAt, nil
+}
+
+// Search implements RepoClient.Search.
+func (client *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
+	for i := range client.searches {
+		rec := &client.searches[i]
+		if rec.Request != request {
+			continue
+		}
+		if err := rec.Error.toError("Search"); err != nil {
+			return clients.SearchResponse{}, err
+		}
+		return rec.Response, nil
+	}
+	if client.searches != nil {
+		return clients.SearchResponse{}, fmt.Errorf("Search(%q): %w", request.Query, errNoRecord)
+	}
+	return clients.SearchResponse{}, fmt.Errorf("Search: %w", clients.ErrUnsupportedFeature)
+}
+
+// SearchCommits implements RepoClient.SearchCommits.
+func (client *Client) SearchCommits(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
+	if err := client.data.err("SearchCommits:" + request.Author); err != nil {
+		return nil, err
+	}
+	commits, ok := client.data.SearchCommits[request.Author]
+	if !ok {
+		return nil, fmt.Errorf("SearchCommits(%s): %w", request.Author, errNoRecord)
+	}
+	return commits, nil
+}
+
+// Close implements RepoClient.Close. The bundle itself is closed by its owner.
+func (client *Client) Close() error {
+	return nil
+}
+
+type ciiClient struct {
+	record *BadgeRecord
+}
+
+// GetBadgeLevel implements CIIBestPracticesClient.GetBadgeLevel.
+func (c *ciiClient) GetBadgeLevel(ctx context.Context, uri string) (clients.BadgeLevel, error) {
+	if c.record == nil || c.record.URI != uri {
+		return clients.Unknown, fmt.Errorf("GetBadgeLevel(%s): %w", uri, errNoRecord)
+	}
+	if err := c.record.Error.toError("GetBadgeLevel"); err != nil {
+		return clients.Unknown, err
+	}
+	return c.record.Level, nil
+}
+
+type projectClient struct {
+	record *PackageVersionsRecord
+}
+
+// GetProjectPackageVersions implements ProjectPackageClient.GetProjectPackageVersions.
+func (c *projectClient) GetProjectPackageVersions(
+	ctx context.Context, host, project string,
+) (*packageclient.ProjectPackageVersions, error) {
+	if c.record == nil || c.record.Host != host || c.record.Project != project {
+		return nil, fmt.Errorf("GetProjectPackageVersions(%s/%s): %w", host, project, errNoRecord)
+	}
+	if err := c.record.Error.toError("GetProjectPackageVersions"); err != nil {
+		return nil, err
+	}
+	return c.record.Versions, nil
+}
+
+type vulnClient struct {
+	record *VulnerabilitiesRecord
+}
+
+// ListUnfixedVulnerabilities implements VulnerabilitiesClient.ListUnfixedVulnerabilities.
+func (c *vulnClient) ListUnfixedVulnerabilities(
+	ctx context.Context, commit, localDir string,
+) (clients.VulnerabilitiesResponse, error) {
+	if c.record == nil || c.record.Commit != commit {
+		return clients.VulnerabilitiesResponse{},
+			fmt.Errorf("ListUnfixedVulnerabilities(%s): %w", commit, errNoRecord)
+	}
+	if err := c.record.Error.toError("ListUnfixedVulnerabilities"); err != nil {
+		return clients.VulnerabilitiesResponse{}, err
+	}
+	return c.record.Response, nil
+}
@@ -0,0 +1,283 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/internal/packageclient"
+)
+
+// dependabotAuthor is the commit author searched for by the
+// Dependency-Update-Tool check.
+const dependabotAuthor = "dependabot[bot]"
+
+var commitSHA = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// ExportConfig configures the clients queried by Export.
+// Nil clients are skipped and their data is absent from the bundle.
+type ExportConfig struct {
+	RepoClient    clients.RepoClient
+	OSSFuzzClient clients.RepoClient
+	CIIClient     clients.CIIBestPracticesClient
+	ProjectClient packageclient.ProjectPackageClient
+	VulnClient    clients.VulnerabilitiesClient
+	// Forge is the forge `RepoClient` talks to, e.g. ForgeGitHub.
+	Forge       string
+	CommitSHA   string
+	CommitDepth int
+}
+
+// Export queries every client in `cfg` about `repo` and writes the
+// responses, along with the repository files, as a bundle to `w`.
+func Export(ctx context.Context, w io.Writer, repo clients.Repo, cfg *ExportConfig) error {
+	if cfg.RepoClient == nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, "bundle export requires a RepoClient")
+	}
+	commit := cfg.CommitSHA
+	if commit == "" {
+		commit = clients.HeadSHA
+	}
+	if err := cfg.RepoClient.InitRepo(repo, commit, cfg.CommitDepth); err != nil {
+		return fmt.Errorf("RepoClient.InitRepo: %w", err)
+	}
+	defer cfg.RepoClient.Close()
+
+	m := Manifest{
+		Version:   FormatVersion,
+		CreatedAt: time.Now().UTC(),
+		Forge:     cfg.Forge,
+		Repo: RepoInfo{
+			URI:      repo.URI(),
+			Host:     repo.Host(),
+			Path:     repo.Path(),
+			Metadata: repo.Metadata(),
+		},
+		CommitSHA: commit,
+	}
+
+	aw := newArchiveWriter(w)
+	var err error
+	m.Repository.Files, err = exportFiles(aw, cfg.RepoClient, repoFilesDir)
+	if err != nil {
+		return err
+	}
+	exportRepoData(&m.Repository, cfg.RepoClient)
+
+	if org, err := cfg.RepoClient.GetOrgRepoClient(ctx); err == nil {
+		m.Org = &RepoData{URI: org.URI(), FilesOnly: true}
+		m.Org.Files, err = exportFiles(aw, org, orgFilesDir)
+		org.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	exportOtherClients(ctx, &m, cfg)
+
+	manifest, err := json.MarshalIndent(&m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("json.MarshalIndent: %w", err)
+	}
+	if err := aw.add(manifestName, manifest); err != nil {
+		return err
+	}
+	return aw.close()
+}
+
+// exportFiles copies all files of the repository into the archive under `dir`.
+func exportFiles(aw *archiveWriter, c clients.RepoClient, dir string) ([]string, error) {
+	files, err := c.ListFiles(func(string) (bool, error) { return true, nil })
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+	for _, fn := range files {
+		r, err := c.GetFileReader(fn)
+		if err != nil {
+			return nil, fmt.Errorf("RepoClient.GetFileReader(%s): %w", fn, err)
+		}
+		content, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("io.ReadAll(%s): %w", fn, err)
+		}
+		if err := aw.add(path.Join(dir, fn), content); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// exportRepoData records the RepoClient responses the checks rely on.
+// Errors are recorded rather than returned, so they are replayed as-is.
+func exportRepoData(d *RepoData, c clients.RepoClient) {
+	var err error
+	d.URI = c.URI()
+	d.Archived, err = c.IsArchived()
+	d.record("IsArchived", err)
+	d.PrivateVulnerabilityReporting, err = c.IsPrivateVulnerabilityReportingEnabled()
+	d.record("IsPrivateVulnerabilityReportingEnabled", err)
+	d.Private, err = c.IsPrivate()
+	d.record("IsPrivate", err)
+	d.CreatedAt, err = c.GetCreatedAt()
+	d.record("GetCreatedAt", err)
+	d.DefaultBranchName, err = c.GetDefaultBranchName()
+	d.record("GetDefaultBranchName", err)
+	d.DefaultBranch, err = c.GetDefaultBranch()
+	d.record("GetDefaultBranch", err)
+	d.Commits, err = c.ListCommits()
+	d.record("ListCommits", err)
+	d.Issues, err = c.ListIssues()
+	d.record("ListIssues", err)
+	d.PullRequests, err = c.ListPullRequests()
+	d.record("ListPullRequests", err)
+	d.Licenses, err = c.ListLicenses()
+	d.record("ListLicenses", err)
+	d.Releases, err = c.ListReleases()
+	d.record("ListReleases", err)
+	d.Contributors, err = c.ListContributors()
+	d.record("ListContributors", err)
+	d.Webhooks, err = c.ListWebhooks()
+	d.record("ListWebhooks", err)
+	d.Languages, err = c.ListProgrammingLanguages()
+	d.record("ListProgrammingLanguages", err)
+
+	d.SearchCommits = make(map[string][]clients.Commit)
+	commits, err := c.SearchCommits(clients.SearchCommitsOptions{Author: dependabotAuthor})
+	if d.record("SearchCommits:"+dependabotAuthor, err) {
+		d.SearchCommits[dependabotAuthor] = commits
+	}
+
+	// Release branches are looked up by Branch-Protection.
+	d.Branches = make(map[string]*clients.BranchRef)
+	for _, release := range d.Releases {
+		for _, name := range []string{release.TargetCommitish, branchRedirect(release.TargetCommitish)} {
+			if name == "" || commitSHA.MatchString(name) {
+				continue
+			}
+			if _, ok := d.Branches[name]; ok {
+				continue
+			}
+			branch, err := c.GetBranch(name)
+			if d.record("GetBranch:"+name, err) {
+				d.Branches[name] = branch
+			}
+		}
+	}
+
+	// Check runs and statuses of merged changes are used by CI-Tests and SAST.
+	d.CheckRuns = make(map[string][]clients.CheckRun)
+	d.Statuses = make(map[string][]clients.Status)
+	for i := range d.Commits {
+		ref := d.Commits[i].AssociatedMergeRequest.HeadSHA
+		if ref == "" {
+			continue
+		}
+		if _, ok := d.CheckRuns[ref]; !ok {
+			runs, err := c.ListCheckRunsForRef(ref)
+			if d.record("ListCheckRunsForRef:"+ref, err) {
+				d.CheckRuns[ref] = runs
+			}
+		}
+		if _, ok := d.Statuses[ref]; !ok {
+			statuses, err := c.ListStatuses(ref)
+			if d.record("ListStatuses:"+ref, err) {
+				d.Statuses[ref] = statuses
+			}
+		}
+	}
+
+	// Workflow runs are looked up by workflow filename.
+	d.WorkflowRuns = make(map[string][]clients.WorkflowRun)
+	for _, fn := range d.Files {
+		if !strings.HasPrefix(fn, ".github/workflows/") {
+			continue
+		}
+		name := path.Base(fn)
+		runs, err := c.ListSuccessfulWorkflowRuns(name)
+		if d.record("ListSuccessfulWorkflowRuns:"+name, err) {
+			d.WorkflowRuns[name] = runs
+		}
+	}
+}
+
+func exportOtherClients(ctx context.Context, m *Manifest, cfg *ExportConfig) {
+	if cfg.OSSFuzzClient != nil {
+		req := clients.SearchRequest{
+			Query:    m.Repository.URI,
+			Filename: "project.yaml",
+		}
+		rec := SearchRecord{Request: req}
+		resp, err := cfg.OSSFuzzClient.Search(req)
+		if err != nil {
+			rec.Error = newCallError(err)
+		} else {
+			rec.Response = resp
+		}
+		m.OSSFuzz = append(m.OSSFuzz, rec)
+	}
+
+	if cfg.CIIClient != nil {
+		m.BestPractices = &BadgeRecord{URI: m.Repo.URI}
+		level, err := cfg.CIIClient.GetBadgeLevel(ctx, m.Repo.URI)
+		if err != nil {
+			m.BestPractices.Error = newCallError(err)
+		} else {
+			m.BestPractices.Level = level
+		}
+	}
+
+	if cfg.ProjectClient != nil {
+		m.PackageVersions = &PackageVersionsRecord{Host: m.Repo.Host, Project: m.Repo.Path}
+		versions, err := cfg.ProjectClient.GetProjectPackageVersions(ctx, m.Repo.Host, m.Repo.Path)
+		if err != nil {
+			m.PackageVersions.Error = newCallError(err)
+		} else {
+			m.PackageVersions.Versions = versions
+		}
+	}
+
+	if cfg.VulnClient != nil {
+		commit := ""
+		if len(m.Repository.Commits) > 0 {
+			commit = m.Repository.Commits[0].SHA
+		}
+		m.Vulnerabilities = &VulnerabilitiesRecord{Commit: commit}
+		localPath, err := cfg.RepoClient.LocalPath()
+		if err == nil {
+			m.Vulnerabilities.Response, err = cfg.VulnClient.ListUnfixedVulnerabilities(ctx, commit, localPath)
+		}
+		if err != nil {
+			m.Vulnerabilities.Error = newCallError(err)
+		}
+	}
+}
+
+// branchRedirect mirrors the master -> main redirect done by Branch-Protection.
+func branchRedirect(name string) string {
+	if name == "master" {
+		return "main"
+	}
+	return ""
+}
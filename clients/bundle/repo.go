@@ -0,0 +1,71 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// Repo is the repository recorded in a bundle. It reports the same
+// identity as the repository the bundle was exported from.
+type Repo struct {
+	info     RepoInfo
+	metadata []string
+}
+
+// Repo returns the repository recorded in the bundle.
+func (b *Bundle) Repo() clients.Repo {
+	return &Repo{info: b.Manifest.Repo}
+}
+
+// URI implements Repo.URI.
+func (r *Repo) URI() string {
+	return r.info.URI
+}
+
+// Host implements Repo.Host.
+func (r *Repo) Host() string {
+	return r.info.Host
+}
+
+// Path implements Repo.Path.
+func (r *Repo) Path() string {
+	return r.info.Path
+}
+
+// String implements Repo.String.
+func (r *Repo) String() string {
+	return r.info.URI
+}
+
+// IsValid implements Repo.IsValid.
+func (r *Repo) IsValid() error {
+	if r.info.URI == "" {
+		return fmt.Errorf("%w: missing repository URI", errInvalidBundle)
+	}
+	return nil
+}
+
+// Metadata implements Repo.Metadata.
+func (r *Repo) Metadata() []string {
+	return append(append([]string{}, r.info.Metadata...), r.metadata...)
+}
+
+// AppendMetadata implements Repo.AppendMetadata.
+func (r *Repo) AppendMetadata(m ...string) {
+	r.metadata = append(r.metadata, m...)
+}
@@ -23,4 +23,6 @@ type Commit struct {
 	SHA                    string
 	AssociatedMergeRequest PullRequest
 	Committer              User
+	// Author is the author of the change, which may differ from its committer.
+	Author User
 }
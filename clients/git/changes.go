@@ -0,0 +1,121 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// AddedLine is a line added to a file by a commit.
+type AddedLine struct {
+	CommitSHA string
+	Path      string
+	Content   string
+	// Line is the line number in the file as of the commit.
+	Line int
+}
+
+// ListAddedLines returns the lines added by the last maxCommits commits.
+// Each commit is compared to its first parent, and binary files are skipped.
+func (c *Client) ListAddedLines(maxCommits int) ([]AddedLine, error) {
+	commitIter, err := c.gitRepo.Log(&git.LogOptions{
+		Order: git.LogOrderCommitterTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("git.Log: %w", err)
+	}
+	defer commitIter.Close()
+
+	var ret []AddedLine
+	for i := 0; i < maxCommits; i++ {
+		commit, err := commitIter.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("commitIter.Next: %w", err)
+		}
+		lines, err := addedLines(commit)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, lines...)
+	}
+	return ret, nil
+}
+
+func addedLines(commit *object.Commit) ([]AddedLine, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("commit.Tree: %w", err)
+	}
+	var parentTree *object.Tree
+	if commit.NumParents() > 0 {
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("commit.Parent: %w", err)
+		}
+		if parentTree, err = parent.Tree(); err != nil {
+			return nil, fmt.Errorf("parent.Tree: %w", err)
+		}
+	}
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, fmt.Errorf("object.DiffTree: %w", err)
+	}
+	patch, err := changes.Patch()
+	if err != nil {
+		return nil, fmt.Errorf("changes.Patch: %w", err)
+	}
+
+	var ret []AddedLine
+	for _, filePatch := range patch.FilePatches() {
+		_, to := filePatch.Files()
+		if to == nil || filePatch.IsBinary() {
+			continue
+		}
+		line := 1
+		for _, chunk := range filePatch.Chunks() {
+			content := strings.TrimSuffix(chunk.Content(), "\n")
+			var chunkLines []string
+			if content != "" {
+				chunkLines = strings.Split(content, "\n")
+			}
+			switch chunk.Type() {
+			case diff.Equal:
+				line += len(chunkLines)
+			case diff.Add:
+				for _, l := range chunkLines {
+					ret = append(ret, AddedLine{
+						CommitSHA: commit.Hash.String(),
+						Path:      to.Path(),
+						Content:   l,
+						Line:      line,
+					})
+					line++
+				}
+			case diff.Delete:
+			}
+		}
+	}
+	return ret, nil
+}
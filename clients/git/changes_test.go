@@ -0,0 +1,67 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestListAddedLines(t *testing.T) {
+	t.Parallel()
+	h := newHistoryRepo(t)
+	first := h.commit("a", "first\n", "alice", 0)
+	second := h.commit("a", "first\nsecond\nthird\n", "alice", 1)
+	third := h.commit("b", "other\n", "bob", 2)
+
+	tests := []struct {
+		name       string
+		want       []AddedLine
+		maxCommits int
+	}{
+		{
+			name:       "all commits",
+			maxCommits: 10,
+			want: []AddedLine{
+				{CommitSHA: third.String(), Path: "b", Content: "other", Line: 1},
+				{CommitSHA: second.String(), Path: "a", Content: "second", Line: 2},
+				{CommitSHA: second.String(), Path: "a", Content: "third", Line: 3},
+				{CommitSHA: first.String(), Path: "a", Content: "first", Line: 1},
+			},
+		},
+		{
+			name:       "most recent commit",
+			maxCommits: 1,
+			want: []AddedLine{
+				{CommitSHA: third.String(), Path: "b", Content: "other", Line: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		client := h.client(10)
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := client.ListAddedLines(tt.maxCommits)
+			if err != nil {
+				t.Fatalf("ListAddedLines: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
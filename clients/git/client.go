@@ -30,7 +30,6 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
-	"github.com/go-git/go-git/v5/plumbing/storer"
 	cp "github.com/otiai10/copy"
 
 	"github.com/ossf/scorecard/v5/clients"
@@ -50,9 +49,13 @@ var (
 type Client struct {
 	repo           clients.Repo
 	errListCommits error
+	mailmap        *mailmap
 	gitRepo        *git.Repository
 	worktree       *git.Worktree
 	listCommits    *sync.Once
+	getCreatedAt   *sync.Once
+	createdAt      time.Time
+	errCreatedAt   error
 	tempDir        string
 	commits        []clients.Commit
 	commitDepth    int
@@ -63,8 +66,11 @@ func (c *Client) InitRepo(repo clients.Repo, commitSHA string, commitDepth int)
 	c.Close()
 	c.listCommits = new(sync.Once)
 	c.commits = nil
+	c.getCreatedAt = new(sync.Once)
+	c.createdAt, c.errCreatedAt = time.Time{}, nil
 
 	// init
+	c.repo = repo
 	c.commitDepth = commitDepth
 	tempDir, err := os.MkdirTemp("", repoDir)
 	if err != nil {
@@ -90,7 +96,7 @@ func (c *Client) InitRepo(repo clients.Repo, commitSHA string, commitDepth int)
 		}
 		c.gitRepo, err = git.PlainClone(tempDir, false /*isBare*/, &git.CloneOptions{
 			URL:      uri,
-			Progress: os.Stdout,
+			Progress: os.Stderr,
 		})
 	}
 	if err != nil {
@@ -112,7 +118,7 @@ func (c *Client) InitRepo(repo clients.Repo, commitSHA string, commitDepth int)
 		}
 	}
 
-	return nil
+	return c.loadMailmap()
 }
 
 func (c *Client) ListCommits() ([]clients.Commit, error) {
@@ -124,7 +130,7 @@ func (c *Client) ListCommits() ([]clients.Commit, error) {
 			c.errListCommits = fmt.Errorf("git.CommitObjects: %w", err)
 			return
 		}
-		c.commits = make([]clients.Commit, 0, c.commitDepth)
+		objects := make([]*object.Commit, 0, c.commitDepth)
 		for i := 0; i < c.commitDepth; i++ {
 			commit, err := commitIter.Next()
 			if err != nil && !errors.Is(err, io.EOF) {
@@ -141,14 +147,19 @@ func (c *Client) ListCommits() ([]clients.Commit, error) {
 				c.errListCommits = fmt.Errorf("%w", errNilCommitFound)
 				return
 			}
+			objects = append(objects, commit)
+		}
 
+		mergeRequests := c.mergeRequests(objects)
+		c.commits = make([]clients.Commit, 0, len(objects))
+		for _, commit := range objects {
 			c.commits = append(c.commits, clients.Commit{
-				SHA:           commit.Hash.String(),
-				Message:       commit.Message,
-				CommittedDate: commit.Committer.When,
-				Committer: clients.User{
-					Login: commit.Committer.Email,
-				},
+				SHA:                    commit.Hash.String(),
+				Message:                commit.Message,
+				CommittedDate:          commit.Committer.When,
+				Committer:              c.user(&commit.Committer),
+				Author:                 c.author(&commit.Author),
+				AssociatedMergeRequest: mergeRequests[commit.Hash],
 			})
 		}
 	})
@@ -287,32 +298,6 @@ func (c *Client) GetBranch(branch string) (*clients.BranchRef, error) {
 	return branchRef, nil
 }
 
-func (c *Client) GetCreatedAt() (time.Time, error) {
-	// Retrieve the first commit of the repository
-	commitIter, err := c.gitRepo.Log(&git.LogOptions{Order: git.LogOrderCommitterTime})
-	if err != nil {
-		return time.Time{}, fmt.Errorf("git.Log: %w", err)
-	}
-	defer commitIter.Close()
-
-	// Iterate through the commits to find the first one
-	var firstCommit *object.Commit
-	err = commitIter.ForEach(func(c *object.Commit) error {
-		firstCommit = c
-		return storer.ErrStop
-	})
-	if err != nil && !errors.Is(err, storer.ErrStop) {
-		return time.Time{}, fmt.Errorf("commitIter.ForEach: %w", err)
-	}
-
-	if firstCommit == nil {
-		return time.Time{}, errNilCommitFound
-	}
-
-	// Return the commit time of the first commit
-	return firstCommit.Committer.When, nil
-}
-
 func (c *Client) GetDefaultBranchName() (string, error) {
 	headRef, err := c.gitRepo.Head()
 	if err != nil {
@@ -345,24 +330,27 @@ func (c *Client) ListLicenses() ([]clients.License, error) {
 	return nil, clients.ErrUnsupportedFeature
 }
 
-func (c *Client) ListReleases() ([]clients.Release, error) {
+func (c *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
 	return nil, clients.ErrUnsupportedFeature
 }
 
-func (c *Client) ListContributors() ([]clients.User, error) {
-	// TODO: Implement this
+func (c *Client) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
 	return nil, clients.ErrUnsupportedFeature
 }
 
-func (c *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
+func (c *Client) ListStatuses(ref string) ([]clients.Status, error) {
 	return nil, clients.ErrUnsupportedFeature
 }
 
-func (c *Client) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
-	return nil, clients.ErrUnsupportedFeature
+func (c *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
 }
 
-func (c *Client) ListStatuses(ref string) ([]clients.Status, error) {
+func (c *Client) IsPrivate() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) ListPullRequests() ([]clients.PullRequest, error) {
 	return nil, clients.ErrUnsupportedFeature
 }
 
@@ -0,0 +1,339 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const (
+	// maxMainlineCommits bounds the first-parent walk used to find where
+	// merged branches fork off.
+	maxMainlineCommits = 10000
+	// maxMergedCommits bounds the number of commits associated with a single merge.
+	maxMergedCommits = 250
+)
+
+var (
+	// Merge commit messages written by common forges when merging a change request.
+	mergeMessagePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`^Merge pull request #(\d+) from `),       // GitHub
+		regexp.MustCompile(`See merge request \S*!(\d+)`),            // GitLab
+		regexp.MustCompile(`^Merged in \S+ \(pull request #(\d+)\)`), // Bitbucket
+		regexp.MustCompile(`^Merged PR (\d+):`),                      // Azure DevOps
+	}
+	reReviewTrailer = regexp.MustCompile(`(?m)^(?:Reviewed-by|Approved-by):\s*(.+?)\s*$`)
+	reTrailerEmail  = regexp.MustCompile(`<([^>]+)>`)
+
+	// Email domains which don't identify an organization.
+	publicEmailDomains = map[string]bool{
+		"gmail.com":                true,
+		"googlemail.com":           true,
+		"hotmail.com":              true,
+		"outlook.com":              true,
+		"live.com":                 true,
+		"yahoo.com":                true,
+		"icloud.com":               true,
+		"me.com":                   true,
+		"proton.me":                true,
+		"protonmail.com":           true,
+		"qq.com":                   true,
+		"163.com":                  true,
+		"users.noreply.github.com": true,
+		"users.noreply.gitlab.com": true,
+	}
+)
+
+// webFlowEmail is the committer of changes made through the GitHub web UI.
+const webFlowEmail = "noreply@github.com"
+
+func (c *Client) loadMailmap() error {
+	f, err := os.Open(filepath.Join(c.tempDir, ".mailmap"))
+	if errors.Is(err, os.ErrNotExist) {
+		c.mailmap = nil
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("os.Open: %w", err)
+	}
+	defer f.Close()
+	c.mailmap = parseMailmap(f)
+	return nil
+}
+
+// user returns the canonical user for a commit signature.
+func (c *Client) user(sig *object.Signature) clients.User {
+	id := c.mailmap.lookup(identity{name: sig.Name, email: sig.Email})
+	return clients.User{
+		Login: id.email,
+		IsBot: strings.HasSuffix(id.name, "[bot]") || strings.Contains(id.email, "[bot]"),
+	}
+}
+
+// mergeRequests infers the change requests merged into the history from
+// merge commits. The merge commit and the commits it brought in are
+// associated with the change request.
+func (c *Client) mergeRequests(commits []*object.Commit) map[plumbing.Hash]clients.PullRequest {
+	ret := make(map[plumbing.Hash]clients.PullRequest)
+	var mainline map[plumbing.Hash]bool
+	for _, commit := range commits {
+		pr, ok := c.mergeRequest(commit)
+		if !ok {
+			continue
+		}
+		if mainline == nil {
+			mainline = c.firstParentChain(commits[0])
+		}
+		ret[commit.Hash] = pr
+
+		// Walk the merged branch until it joins the mainline.
+		queue := append([]plumbing.Hash{}, commit.ParentHashes[1:]...)
+		for n := 0; len(queue) > 0 && n < maxMergedCommits; n++ {
+			h := queue[0]
+			queue = queue[1:]
+			if _, ok := ret[h]; ok || mainline[h] {
+				continue
+			}
+			ret[h] = pr
+			parent, err := c.gitRepo.CommitObject(h)
+			if err != nil {
+				break
+			}
+			queue = append(queue, parent.ParentHashes...)
+		}
+	}
+	return ret
+}
+
+// mergeRequest returns the change request merged by a merge commit, if
+// the merge message identifies one.
+func (c *Client) mergeRequest(commit *object.Commit) (clients.PullRequest, bool) {
+	if commit.NumParents() < 2 {
+		return clients.PullRequest{}, false
+	}
+	number := 0
+	for _, re := range mergeMessagePatterns {
+		if m := re.FindStringSubmatch(commit.Message); len(m) == 2 {
+			number, _ = strconv.Atoi(m[1])
+			break
+		}
+	}
+	if number == 0 {
+		return clients.PullRequest{}, false
+	}
+
+	pr := clients.PullRequest{
+		Number:   number,
+		MergedAt: commit.Committer.When,
+		HeadSHA:  commit.ParentHashes[1].String(),
+		MergedBy: c.user(&commit.Committer),
+	}
+	if head, err := c.gitRepo.CommitObject(commit.ParentHashes[1]); err == nil {
+		pr.Author = c.user(&head.Author)
+	}
+	for _, m := range reReviewTrailer.FindAllStringSubmatch(commit.Message, -1) {
+		reviewer := clients.User{Login: m[1]}
+		if e := reTrailerEmail.FindStringSubmatch(m[1]); len(e) == 2 {
+			name := strings.TrimSpace(m[1][:strings.Index(m[1], "<")])
+			reviewer = c.user(&object.Signature{Name: name, Email: e[1]})
+		}
+		pr.Reviews = append(pr.Reviews, clients.Review{Author: &reviewer, State: "APPROVED"})
+	}
+	return pr, true
+}
+
+// firstParentChain returns `from` and the commits on its first-parent history.
+func (c *Client) firstParentChain(from *object.Commit) map[plumbing.Hash]bool {
+	chain := map[plumbing.Hash]bool{from.Hash: true}
+	commit := from
+	for i := 0; i < maxMainlineCommits && commit.NumParents() > 0; i++ {
+		chain[commit.ParentHashes[0]] = true
+		parent, err := commit.Parent(0)
+		if err != nil {
+			break
+		}
+		commit = parent
+	}
+	return chain
+}
+
+// ListReleases implements RepoClient.ListReleases. Releases are derived from
+// tags; releases of signed tags carry the tag's signature.
+func (c *Client) ListReleases() ([]clients.Release, error) {
+	refs, err := c.gitRepo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("git.Tags: %w", err)
+	}
+	type datedRelease struct {
+		when    time.Time
+		release clients.Release
+	}
+	var releases []datedRelease
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		release := clients.Release{
+			TagName: name,
+			URL:     fmt.Sprintf("%s/%s", c.repo.URI(), ref.Name()),
+		}
+
+		var when time.Time
+		tag, err := c.gitRepo.TagObject(ref.Hash())
+		switch {
+		case err == nil:
+			commit, err := tag.Commit()
+			if err != nil {
+				// Tags of trees or blobs are not releases.
+				return nil //nolint:nilerr
+			}
+			release.TargetCommitish = commit.Hash.String()
+			when = tag.Tagger.When
+			release.TagSignature = tag.PGPSignature
+		case errors.Is(err, plumbing.ErrObjectNotFound):
+			// Lightweight tag.
+			commit, err := c.gitRepo.CommitObject(ref.Hash())
+			if err != nil {
+				return nil //nolint:nilerr
+			}
+			release.TargetCommitish = commit.Hash.String()
+			when = commit.Committer.When
+		default:
+			return fmt.Errorf("git.TagObject: %w", err)
+		}
+		release.PublishedAt = when
+		releases = append(releases, datedRelease{when: when, release: release})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterating tags: %w", err)
+	}
+
+	// Most recent first, as forges list them.
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].when.After(releases[j].when)
+	})
+	ret := make([]clients.Release, 0, len(releases))
+	for i := range releases {
+		ret = append(ret, releases[i].release)
+	}
+	return ret, nil
+}
+
+// ListContributors implements RepoClient.ListContributors. Contributors are
+// the authors and committers of the commits returned by ListCommits, so the
+// history is walked once and only up to the configured commit depth.
+func (c *Client) ListContributors() ([]clients.User, error) {
+	commits, err := c.ListCommits()
+	if err != nil {
+		return nil, err
+	}
+
+	contributors := make(map[string]*clients.User)
+	for i := range commits {
+		users := []clients.User{commits[i].Author}
+		if committer := commits[i].Committer; committer.Login != commits[i].Author.Login &&
+			committer.Login != webFlowEmail {
+			users = append(users, committer)
+		}
+		for j := range users {
+			login := strings.ToLower(users[j].Login)
+			contributor, ok := contributors[login]
+			if !ok {
+				contributor = &clients.User{Login: users[j].Login, IsBot: users[j].IsBot}
+				if company := emailCompany(login); company != "" {
+					contributor.Companies = []string{company}
+				}
+				contributors[login] = contributor
+			}
+			contributor.NumContributions++
+		}
+	}
+
+	ret := make([]clients.User, 0, len(contributors))
+	for _, contributor := range contributors {
+		ret = append(ret, *contributor)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].NumContributions != ret[j].NumContributions {
+			return ret[i].NumContributions > ret[j].NumContributions
+		}
+		return ret[i].Login < ret[j].Login
+	})
+	return ret, nil
+}
+
+// author returns the commit author with the organization inferred from
+// their email domain, mirroring ListContributors.
+func (c *Client) author(sig *object.Signature) clients.User {
+	user := c.user(sig)
+	if company := emailCompany(strings.ToLower(user.Login)); company != "" {
+		user.Companies = []string{company}
+	}
+	return user
+}
+
+// emailCompany returns the organization identified by an email domain, if any.
+func emailCompany(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return ""
+	}
+	domain := email[at+1:]
+	if publicEmailDomains[domain] || !strings.Contains(domain, ".") {
+		return ""
+	}
+	return domain
+}
+
+// GetCreatedAt implements RepoClient.GetCreatedAt. The creation time is the
+// commit time of the root commit of the first-parent history of HEAD. The walk
+// is bounded by maxMainlineCommits: past it, the oldest commit reached is used.
+// The commit depth doesn't bound it, as the root commit is usually beyond it.
+func (c *Client) GetCreatedAt() (time.Time, error) {
+	c.getCreatedAt.Do(func() {
+		head, err := c.gitRepo.Head()
+		if err != nil {
+			c.errCreatedAt = fmt.Errorf("git.Head: %w", err)
+			return
+		}
+		commit, err := c.gitRepo.CommitObject(head.Hash())
+		if err != nil {
+			c.errCreatedAt = fmt.Errorf("git.CommitObject: %w", err)
+			return
+		}
+		for i := 0; i < maxMainlineCommits && commit.NumParents() > 0; i++ {
+			parent, err := commit.Parent(0)
+			if err != nil {
+				c.errCreatedAt = fmt.Errorf("commit.Parent: %w", err)
+				return
+			}
+			commit = parent
+		}
+		c.createdAt = commit.Committer.When
+	})
+	return c.createdAt, c.errCreatedAt
+}
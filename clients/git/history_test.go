@@ -0,0 +1,261 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gitV5 "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/localdir"
+)
+
+var baseTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type historyRepo struct {
+	t    *testing.T
+	repo *gitV5.Repository
+	w    *gitV5.Worktree
+	dir  string
+}
+
+func newHistoryRepo(t *testing.T) *historyRepo {
+	t.Helper()
+	dir := t.TempDir()
+	r, err := gitV5.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("PlainInit: %v", err)
+	}
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatalf("Worktree: %v", err)
+	}
+	return &historyRepo{t: t, repo: r, w: w, dir: dir}
+}
+
+func (h *historyRepo) commit(file, msg, author string, day int, parents ...plumbing.Hash) plumbing.Hash {
+	h.t.Helper()
+	if err := os.WriteFile(filepath.Join(h.dir, file), []byte(msg), 0o600); err != nil {
+		h.t.Fatalf("WriteFile: %v", err)
+	}
+	if _, err := h.w.Add(file); err != nil {
+		h.t.Fatalf("Add: %v", err)
+	}
+	sig := &object.Signature{Name: author, Email: author + "@example.com", When: baseTime.AddDate(0, 0, day)}
+	hash, err := h.w.Commit(msg, &gitV5.CommitOptions{Author: sig, Committer: sig, Parents: parents})
+	if err != nil {
+		h.t.Fatalf("Commit: %v", err)
+	}
+	return hash
+}
+
+func (h *historyRepo) client(commitDepth int) *Client {
+	h.t.Helper()
+	repo, err := localdir.MakeLocalDirRepo(h.dir)
+	if err != nil {
+		h.t.Fatalf("MakeLocalDirRepo: %v", err)
+	}
+	client := &Client{}
+	if err := client.InitRepo(repo, clients.HeadSHA, commitDepth); err != nil {
+		h.t.Fatalf("InitRepo: %v", err)
+	}
+	h.t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestListCommits_mergeRequests(t *testing.T) {
+	t.Parallel()
+	h := newHistoryRepo(t)
+	root := h.commit("a", "root", "alice", 0)
+	feature := h.commit("b", "feature", "bob", 1)
+	merge := h.commit("c", "Merge pull request #7 from bob/feature\n\nReviewed-by: Carol <carol@example.com>\n",
+		"alice", 2, root, feature)
+
+	commits, err := h.client(10).ListCommits()
+	if err != nil {
+		t.Fatalf("ListCommits: %v", err)
+	}
+	if len(commits) != 3 {
+		t.Fatalf("ListCommits returned %d commits, want 3", len(commits))
+	}
+
+	wantPR := clients.PullRequest{
+		Number:   7,
+		MergedAt: baseTime.AddDate(0, 0, 2),
+		HeadSHA:  feature.String(),
+		Author:   clients.User{Login: "bob@example.com"},
+		MergedBy: clients.User{Login: "alice@example.com"},
+		Reviews: []clients.Review{
+			{Author: &clients.User{Login: "carol@example.com"}, State: "APPROVED"},
+		},
+	}
+	for _, commit := range commits {
+		var want clients.PullRequest
+		if commit.SHA == merge.String() || commit.SHA == feature.String() {
+			want = wantPR
+		}
+		if diff := cmp.Diff(want, commit.AssociatedMergeRequest,
+			cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+			t.Errorf("commit %q: AssociatedMergeRequest mismatch (-want +got):\n%s", commit.Message, diff)
+		}
+	}
+}
+
+func TestListReleases(t *testing.T) {
+	t.Parallel()
+	h := newHistoryRepo(t)
+	first := h.commit("a", "first", "alice", 0)
+	second := h.commit("b", "second", "alice", 5)
+	if _, err := h.repo.CreateTag("v1.0.0", first, nil); err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+	tagger := &object.Signature{Name: "alice", Email: "alice@example.com", When: baseTime.AddDate(0, 0, 6)}
+	if _, err := h.repo.CreateTag("v2.0.0", second, &gitV5.CreateTagOptions{Tagger: tagger, Message: "v2"}); err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+	signed := &object.Tag{
+		Name:         "v2.1.0",
+		Tagger:       object.Signature{Name: "alice", Email: "alice@example.com", When: baseTime.AddDate(0, 0, 7)},
+		Message:      "v2.1\n",
+		TargetType:   plumbing.CommitObject,
+		Target:       second,
+		PGPSignature: "-----BEGIN PGP SIGNATURE-----\n\n-----END PGP SIGNATURE-----\n",
+	}
+	obj := h.repo.Storer.NewEncodedObject()
+	if err := signed.Encode(obj); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	hash, err := h.repo.Storer.SetEncodedObject(obj)
+	if err != nil {
+		t.Fatalf("SetEncodedObject: %v", err)
+	}
+	if err := h.repo.Storer.SetReference(plumbing.NewHashReference("refs/tags/v2.1.0", hash)); err != nil {
+		t.Fatalf("SetReference: %v", err)
+	}
+
+	client := h.client(10)
+	releases, err := client.ListReleases()
+	if err != nil {
+		t.Fatalf("ListReleases: %v", err)
+	}
+	want := []clients.Release{
+		{
+			TagName:         "v2.1.0",
+			TargetCommitish: second.String(),
+			URL:             client.repo.URI() + "/refs/tags/v2.1.0",
+			PublishedAt:     baseTime.AddDate(0, 0, 7),
+			TagSignature:    signed.PGPSignature,
+		},
+		{
+			TagName:         "v2.0.0",
+			TargetCommitish: second.String(),
+			URL:             client.repo.URI() + "/refs/tags/v2.0.0",
+			PublishedAt:     baseTime.AddDate(0, 0, 6),
+		},
+		{
+			TagName:         "v1.0.0",
+			TargetCommitish: first.String(),
+			URL:             client.repo.URI() + "/refs/tags/v1.0.0",
+			PublishedAt:     baseTime,
+		},
+	}
+	if diff := cmp.Diff(want, releases,
+		cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+		t.Errorf("ListReleases mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestListContributors(t *testing.T) {
+	t.Parallel()
+	h := newHistoryRepo(t)
+	h.commit(".mailmap", "Alice <alice@corp.example> <alice@example.com>\n", "alice", 0)
+	h.commit("a", "a", "alice", 1)
+	h.commit("b", "b", "bob", 2)
+
+	contributors, err := h.client(10).ListContributors()
+	if err != nil {
+		t.Fatalf("ListContributors: %v", err)
+	}
+	want := []clients.User{
+		{Login: "alice@corp.example", Companies: []string{"corp.example"}, NumContributions: 2},
+		{Login: "bob@example.com", Companies: []string{"example.com"}, NumContributions: 1},
+	}
+	if diff := cmp.Diff(want, contributors); diff != "" {
+		t.Errorf("ListContributors mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestListContributors_commitDepth(t *testing.T) {
+	t.Parallel()
+	h := newHistoryRepo(t)
+	h.commit("a", "a", "carol", 0)
+	h.commit("b", "b", "alice", 1)
+	h.commit("c", "c", "bob", 2)
+
+	contributors, err := h.client(2).ListContributors()
+	if err != nil {
+		t.Fatalf("ListContributors: %v", err)
+	}
+	want := []clients.User{
+		{Login: "alice@example.com", Companies: []string{"example.com"}, NumContributions: 1},
+		{Login: "bob@example.com", Companies: []string{"example.com"}, NumContributions: 1},
+	}
+	if diff := cmp.Diff(want, contributors); diff != "" {
+		t.Errorf("ListContributors mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestListCommits_author(t *testing.T) {
+	t.Parallel()
+	h := newHistoryRepo(t)
+	h.commit(".mailmap", "Alice <alice@corp.example> <alice@example.com>\n", "alice", 0)
+
+	commits, err := h.client(10).ListCommits()
+	if err != nil {
+		t.Fatalf("ListCommits: %v", err)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("ListCommits returned %d commits, want 1", len(commits))
+	}
+	want := clients.User{Login: "alice@corp.example", Companies: []string{"corp.example"}}
+	if diff := cmp.Diff(want, commits[0].Author); diff != "" {
+		t.Errorf("Author mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetCreatedAt(t *testing.T) {
+	t.Parallel()
+	h := newHistoryRepo(t)
+	h.commit("a", "root", "alice", 3)
+	h.commit("b", "second", "alice", 7)
+
+	client := h.client(1)
+	for i := 0; i < 2; i++ {
+		createdAt, err := client.GetCreatedAt()
+		if err != nil {
+			t.Fatalf("GetCreatedAt: %v", err)
+		}
+		if want := baseTime.AddDate(0, 0, 3); !createdAt.Equal(want) {
+			t.Errorf("GetCreatedAt() = %v, want %v", createdAt, want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// identity is a name and email pair, as found in commit signatures.
+type identity struct {
+	name  string
+	email string
+}
+
+// mailmap maps commit identities to canonical identities.
+// See https://git-scm.com/docs/gitmailmap.
+type mailmap struct {
+	// byNameEmail is keyed by lowercase commit name and email.
+	byNameEmail map[identity]identity
+	// byEmail is keyed by lowercase commit email.
+	byEmail map[string]identity
+}
+
+// parseMailmap parses a .mailmap file. Malformed lines are ignored,
+// as git does.
+func parseMailmap(r io.Reader) *mailmap {
+	m := &mailmap{
+		byNameEmail: make(map[identity]identity),
+		byEmail:     make(map[string]identity),
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		// Each line has up to two "[Name] <email>" entries:
+		// the canonical identity first, then the commit identity.
+		var names, emails []string
+		for {
+			open := strings.IndexByte(line, '<')
+			if open < 0 {
+				break
+			}
+			end := strings.IndexByte(line[open:], '>')
+			if end < 0 {
+				break
+			}
+			names = append(names, strings.TrimSpace(line[:open]))
+			emails = append(emails, strings.TrimSpace(line[open+1:open+end]))
+			line = line[open+end+1:]
+		}
+
+		switch len(emails) {
+		case 1:
+			// Proper Name <commit@email>
+			if names[0] == "" {
+				continue
+			}
+			m.add(identity{email: emails[0]}, identity{name: names[0]})
+		case 2:
+			// [Proper Name] <proper@email> [Commit Name] <commit@email>
+			m.add(identity{name: names[1], email: emails[1]}, identity{name: names[0], email: emails[0]})
+		}
+	}
+	return m
+}
+
+func (m *mailmap) add(from, to identity) {
+	from.email = strings.ToLower(from.email)
+	if from.name == "" {
+		prev := m.byEmail[from.email]
+		m.byEmail[from.email] = merge(prev, to)
+		return
+	}
+	from.name = strings.ToLower(from.name)
+	prev := m.byNameEmail[from]
+	m.byNameEmail[from] = merge(prev, to)
+}
+
+// merge fills in the missing fields of `to` from `prev`, so that separate
+// lines can map the name and the email of the same identity.
+func merge(prev, to identity) identity {
+	if to.name == "" {
+		to.name = prev.name
+	}
+	if to.email == "" {
+		to.email = prev.email
+	}
+	return to
+}
+
+// lookup returns the canonical identity for the given commit identity.
+func (m *mailmap) lookup(id identity) identity {
+	if m == nil {
+		return id
+	}
+	email := strings.ToLower(id.email)
+	canon, ok := m.byNameEmail[identity{name: strings.ToLower(id.name), email: email}]
+	if !ok {
+		canon, ok = m.byEmail[email]
+	}
+	if !ok {
+		return id
+	}
+	if canon.name == "" {
+		canon.name = id.name
+	}
+	if canon.email == "" {
+		canon.email = id.email
+	}
+	return canon
+}
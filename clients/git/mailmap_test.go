@@ -0,0 +1,77 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMailmap(t *testing.T) {
+	t.Parallel()
+	const content = `# comment
+Proper Name <commit@example.com>
+<proper@example.com> <Old@Example.com>
+Jane Doe <jane@corp.example> <jane@home.example>
+Jane Doe <jane@corp.example> Jane <jane@laptop.example> # trailing comment
+malformed line without email
+`
+	m := parseMailmap(strings.NewReader(content))
+
+	tests := []struct {
+		name string
+		in   identity
+		want identity
+	}{
+		{
+			name: "name only",
+			in:   identity{name: "commit", email: "commit@example.com"},
+			want: identity{name: "Proper Name", email: "commit@example.com"},
+		},
+		{
+			name: "email only, case insensitive",
+			in:   identity{name: "Old Name", email: "old@example.com"},
+			want: identity{name: "Old Name", email: "proper@example.com"},
+		},
+		{
+			name: "name and email",
+			in:   identity{name: "jd", email: "jane@home.example"},
+			want: identity{name: "Jane Doe", email: "jane@corp.example"},
+		},
+		{
+			name: "matching commit name",
+			in:   identity{name: "Jane", email: "jane@laptop.example"},
+			want: identity{name: "Jane Doe", email: "jane@corp.example"},
+		},
+		{
+			name: "other commit name",
+			in:   identity{name: "Someone", email: "jane@laptop.example"},
+			want: identity{name: "Someone", email: "jane@laptop.example"},
+		},
+		{
+			name: "unmapped",
+			in:   identity{name: "Other", email: "other@example.com"},
+			want: identity{name: "Other", email: "other@example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := m.lookup(tt.in); got != tt.want {
+				t.Errorf("lookup(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
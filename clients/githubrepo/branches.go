@@ -123,27 +123,54 @@ type refUpdateRule struct {
 	RequiredApprovingReviewCount *int32
 	RequiresCodeOwnerReviews     *bool
 	RequiresLinearHistory        *bool
+	RequiresSignatures           *bool
 	RequiredStatusCheckContexts  []string
 }
 
 // Used for all settings, both admin and non-admin ones.
 // This only works with an admin token.
 type branchProtectionRule struct {
-	DismissesStaleReviews        *bool
-	IsAdminEnforced              *bool
-	RequiresStrictStatusChecks   *bool
-	RequiresStatusChecks         *bool
-	AllowsDeletions              *bool
-	AllowsForcePushes            *bool
-	RequiredApprovingReviewCount *int32
-	RequiresCodeOwnerReviews     *bool
-	RequiresLinearHistory        *bool
-	RequireLastPushApproval      *bool
-	RequiredStatusCheckContexts  []string
+	DismissesStaleReviews          *bool
+	IsAdminEnforced                *bool
+	RequiresStrictStatusChecks     *bool
+	RequiresStatusChecks           *bool
+	AllowsDeletions                *bool
+	AllowsForcePushes              *bool
+	RequiredApprovingReviewCount   *int32
+	RequiresCodeOwnerReviews       *bool
+	RequiresLinearHistory          *bool
+	RequireLastPushApproval        *bool
+	RequiresCommitSignatures       *bool
+	RequiresDeployments            *bool
+	Pattern                        *string
+	RequiredStatusCheckContexts    []string
+	RequiredDeploymentEnvironments []string
+	BypassPullRequestAllowances    struct {
+		Nodes []*bypassAllowance
+	} `graphql:"bypassPullRequestAllowances(first: 100)"`
+	BypassForcePushAllowances struct {
+		Nodes []*bypassAllowance
+	} `graphql:"bypassForcePushAllowances(first: 100)"`
 	// TODO: verify there is no conflicts.
 	// BranchProtectionRuleConflicts interface{}
 }
 
+// An actor allowed to bypass the pull request or force push settings of a branch protection rule.
+type bypassAllowance struct {
+	Actor struct {
+		Typename string `graphql:"__typename"`
+		App      struct {
+			Name *string
+		} `graphql:"... on App"`
+		Team struct {
+			Name *string
+		} `graphql:"... on Team"`
+		User struct {
+			Login *string
+		} `graphql:"... on User"`
+	}
+}
+
 type branch struct {
 	Name                 *string
 	RefUpdateRule        *refUpdateRule
@@ -177,9 +204,13 @@ type repoRule struct {
 	Type       string
 	Parameters repoRulesParameters
 }
+type requiredDeploymentsParameters struct {
+	RequiredDeploymentEnvironments []string
+}
 type repoRulesParameters struct {
 	PullRequestParameters pullRequestRuleParameters     `graphql:"... on PullRequestParameters"`
 	StatusCheckParameters requiredStatusCheckParameters `graphql:"... on RequiredStatusChecksParameters"`
+	DeploymentParameters  requiredDeploymentsParameters `graphql:"... on RequiredDeploymentsParameters"`
 }
 type ruleSetConditionRefs struct {
 	Include []string
@@ -189,13 +220,30 @@ type ruleSetCondition struct {
 	RefName ruleSetConditionRefs
 }
 type ruleSetBypass struct {
+	Actor *struct {
+		Typename string `graphql:"__typename"`
+		App      struct {
+			Name *string
+		} `graphql:"... on App"`
+		Team struct {
+			Name *string
+		} `graphql:"... on Team"`
+	}
 	BypassMode         *string
 	OrganizationAdmin  *bool
+	DeployKey          *bool
 	RepositoryRoleName *string
 }
+type ruleSetSource struct {
+	Typename     string `graphql:"__typename"`
+	Organization struct {
+		Login *string
+	} `graphql:"... on Organization"`
+}
 type repoRuleSet struct {
 	Name         *string
 	Enforcement  *string
+	Source       ruleSetSource
 	Conditions   ruleSetCondition
 	BypassActors struct {
 		Nodes []*ruleSetBypass
@@ -211,7 +259,7 @@ type ruleSetData struct {
 		}
 		Rulesets struct {
 			Nodes []*repoRuleSet
-		} `graphql:"rulesets(first: 100)"`
+		} `graphql:"rulesets(first: 100, includeParents: true)"`
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
@@ -226,26 +274,27 @@ type branchesHandler struct {
 	graphClient       *githubv4.Client
 	data              *defaultBranchData
 	once              *sync.Once
-	ctx               context.Context
 	errSetup          error
 	repourl           *Repo
 	defaultBranchRef  *clients.BranchRef
 	defaultBranchName string
 	ruleSets          []*repoRuleSet
+	// Rulesets in evaluate mode, which don't block changes.
+	evaluatedRuleSets []*repoRuleSet
 }
 
-func (handler *branchesHandler) init(ctx context.Context, repourl *Repo) {
-	handler.ctx = ctx
+func (handler *branchesHandler) init(repourl *Repo) {
 	handler.repourl = repourl
 	handler.errSetup = nil
 	handler.once = new(sync.Once)
 	handler.defaultBranchRef = nil
 	handler.defaultBranchName = ""
 	handler.ruleSets = nil
+	handler.evaluatedRuleSets = nil
 	handler.data = nil
 }
 
-func (handler *branchesHandler) setup() error {
+func (handler *branchesHandler) setup(ctx context.Context) error {
 	handler.once.Do(func() {
 		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
 			handler.errSetup = fmt.Errorf("%w: branches only supported for HEAD queries", clients.ErrUnsupportedFeature)
@@ -258,17 +307,18 @@ func (handler *branchesHandler) setup() error {
 
 		// Fetch default branch name and any repository rulesets, which are available with basic read permission.
 		rulesData := new(ruleSetData)
-		if err := handler.graphClient.Query(handler.ctx, rulesData, vars); err != nil {
+		if err := handler.graphClient.Query(ctx, rulesData, vars); err != nil {
 			handler.errSetup = sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
 			return
 		}
 		handler.defaultBranchName = getDefaultBranchNameFrom(rulesData)
 		handler.ruleSets = getActiveRuleSetsFrom(rulesData)
+		handler.evaluatedRuleSets = getEvaluatedRuleSetsFrom(rulesData)
 
 		// Attempt to fetch branch protection rules, which require admin permission.
 		// Ignore permissions errors if we know the repository is using rulesets, so non-admins can still get a score.
 		handler.data = new(defaultBranchData)
-		if err := handler.graphClient.Query(handler.ctx, handler.data, vars); err != nil &&
+		if err := handler.graphClient.Query(ctx, handler.data, vars); err != nil &&
 			(!isPermissionsError(err) || len(handler.ruleSets) == 0) {
 			handler.errSetup = sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
 			return
@@ -280,16 +330,22 @@ func (handler *branchesHandler) setup() error {
 			return
 		}
 		handler.defaultBranchRef = getBranchRefFrom(handler.data.Repository.DefaultBranchRef, rules)
+		evaluated, err := rulesMatchingBranch(handler.evaluatedRuleSets, handler.defaultBranchName, true)
+		if err != nil {
+			handler.errSetup = sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("rulesMatchingBranch: %v", err))
+			return
+		}
+		addEvaluatedRuleSources(handler.defaultBranchRef, evaluated)
 	})
 	return handler.errSetup
 }
 
-func (handler *branchesHandler) query(branchName string) (*clients.BranchRef, error) {
+func (handler *branchesHandler) query(ctx context.Context, branchName string) (*clients.BranchRef, error) {
 	if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
 		return nil, fmt.Errorf("%w: branches only supported for HEAD queries", clients.ErrUnsupportedFeature)
 	}
 	// Call setup(), so we know if branchName == handler.defaultBranchName.
-	if err := handler.setup(); err != nil {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during branchesHandler.setup: %w", err)
 	}
 	vars := map[string]interface{}{
@@ -298,25 +354,31 @@ func (handler *branchesHandler) query(branchName string) (*clients.BranchRef, er
 		"branchRefName": githubv4.String(refPrefix + branchName),
 	}
 	queryData := new(branchData)
-	if err := handler.graphClient.Query(handler.ctx, queryData, vars); err != nil {
+	if err := handler.graphClient.Query(ctx, queryData, vars); err != nil {
 		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
 	}
 	rules, err := rulesMatchingBranch(handler.ruleSets, branchName, branchName == handler.defaultBranchName)
 	if err != nil {
 		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("rulesMatchingBranch: %v", err))
 	}
-	return getBranchRefFrom(queryData.Repository.Ref, rules), nil
+	evaluated, err := rulesMatchingBranch(handler.evaluatedRuleSets, branchName, branchName == handler.defaultBranchName)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("rulesMatchingBranch: %v", err))
+	}
+	branchRef := getBranchRefFrom(queryData.Repository.Ref, rules)
+	addEvaluatedRuleSources(branchRef, evaluated)
+	return branchRef, nil
 }
 
-func (handler *branchesHandler) getDefaultBranch() (*clients.BranchRef, error) {
-	if err := handler.setup(); err != nil {
+func (handler *branchesHandler) getDefaultBranch(ctx context.Context) (*clients.BranchRef, error) {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during branchesHandler.setup: %w", err)
 	}
 	return handler.defaultBranchRef, nil
 }
 
-func (handler *branchesHandler) getBranch(branch string) (*clients.BranchRef, error) {
-	branchRef, err := handler.query(branch)
+func (handler *branchesHandler) getBranch(ctx context.Context, branch string) (*clients.BranchRef, error) {
+	branchRef, err := handler.query(ctx, branch)
 	if err != nil {
 		return nil, fmt.Errorf("error during branchesHandler.query: %w", err)
 	}
@@ -328,6 +390,23 @@ func copyAdminSettings(src *branchProtectionRule, dst *clients.BranchProtectionR
 	copyBoolPtr(src.IsAdminEnforced, &dst.EnforceAdmins)
 	copyBoolPtr(src.RequireLastPushApproval, &dst.RequireLastPushApproval)
 	copyBoolPtr(src.DismissesStaleReviews, &dst.PullRequestRule.DismissStaleReviews)
+	if valueOrZero(src.RequiresDeployments) {
+		copyStringSlice(src.RequiredDeploymentEnvironments, &dst.RequiredDeployments)
+	}
+	var source string
+	if src.Pattern != nil {
+		source = *src.Pattern
+	}
+	for _, allowance := range src.BypassPullRequestAllowances.Nodes {
+		if actor, ok := allowance.bypassActor(source); ok {
+			dst.BypassActors = append(dst.BypassActors, actor)
+		}
+	}
+	for _, allowance := range src.BypassForcePushAllowances.Nodes {
+		if actor, ok := allowance.bypassActor(source); ok && !slices.Contains(dst.BypassActors, actor) {
+			dst.BypassActors = append(dst.BypassActors, actor)
+		}
+	}
 	if src.RequiresStatusChecks != nil {
 		copyBoolPtr(src.RequiresStatusChecks, &dst.CheckRules.RequiresStatusChecks)
 		// TODO(#3255): Update when GitHub GraphQL bug is fixed
@@ -358,6 +437,7 @@ func copyNonAdminSettings(src interface{}, dst *clients.BranchProtectionRule) {
 		copyBoolPtr(v.AllowsDeletions, &dst.AllowDeletions)
 		copyBoolPtr(v.AllowsForcePushes, &dst.AllowForcePushes)
 		copyBoolPtr(v.RequiresLinearHistory, &dst.RequireLinearHistory)
+		copyBoolPtr(v.RequiresCommitSignatures, &dst.RequireSignedCommits)
 		copyInt32Ptr(v.RequiredApprovingReviewCount, &dst.PullRequestRule.RequiredApprovingReviewCount)
 		copyBoolPtr(v.RequiresCodeOwnerReviews, &dst.PullRequestRule.RequireCodeOwnerReviews)
 		copyStringSlice(v.RequiredStatusCheckContexts, &dst.CheckRules.Contexts)
@@ -376,6 +456,7 @@ func copyNonAdminSettings(src interface{}, dst *clients.BranchProtectionRule) {
 		copyBoolPtr(v.AllowsDeletions, &dst.AllowDeletions)
 		copyBoolPtr(v.AllowsForcePushes, &dst.AllowForcePushes)
 		copyBoolPtr(v.RequiresLinearHistory, &dst.RequireLinearHistory)
+		copyBoolPtr(v.RequiresSignatures, &dst.RequireSignedCommits)
 		copyInt32Ptr(v.RequiredApprovingReviewCount, &dst.PullRequestRule.RequiredApprovingReviewCount)
 		copyBoolPtr(v.RequiresCodeOwnerReviews, &dst.PullRequestRule.RequireCodeOwnerReviews)
 		copyStringSlice(v.RequiredStatusCheckContexts, &dst.CheckRules.Contexts)
@@ -406,6 +487,32 @@ func getActiveRuleSetsFrom(data *ruleSetData) []*repoRuleSet {
 	return ret
 }
 
+func getEvaluatedRuleSetsFrom(data *ruleSetData) []*repoRuleSet {
+	ret := make([]*repoRuleSet, 0)
+	for _, rule := range data.Repository.Rulesets.Nodes {
+		if rule.Enforcement == nil || *rule.Enforcement != "EVALUATE" {
+			continue
+		}
+		ret = append(ret, rule)
+	}
+	return ret
+}
+
+func (a *bypassAllowance) bypassActor(source string) (clients.BypassActor, bool) {
+	actor := clients.BypassActor{Source: source}
+	switch a.Actor.Typename {
+	case "App":
+		actor.Type, actor.Name = clients.BypassActorApp, valueOrZero(a.Actor.App.Name)
+	case "Team":
+		actor.Type, actor.Name = clients.BypassActorTeam, valueOrZero(a.Actor.Team.Name)
+	case "User":
+		actor.Type, actor.Name = clients.BypassActorUser, valueOrZero(a.Actor.User.Login)
+	default:
+		return actor, false
+	}
+	return actor, true
+}
+
 func getBranchRefFrom(data *branch, rules []*repoRuleSet) *clients.BranchRef {
 	if data == nil {
 		return nil
@@ -428,6 +535,13 @@ func getBranchRefFrom(data *branch, rules []*repoRuleSet) *clients.BranchRef {
 
 	*branchRef.Protected = true
 	branchRule := &branchRef.BranchProtectionRule
+	if data.BranchProtectionRule != nil || data.RefUpdateRule != nil {
+		source := clients.RuleSource{Type: clients.RuleSourceBranchProtection}
+		if data.BranchProtectionRule != nil && data.BranchProtectionRule.Pattern != nil {
+			source.Name = *data.BranchProtectionRule.Pattern
+		}
+		branchRule.Sources = append(branchRule.Sources, source)
+	}
 
 	switch {
 	// All settings are available. This typically means
@@ -466,6 +580,10 @@ const (
 	ruleLinear                 = "REQUIRED_LINEAR_HISTORY"
 	rulePullRequest            = "PULL_REQUEST"
 	ruleStatusCheck            = "REQUIRED_STATUS_CHECKS"
+	ruleSignatures             = "REQUIRED_SIGNATURES"
+	ruleDeployments            = "REQUIRED_DEPLOYMENTS"
+	ruleMergeQueue             = "MERGE_QUEUE"
+	bypassModePullRequest      = "PULL_REQUEST"
 )
 
 func rulesMatchingBranch(rules []*repoRuleSet, name string, defaultRef bool) ([]*repoRuleSet, error) {
@@ -514,6 +632,13 @@ func applyRepoRules(branchRef *clients.BranchRef, rules []*repoRuleSet) {
 		}
 
 		translated.EnforceAdmins = asPtr(len(r.BypassActors.Nodes) == 0)
+		translated.RequireSignedCommits = asPtr(false)
+		translated.RequireMergeQueue = asPtr(false)
+		source := ruleSetSourceFrom(r)
+		translated.Sources = []clients.RuleSource{source}
+		for _, actor := range r.BypassActors.Nodes {
+			translated.BypassActors = append(translated.BypassActors, actor.bypassActor(source.Name))
+		}
 
 		for _, rule := range r.Rules.Nodes {
 			switch rule.Type {
@@ -527,12 +652,61 @@ func applyRepoRules(branchRef *clients.BranchRef, rules []*repoRuleSet) {
 				translatePullRequestRepoRule(&translated, rule)
 			case ruleStatusCheck:
 				translateRequiredStatusRepoRule(&translated, rule)
+			case ruleSignatures:
+				translated.RequireSignedCommits = asPtr(true)
+			case ruleMergeQueue:
+				translated.RequireMergeQueue = asPtr(true)
+			case ruleDeployments:
+				translated.RequiredDeployments = rule.Parameters.DeploymentParameters.RequiredDeploymentEnvironments
 			}
 		}
 		mergeBranchProtectionRules(&branchRef.BranchProtectionRule, &translated)
 	}
 }
 
+// addEvaluatedRuleSources adds the rulesets in evaluate mode which apply to a branch to its sources.
+func addEvaluatedRuleSources(branchRef *clients.BranchRef, rules []*repoRuleSet) {
+	if branchRef == nil {
+		return
+	}
+	for _, r := range rules {
+		source := ruleSetSourceFrom(r)
+		source.Evaluate = true
+		branchRef.BranchProtectionRule.Sources = append(branchRef.BranchProtectionRule.Sources, source)
+	}
+}
+
+func ruleSetSourceFrom(r *repoRuleSet) clients.RuleSource {
+	source := clients.RuleSource{
+		Name: valueOrZero(r.Name),
+		Type: clients.RuleSourceRepositoryRuleset,
+	}
+	if r.Source.Typename == "Organization" {
+		source.Type = clients.RuleSourceOrganizationRuleset
+	}
+	return source
+}
+
+func (b *ruleSetBypass) bypassActor(source string) clients.BypassActor {
+	actor := clients.BypassActor{
+		Source:          source,
+		PullRequestOnly: valueOrZero(b.BypassMode) == bypassModePullRequest,
+	}
+	switch {
+	case valueOrZero(b.OrganizationAdmin):
+		actor.Type = clients.BypassActorOrganizationAdmin
+	case valueOrZero(b.DeployKey):
+		actor.Type = clients.BypassActorDeployKey
+	case b.RepositoryRoleName != nil:
+		actor.Type, actor.Name = clients.BypassActorRepositoryRole, *b.RepositoryRoleName
+	case b.Actor != nil && b.Actor.Typename == "App":
+		actor.Type, actor.Name = clients.BypassActorApp, valueOrZero(b.Actor.App.Name)
+	case b.Actor != nil && b.Actor.Typename == "Team":
+		actor.Type, actor.Name = clients.BypassActorTeam, valueOrZero(b.Actor.Team.Name)
+	}
+	return actor
+}
+
 func translatePullRequestRepoRule(base *clients.BranchProtectionRule, rule *repoRule) {
 	base.PullRequestRule.Required = asPtr(true)
 	base.PullRequestRule.DismissStaleReviews = rule.Parameters.PullRequestParameters.DismissStaleReviewsOnPush
@@ -581,6 +755,19 @@ func mergeBranchProtectionRules(base, translated *clients.BranchProtectionRule)
 	if base.RequireLinearHistory == nil || valueOrZero(translated.RequireLinearHistory) {
 		base.RequireLinearHistory = translated.RequireLinearHistory
 	}
+	if base.RequireSignedCommits == nil || valueOrZero(translated.RequireSignedCommits) {
+		base.RequireSignedCommits = translated.RequireSignedCommits
+	}
+	if base.RequireMergeQueue == nil || valueOrZero(translated.RequireMergeQueue) {
+		base.RequireMergeQueue = translated.RequireMergeQueue
+	}
+	for _, environment := range translated.RequiredDeployments {
+		if !slices.Contains(base.RequiredDeployments, environment) {
+			base.RequiredDeployments = append(base.RequiredDeployments, environment)
+		}
+	}
+	base.BypassActors = append(base.BypassActors, translated.BypassActors...)
+	base.Sources = append(base.Sources, translated.Sources...)
 	mergeCheckRules(&base.CheckRules, &translated.CheckRules)
 	mergePullRequestReviews(&base.PullRequestRule, &translated.PullRequestRule)
 }
@@ -41,8 +41,8 @@ var _ = Describe("E2E TEST: githubrepo.branchesHandler", func() {
 				repo:      "scorecard",
 				commitSHA: clients.HeadSHA,
 			}
-			brancheshandler.init(context.Background(), repourl)
-			Expect(brancheshandler.setup()).Should(BeNil())
+			brancheshandler.init(repourl)
+			Expect(brancheshandler.setup(context.Background())).Should(BeNil())
 			Expect(brancheshandler.data).ShouldNot(BeNil())
 			Expect(brancheshandler.data.RateLimit.Cost).ShouldNot(BeNil())
 			Expect(*brancheshandler.data.RateLimit.Cost).Should(BeNumerically("<=", 1))
@@ -56,8 +56,8 @@ var _ = Describe("E2E TEST: githubrepo.branchesHandler", func() {
 				repo:      "scorecard",
 				commitSHA: "de5224bbc56eceb7a25aece55d2d53bbc561ed2d",
 			}
-			brancheshandler.init(context.Background(), repourl)
-			Expect(brancheshandler.setup()).ShouldNot(BeNil())
+			brancheshandler.init(repourl)
+			Expect(brancheshandler.setup(context.Background())).ShouldNot(BeNil())
 			Expect(brancheshandler.data).Should(BeNil())
 		})
 	})
@@ -71,9 +71,9 @@ var _ = Describe("E2E TEST: githubrepo.branchesHandler", func() {
 				repo:      "scorecard",
 				commitSHA: clients.HeadSHA,
 			}
-			brancheshandler.init(context.Background(), repourl)
+			brancheshandler.init(repourl)
 
-			branchRef, err := brancheshandler.getDefaultBranch()
+			branchRef, err := brancheshandler.getDefaultBranch(context.Background())
 			Expect(err).Should(BeNil())
 			Expect(branchRef).ShouldNot(BeNil())
 			Expect(*branchRef.Name).Should(Equal("main"))
@@ -88,9 +88,9 @@ var _ = Describe("E2E TEST: githubrepo.branchesHandler", func() {
 				repo:      "scorecard",
 				commitSHA: clients.HeadSHA,
 			}
-			brancheshandler.init(context.Background(), repourl)
+			brancheshandler.init(repourl)
 
-			branchRef, err := brancheshandler.getBranch("main")
+			branchRef, err := brancheshandler.getBranch(context.Background(), "main")
 			Expect(err).Should(BeNil())
 			Expect(branchRef).ShouldNot(BeNil())
 		})
@@ -103,9 +103,9 @@ var _ = Describe("E2E TEST: githubrepo.branchesHandler", func() {
 				repo:      "scorecard",
 				commitSHA: clients.HeadSHA,
 			}
-			brancheshandler.init(context.Background(), repourl)
+			brancheshandler.init(repourl)
 
-			branchRef, err := brancheshandler.getBranch("nonexistent-branch")
+			branchRef, err := brancheshandler.getBranch(context.Background(), "nonexistent-branch")
 			Expect(err).Should(BeNil())
 			Expect(branchRef).Should(BeNil())
 		})
@@ -119,8 +119,8 @@ var _ = Describe("E2E TEST: githubrepo.branchesHandler", func() {
 				repo:      "scorecard",
 				commitSHA: clients.HeadSHA,
 			}
-			brancheshandler.init(context.Background(), repourl)
-			Expect(brancheshandler.query("main")).ShouldNot(BeNil())
+			brancheshandler.init(repourl)
+			Expect(brancheshandler.query(context.Background(), "main")).ShouldNot(BeNil())
 		})
 
 		It("Should fail for non-HEAD query", func() {
@@ -131,8 +131,8 @@ var _ = Describe("E2E TEST: githubrepo.branchesHandler", func() {
 				repo:      "scorecard",
 				commitSHA: "de5224bbc56eceb7a25aece55d2d53bbc561ed2d",
 			}
-			brancheshandler.init(context.Background(), repourl)
-			branchRef, err := brancheshandler.query("main")
+			brancheshandler.init(repourl)
+			branchRef, err := brancheshandler.query(context.Background(), "main")
 
 			Expect(err).ShouldNot(BeNil())
 			Expect(branchRef).Should(BeNil())
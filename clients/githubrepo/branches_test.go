@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/ossf/scorecard/v5/clients"
 )
@@ -171,6 +172,10 @@ func withBypass() ruleSetOpt {
 	}
 }
 
+// The settings added with rulesets support are tested in Test_applyRepoRulesSources.
+var ignoreRuleSourceFields = cmpopts.IgnoreFields(clients.BranchProtectionRule{},
+	"RequireSignedCommits", "RequireMergeQueue", "RequiredDeployments", "BypassActors", "Sources")
+
 func Test_applyRepoRules(t *testing.T) {
 	t.Parallel()
 	trueVal := true
@@ -526,8 +531,8 @@ func Test_applyRepoRules(t *testing.T) {
 			t.Parallel()
 			applyRepoRules(testcase.base, testcase.ruleSets)
 
-			if !cmp.Equal(testcase.base, testcase.expected) {
-				diff := cmp.Diff(testcase.base, testcase.expected)
+			if !cmp.Equal(testcase.base, testcase.expected, ignoreRuleSourceFields) {
+				diff := cmp.Diff(testcase.base, testcase.expected, ignoreRuleSourceFields)
 				t.Errorf("test failed: expected - %v, got - %v. \n%s", testcase.expected, testcase.base, diff)
 			}
 		})
@@ -633,10 +638,101 @@ func Test_translationFromGithubAPIBranchProtectionData(t *testing.T) {
 
 			result := getBranchRefFrom(testcase.branch, repoRules)
 
-			if !cmp.Equal(result, testcase.expected) {
-				diff := cmp.Diff(result, testcase.expected)
+			if !cmp.Equal(result, testcase.expected, ignoreRuleSourceFields) {
+				diff := cmp.Diff(result, testcase.expected, ignoreRuleSourceFields)
 				t.Errorf("test failed: expected - %v, got - %v. \n%s", testcase.expected, result, diff)
 			}
 		})
 	}
 }
+
+func Test_applyRepoRulesSources(t *testing.T) {
+	t.Parallel()
+	orgRuleSet := ruleSet(
+		withRules(&repoRule{Type: ruleSignatures}, &repoRule{Type: ruleMergeQueue}),
+		func(r *repoRuleSet) {
+			r.Name = asPtr("org rules")
+			r.Source.Typename = "Organization"
+			r.BypassActors.Nodes = append(r.BypassActors.Nodes,
+				&ruleSetBypass{OrganizationAdmin: asPtr(true), BypassMode: asPtr("ALWAYS")})
+		},
+	)
+	repoRules := ruleSet(
+		withRules(&repoRule{
+			Type: ruleDeployments,
+			Parameters: repoRulesParameters{
+				DeploymentParameters: requiredDeploymentsParameters{
+					RequiredDeploymentEnvironments: []string{"staging"},
+				},
+			},
+		}),
+		func(r *repoRuleSet) {
+			r.Name = asPtr("main")
+			r.BypassActors.Nodes = append(r.BypassActors.Nodes,
+				&ruleSetBypass{RepositoryRoleName: asPtr("maintain"), BypassMode: asPtr("PULL_REQUEST")})
+		},
+	)
+	branchRef := &clients.BranchRef{}
+	applyRepoRules(branchRef, []*repoRuleSet{orgRuleSet, repoRules})
+	addEvaluatedRuleSources(branchRef, []*repoRuleSet{ruleSet(func(r *repoRuleSet) {
+		r.Name = asPtr("trial")
+	})})
+
+	rule := branchRef.BranchProtectionRule
+	if !valueOrZero(rule.RequireSignedCommits) {
+		t.Error("signed commits should be required")
+	}
+	if !valueOrZero(rule.RequireMergeQueue) {
+		t.Error("merge queue should be required")
+	}
+	if diff := cmp.Diff([]string{"staging"}, rule.RequiredDeployments); diff != "" {
+		t.Errorf("RequiredDeployments mismatch (-want +got):\n%s", diff)
+	}
+	wantActors := []clients.BypassActor{
+		{Type: clients.BypassActorOrganizationAdmin, Source: "org rules"},
+		{Type: clients.BypassActorRepositoryRole, Name: "maintain", Source: "main", PullRequestOnly: true},
+	}
+	if diff := cmp.Diff(wantActors, rule.BypassActors); diff != "" {
+		t.Errorf("BypassActors mismatch (-want +got):\n%s", diff)
+	}
+	wantSources := []clients.RuleSource{
+		{Name: "org rules", Type: clients.RuleSourceOrganizationRuleset},
+		{Name: "main", Type: clients.RuleSourceRepositoryRuleset},
+		{Name: "trial", Type: clients.RuleSourceRepositoryRuleset, Evaluate: true},
+	}
+	if diff := cmp.Diff(wantSources, rule.Sources); diff != "" {
+		t.Errorf("Sources mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_branchProtectionRuleSources(t *testing.T) {
+	t.Parallel()
+	rule := &branchProtectionRule{
+		Pattern:                        asPtr("main"),
+		RequiresCommitSignatures:       asPtr(true),
+		RequiresDeployments:            asPtr(true),
+		RequiredDeploymentEnvironments: []string{"production"},
+	}
+	app := &bypassAllowance{}
+	app.Actor.Typename = "App"
+	app.Actor.App.Name = asPtr("release-bot")
+	rule.BypassPullRequestAllowances.Nodes = []*bypassAllowance{app}
+	rule.BypassForcePushAllowances.Nodes = []*bypassAllowance{app}
+
+	branchRef := getBranchRefFrom(&branch{Name: asPtr("main"), BranchProtectionRule: rule}, nil)
+	got := branchRef.BranchProtectionRule
+	if !valueOrZero(got.RequireSignedCommits) {
+		t.Error("signed commits should be required")
+	}
+	if diff := cmp.Diff([]string{"production"}, got.RequiredDeployments); diff != "" {
+		t.Errorf("RequiredDeployments mismatch (-want +got):\n%s", diff)
+	}
+	wantActors := []clients.BypassActor{{Type: clients.BypassActorApp, Name: "release-bot", Source: "main"}}
+	if diff := cmp.Diff(wantActors, got.BypassActors); diff != "" {
+		t.Errorf("BypassActors mismatch (-want +got):\n%s", diff)
+	}
+	wantSources := []clients.RuleSource{{Name: "main", Type: clients.RuleSourceBranchProtection}}
+	if diff := cmp.Diff(wantSources, got.Sources); diff != "" {
+		t.Errorf("Sources mismatch (-want +got):\n%s", diff)
+	}
+}
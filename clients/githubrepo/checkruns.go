@@ -75,14 +75,12 @@ type checkrunsHandler struct {
 	logger         *log.Logger
 	checkData      *checkRunsGraphqlData
 	setupOnce      *sync.Once
-	ctx            context.Context
 	commitDepth    int
 	checkRunsByRef checkRunsByRef
 	errSetup       error
 }
 
-func (handler *checkrunsHandler) init(ctx context.Context, repourl *Repo, commitDepth int) {
-	handler.ctx = ctx
+func (handler *checkrunsHandler) init(repourl *Repo, commitDepth int) {
 	handler.repourl = repourl
 	handler.commitDepth = commitDepth
 	handler.logger = log.NewLogger(log.DefaultLevel)
@@ -92,7 +90,7 @@ func (handler *checkrunsHandler) init(ctx context.Context, repourl *Repo, commit
 	handler.errSetup = nil
 }
 
-func (handler *checkrunsHandler) setup() error {
+func (handler *checkrunsHandler) setup(ctx context.Context) error {
 	handler.setupOnce.Do(func() {
 		handler.errSetup = nil
 		commitExpression := handler.repourl.commitExpression()
@@ -110,7 +108,7 @@ func (handler *checkrunsHandler) setup() error {
 		if handler.commitDepth > 99 {
 			vars["commitsToAnalyze"] = githubv4.Int(99)
 		}
-		if err := handler.graphClient.Query(handler.ctx, handler.checkData, vars); err != nil {
+		if err := handler.graphClient.Query(ctx, handler.checkData, vars); err != nil {
 			// quit early without setting crsErrSetup for "Resource not accessible by integration" error
 			// for whatever reason, this check doesn't work with a GITHUB_TOKEN, only a PAT
 			if strings.Contains(err.Error(), "Resource not accessible by integration") {
@@ -124,8 +122,8 @@ func (handler *checkrunsHandler) setup() error {
 	return handler.errSetup
 }
 
-func (handler *checkrunsHandler) listCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
-	if err := handler.setup(); err != nil {
+func (handler *checkrunsHandler) listCheckRunsForRef(ctx context.Context, ref string) ([]clients.CheckRun, error) {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during graphqlHandler.setupCheckRuns: %w", err)
 	}
 	if crs, ok := handler.checkRunsByRef[ref]; ok {
@@ -135,7 +133,7 @@ func (handler *checkrunsHandler) listCheckRunsForRef(ref string) ([]clients.Chec
 	handler.logger.Info(msg)
 
 	checkRuns, _, err := handler.client.Checks.ListCheckRunsForRef(
-		handler.ctx, handler.repourl.owner, handler.repourl.repo, ref, &github.ListCheckRunsOptions{})
+		ctx, handler.repourl.owner, handler.repourl.repo, ref, &github.ListCheckRunsOptions{})
 	if err != nil {
 		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("ListCheckRunsForRef: %v", err))
 	}
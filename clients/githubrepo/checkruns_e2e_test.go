@@ -45,8 +45,8 @@ var _ = Describe("E2E TEST: githubrepo.checkrunsHandler", func() {
 				repo:      "scorecard",
 				commitSHA: clients.HeadSHA,
 			}
-			checkrunshandler.init(context.Background(), repourl, 30)
-			Expect(checkrunshandler.setup()).Should(BeNil())
+			checkrunshandler.init(repourl, 30)
+			Expect(checkrunshandler.setup(context.Background())).Should(BeNil())
 			Expect(checkrunshandler.checkData).ShouldNot(BeNil())
 			Expect(checkrunshandler.checkData.RateLimit.Cost).ShouldNot(BeNil())
 			Expect(*checkrunshandler.checkData.RateLimit.Cost).Should(BeNumerically("<=", 1))
@@ -63,12 +63,12 @@ var _ = Describe("E2E TEST: githubrepo.checkrunsHandler", func() {
 			httpClient := &http.Client{
 				Transport: rt,
 			}
-			checkrunshandler.init(context.Background(), repourl, 30)
+			checkrunshandler.init(repourl, 30)
 			checkrunshandler.client = github.NewClient(httpClient)
-			Expect(checkrunshandler.setup()).Should(BeNil())
+			Expect(checkrunshandler.setup(context.Background())).Should(BeNil())
 			Expect(checkrunshandler.checkData).ShouldNot(BeNil())
 
-			checkRuns, err := checkrunshandler.listCheckRunsForRef("main")
+			checkRuns, err := checkrunshandler.listCheckRunsForRef(context.Background(), "main")
 			Expect(err).Should(BeNil())
 			Expect(checkRuns).ShouldNot(BeNil())
 			Expect(len(checkRuns)).Should(BeNumerically(">", 0))
@@ -80,14 +80,14 @@ var _ = Describe("E2E TEST: githubrepo.checkrunsHandler", func() {
 			repo:      "scorecard",
 			commitSHA: clients.HeadSHA,
 		}
-		checkrunshandler.init(context.Background(), repourl, 30)
-		Expect(checkrunshandler.setup()).Should(BeNil())
+		checkrunshandler.init(repourl, 30)
+		Expect(checkrunshandler.setup(context.Background())).Should(BeNil())
 		rt := roundtripper.NewTransport(context.Background(), &log.Logger{})
 		httpClient := &http.Client{
 			Transport: rt,
 		}
 		checkrunshandler.client = github.NewClient(httpClient)
-		checkRuns, err := checkrunshandler.listCheckRunsForRef("invalid-ref")
+		checkRuns, err := checkrunshandler.listCheckRunsForRef(context.Background(), "invalid-ref")
 		Expect(err).ShouldNot(BeNil())
 		Expect(checkRuns).Should(BeNil())
 	})
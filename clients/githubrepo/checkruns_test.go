@@ -15,7 +15,6 @@
 package githubrepo
 
 import (
-	"context"
 	"testing"
 
 	sce "github.com/ossf/scorecard/v5/errors"
@@ -24,7 +23,7 @@ import (
 func Test_init_clearsErr(t *testing.T) {
 	t.Parallel()
 	handler := &checkrunsHandler{errSetup: sce.ErrScorecardInternal}
-	handler.init(context.Background(), nil, 0)
+	handler.init(nil, 0)
 	if handler.errSetup != nil {
 		t.Errorf("expected nil error, got %v", handler.errSetup)
 	}
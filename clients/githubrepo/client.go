@@ -62,7 +62,7 @@ type Client struct {
 	licenses      *licensesHandler
 	git           *gitfile.Handler
 	ctx           context.Context
-	tarball       tarballHandler
+	tarball       *tarballHandler
 	commitDepth   int
 	gitMode       bool
 }
@@ -118,44 +118,44 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 		client.git.Init(client.ctx, client.repo.GetCloneURL(), commitSHA)
 	} else {
 		// Init tarballHandler.
-		client.tarball.init(client.ctx, client.repo, commitSHA)
+		client.tarball.init(client.repo, commitSHA)
 	}
 
 	// Setup GraphQL.
-	client.graphClient.init(client.ctx, client.repourl, client.commitDepth)
+	client.graphClient.init(client.repourl, client.commitDepth)
 
 	// Setup contributorsHandler.
-	client.contributors.init(client.ctx, client.repourl)
+	client.contributors.init(client.repourl)
 
 	// Setup branchesHandler.
-	client.branches.init(client.ctx, client.repourl)
+	client.branches.init(client.repourl)
 
 	// Setup releasesHandler.
-	client.releases.init(client.ctx, client.repourl)
+	client.releases.init(client.repourl)
 
 	// Setup workflowsHandler.
-	client.workflows.init(client.ctx, client.repourl)
+	client.workflows.init(client.repourl)
 
 	// Setup checkrunsHandler.
-	client.checkruns.init(client.ctx, client.repourl, client.commitDepth)
+	client.checkruns.init(client.repourl, client.commitDepth)
 
 	// Setup statusesHandler.
-	client.statuses.init(client.ctx, client.repourl)
+	client.statuses.init(client.repourl)
 
 	// Setup searchHandler.
-	client.search.init(client.ctx, client.repourl)
+	client.search.init(client.repourl)
 
 	// Setup searchCommitsHandler
-	client.searchCommits.init(client.ctx, client.repourl)
+	client.searchCommits.init(client.repourl)
 
 	// Setup webhookHandler.
-	client.webhook.init(client.ctx, client.repourl)
+	client.webhook.init(client.repourl)
 
 	// Setup languagesHandler.
-	client.languages.init(client.ctx, client.repourl)
+	client.languages.init(client.repourl)
 
 	// Setup licensesHandler.
-	client.licenses.init(client.ctx, client.repourl)
+	client.licenses.init(client.repourl)
 
 	return nil
 }
@@ -178,7 +178,7 @@ func (client *Client) LocalPath() (string, error) {
 		}
 		return path, nil
 	}
-	return client.tarball.getLocalPath()
+	return client.tarball.getLocalPath(client.ctx)
 }
 
 // ListFiles implements RepoClient.ListFiles.
@@ -190,7 +190,7 @@ func (client *Client) ListFiles(predicate func(string) (bool, error)) ([]string,
 		}
 		return files, nil
 	}
-	return client.tarball.listFiles(predicate)
+	return client.tarball.listFiles(client.ctx, predicate)
 }
 
 // GetFileReader implements RepoClient.GetFileReader.
@@ -202,38 +202,38 @@ func (client *Client) GetFileReader(filename string) (io.ReadCloser, error) {
 		}
 		return f, nil
 	}
-	return client.tarball.getFile(filename)
+	return client.tarball.getFile(client.ctx, filename)
 }
 
 // ListCommits implements RepoClient.ListCommits.
 func (client *Client) ListCommits() ([]clients.Commit, error) {
-	return client.graphClient.getCommits()
+	return client.graphClient.getCommits(client.ctx)
 }
 
 // ListIssues implements RepoClient.ListIssues.
 func (client *Client) ListIssues() ([]clients.Issue, error) {
 	// here you would need to pass commitDepth or something
-	return client.graphClient.getIssues()
+	return client.graphClient.getIssues(client.ctx)
 }
 
 // ListReleases implements RepoClient.ListReleases.
 func (client *Client) ListReleases() ([]clients.Release, error) {
-	return client.releases.getReleases()
+	return client.releases.getReleases(client.ctx)
 }
 
 // ListContributors implements RepoClient.ListContributors.
 func (client *Client) ListContributors() ([]clients.User, error) {
-	return client.contributors.getContributors()
+	return client.contributors.getContributors(client.ctx)
 }
 
 // IsArchived implements RepoClient.IsArchived.
 func (client *Client) IsArchived() (bool, error) {
-	return client.graphClient.isArchived()
+	return client.graphClient.isArchived(client.ctx)
 }
 
 // GetDefaultBranch implements RepoClient.GetDefaultBranch.
 func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
-	return client.branches.getDefaultBranch()
+	return client.branches.getDefaultBranch(client.ctx)
 }
 
 // GetDefaultBranchName implements RepoClient.GetDefaultBranchName.
@@ -247,7 +247,7 @@ func (client *Client) GetDefaultBranchName() (string, error) {
 
 // GetBranch implements RepoClient.GetBranch.
 func (client *Client) GetBranch(branch string) (*clients.BranchRef, error) {
-	return client.branches.getBranch(branch)
+	return client.branches.getBranch(client.ctx, branch)
 }
 
 // GetCreatedAt is a getter for repo.CreatedAt.
@@ -276,44 +276,59 @@ func (client *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient,
 	return c, nil
 }
 
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled.
+func (client *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return isPrivateReportingEnabled(client.ctx, client.repoClient, client.repourl)
+}
+
+// IsPrivate implements RepoClient.IsPrivate.
+func (client *Client) IsPrivate() (bool, error) {
+	return client.repo.GetPrivate() || client.repo.GetVisibility() == "internal", nil
+}
+
+// ListPullRequests implements RepoClient.ListPullRequests.
+func (client *Client) ListPullRequests() ([]clients.PullRequest, error) {
+	return client.graphClient.getPullRequests(client.ctx)
+}
+
 // ListWebhooks implements RepoClient.ListWebhooks.
 func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
-	return client.webhook.listWebhooks()
+	return client.webhook.listWebhooks(client.ctx)
 }
 
 // ListSuccessfulWorkflowRuns implements RepoClient.WorkflowRunsByFilename.
 func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
-	return client.workflows.listSuccessfulWorkflowRuns(filename)
+	return client.workflows.listSuccessfulWorkflowRuns(client.ctx, filename)
 }
 
 // ListCheckRunsForRef implements RepoClient.ListCheckRunsForRef.
 func (client *Client) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
-	return client.checkruns.listCheckRunsForRef(ref)
+	return client.checkruns.listCheckRunsForRef(client.ctx, ref)
 }
 
 // ListStatuses implements RepoClient.ListStatuses.
 func (client *Client) ListStatuses(ref string) ([]clients.Status, error) {
-	return client.statuses.listStatuses(ref)
+	return client.statuses.listStatuses(client.ctx, ref)
 }
 
 // ListProgrammingLanguages implements RepoClient.ListProgrammingLanguages.
 func (client *Client) ListProgrammingLanguages() ([]clients.Language, error) {
-	return client.languages.listProgrammingLanguages()
+	return client.languages.listProgrammingLanguages(client.ctx)
 }
 
 // ListLicenses implements RepoClient.ListLicenses.
 func (client *Client) ListLicenses() ([]clients.License, error) {
-	return client.licenses.listLicenses()
+	return client.licenses.listLicenses(client.ctx)
 }
 
 // Search implements RepoClient.Search.
 func (client *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
-	return client.search.search(request)
+	return client.search.search(client.ctx, request)
 }
 
 // SearchCommits implements RepoClient.SearchCommits.
 func (client *Client) SearchCommits(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
-	return client.searchCommits.search(request)
+	return client.searchCommits.search(client.ctx, request)
 }
 
 // Close implements RepoClient.Close.
@@ -327,6 +342,29 @@ func (client *Client) Close() error {
 	return client.tarball.cleanup()
 }
 
+// WithContext returns a copy of client which makes its API calls with the values
+// of ctx, e.g. the span of a traced RepoClient call, but keeps the deadline and
+// cancellation of the client's own context.
+// The copy shares the fetched data of client and must not be re-initialized.
+func (client *Client) WithContext(ctx context.Context) clients.RepoClient {
+	c := *client
+	c.ctx = valuesContext{Context: client.ctx, values: ctx}
+	return &c
+}
+
+// valuesContext looks up values in values before the embedded Context.
+type valuesContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c valuesContext) Value(key any) any {
+	if v := c.values.Value(key); v != nil {
+		return v
+	}
+	return c.Context.Value(key)
+}
+
 // CreateGithubRepoClientWithTransport returns a Client which implements RepoClient interface.
 func CreateGithubRepoClientWithTransport(ctx context.Context, rt http.RoundTripper) clients.RepoClient {
 	//nolint:errcheck // need to suppress because this method doesn't return an error
@@ -415,7 +453,7 @@ func NewRepoClient(ctx context.Context, opts ...Option) (clients.RepoClient, err
 		licenses: &licensesHandler{
 			ghclient: client,
 		},
-		tarball: tarballHandler{
+		tarball: &tarballHandler{
 			httpClient: httpClient,
 		},
 		gitMode: config.gitMode,
@@ -0,0 +1,62 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// contextTripper records the context of the last request.
+type contextTripper struct {
+	stubTripper
+	ctx context.Context
+}
+
+func (c *contextTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.ctx = r.Context()
+	return c.stubTripper.RoundTrip(r)
+}
+
+func TestClient_WithContext(t *testing.T) {
+	t.Parallel()
+	type key struct{}
+	clientCtx, cancel := context.WithCancel(context.Background())
+	rt := &contextTripper{stubTripper: stubTripper{responsePath: "./testdata/valid-webhook.json"}}
+	rc, err := NewRepoClient(clientCtx, WithRoundTripper(rt))
+	if err != nil {
+		t.Fatalf("NewRepoClient: %v", err)
+	}
+	client, ok := rc.(*Client)
+	if !ok {
+		t.Fatalf("NewRepoClient returned %T", rc)
+	}
+	client.webhook.init(&Repo{owner: "ossf-tests", repo: "foo", commitSHA: clients.HeadSHA})
+
+	callCtx := context.WithValue(context.Background(), key{}, "call")
+	if _, err := client.WithContext(callCtx).ListWebhooks(); err != nil {
+		t.Fatalf("ListWebhooks: %v", err)
+	}
+	if got := rt.ctx.Value(key{}); got != "call" {
+		t.Errorf("request context value = %v, want the value of the call context", got)
+	}
+	cancel()
+	if rt.ctx.Err() == nil {
+		t.Errorf("request context should be cancelled with the client's context")
+	}
+}
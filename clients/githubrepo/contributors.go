@@ -28,28 +28,26 @@ import (
 type contributorsHandler struct {
 	ghClient     *github.Client
 	once         *sync.Once
-	ctx          context.Context
 	errSetup     error
 	repourl      *Repo
 	contributors []clients.User
 }
 
-func (handler *contributorsHandler) init(ctx context.Context, repourl *Repo) {
-	handler.ctx = ctx
+func (handler *contributorsHandler) init(repourl *Repo) {
 	handler.repourl = repourl
 	handler.errSetup = nil
 	handler.once = new(sync.Once)
 	handler.contributors = nil
 }
 
-func (handler *contributorsHandler) setup() error {
+func (handler *contributorsHandler) setup(ctx context.Context) error {
 	handler.once.Do(func() {
 		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
 			handler.errSetup = fmt.Errorf("%w: ListContributors only supported for HEAD queries", clients.ErrUnsupportedFeature)
 			return
 		}
 		contribs, _, err := handler.ghClient.Repositories.ListContributors(
-			handler.ctx, handler.repourl.owner, handler.repourl.repo, &github.ListContributorsOptions{})
+			ctx, handler.repourl.owner, handler.repourl.repo, &github.ListContributorsOptions{})
 		if err != nil {
 			handler.errSetup = fmt.Errorf("error during ListContributors: %w", err)
 			return
@@ -63,7 +61,7 @@ func (handler *contributorsHandler) setup() error {
 				NumContributions: contrib.GetContributions(),
 				Login:            contrib.GetLogin(),
 			}
-			orgs, _, err := handler.ghClient.Organizations.List(handler.ctx, contrib.GetLogin(), nil)
+			orgs, _, err := handler.ghClient.Organizations.List(ctx, contrib.GetLogin(), nil)
 			// This call can fail due to token scopes. So ignore error.
 			if err == nil {
 				for _, org := range orgs {
@@ -72,7 +70,7 @@ func (handler *contributorsHandler) setup() error {
 					})
 				}
 			}
-			user, _, err := handler.ghClient.Users.Get(handler.ctx, contrib.GetLogin())
+			user, _, err := handler.ghClient.Users.Get(ctx, contrib.GetLogin())
 			if err != nil {
 				handler.errSetup = fmt.Errorf("error during Users.Get: %w", err)
 			}
@@ -84,8 +82,8 @@ func (handler *contributorsHandler) setup() error {
 	return handler.errSetup
 }
 
-func (handler *contributorsHandler) getContributors() ([]clients.User, error) {
-	if err := handler.setup(); err != nil {
+func (handler *contributorsHandler) getContributors(ctx context.Context) ([]clients.User, error) {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during contributorsHandler.setup: %w", err)
 	}
 	return handler.contributors, nil
@@ -31,7 +31,6 @@ var _ = Describe("E2E TEST: githubrepo.contributorsHandler", func() {
 	var contribHandler *contributorsHandler
 
 	BeforeEach(func() {
-		ctx := context.Background()
 		rt := roundtripper.NewTransport(context.Background(), &log.Logger{})
 		httpClient := &http.Client{
 			Transport: rt,
@@ -39,7 +38,6 @@ var _ = Describe("E2E TEST: githubrepo.contributorsHandler", func() {
 		client := github.NewClient(httpClient)
 		contribHandler = &contributorsHandler{
 			ghClient: client,
-			ctx:      ctx,
 		}
 	})
 	Context("getContributors()", func() {
@@ -51,8 +49,8 @@ var _ = Describe("E2E TEST: githubrepo.contributorsHandler", func() {
 				commitSHA: clients.HeadSHA,
 			}
 
-			contribHandler.init(context.Background(), &repoURL)
-			Expect(contribHandler.getContributors()).ShouldNot(BeNil())
+			contribHandler.init(&repoURL)
+			Expect(contribHandler.getContributors(context.Background())).ShouldNot(BeNil())
 			Expect(contribHandler.errSetup).Should(BeNil())
 		})
 	})
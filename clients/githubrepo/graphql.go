@@ -28,12 +28,13 @@ import (
 )
 
 const (
-	pullRequestsToAnalyze  = 1
-	checksToAnalyze        = 30
-	issuesToAnalyze        = 30
-	issueCommentsToAnalyze = 30
-	reviewsToAnalyze       = 30
-	labelsToAnalyze        = 30
+	pullRequestsToAnalyze       = 1
+	checksToAnalyze             = 30
+	issuesToAnalyze             = 30
+	issueCommentsToAnalyze      = 30
+	recentPullRequestsToAnalyze = 30
+	reviewsToAnalyze            = 30
+	labelsToAnalyze             = 30
 
 	// https://docs.github.com/en/graphql/overview/rate-limits-and-node-limits-for-the-graphql-api#node-limit
 	defaultPageLimit = 100
@@ -78,19 +79,27 @@ type graphqlData struct {
 									Login        githubv4.String
 									ResourcePath githubv4.String
 								}
-								Number     githubv4.Int
-								HeadRefOid githubv4.String
-								MergedAt   githubv4.DateTime
-								Labels     struct {
+								Number         githubv4.Int
+								HeadRefOid     githubv4.String
+								MergedAt       githubv4.DateTime
+								CreatedAt      githubv4.DateTime
+								ReviewDecision *githubv4.String
+								Labels         struct {
 									Nodes []struct {
 										Name githubv4.String
 									}
 								} `graphql:"labels(last: $labelsToAnalyze)"`
 								Reviews struct {
 									Nodes []struct {
-										State  githubv4.String
-										Author struct {
-											Login githubv4.String
+										State             githubv4.String
+										SubmittedAt       *githubv4.DateTime
+										AuthorAssociation *string
+										Author            struct {
+											Login        githubv4.String
+											ResourcePath githubv4.String
+										}
+										Commit struct {
+											Oid githubv4.String
 										}
 									}
 								} `graphql:"reviews(last: $reviewsToAnalyze)"`
@@ -116,16 +125,15 @@ type graphqlData struct {
 				Author            struct {
 					Login githubv4.String
 				}
-				CreatedAt *time.Time
-				Comments  struct {
+				CreatedAt     *time.Time
+				ClosedAt      *time.Time
+				Comments      graphqlComments `graphql:"comments(last: $issueCommentsToAnalyze)"`
+				FirstComments graphqlComments `graphql:"firstComments: comments(first: $issueCommentsToAnalyze)"`
+				Labels        struct {
 					Nodes []struct {
-						AuthorAssociation *string
-						CreatedAt         *time.Time
-						Author            struct {
-							Login githubv4.String
-						}
+						Name githubv4.String
 					}
-				} `graphql:"comments(last: $issueCommentsToAnalyze)"`
+				} `graphql:"labels(last: $labelsToAnalyze)"`
 			}
 		} `graphql:"issues(first: $issuesToAnalyze, orderBy:{field:UPDATED_AT, direction:DESC})"`
 	} `graphql:"repository(owner: $owner, name: $name)"`
@@ -134,31 +142,85 @@ type graphqlData struct {
 	}
 }
 
+// graphqlComments are comments on an issue or a pull request.
+type graphqlComments struct {
+	Nodes []struct {
+		ID                githubv4.ID
+		AuthorAssociation *string
+		CreatedAt         *time.Time
+		Author            struct {
+			Login githubv4.String
+		}
+	}
+}
+
+// graphqlPullRequestsData is queried separately from graphqlData, only by
+// the checks listing the recent pull requests.
+//
+//nolint:govet
+type graphqlPullRequestsData struct {
+	Repository struct {
+		PullRequests struct {
+			Nodes []struct {
+				//nolint:revive,stylecheck // naming according to githubv4 convention.
+				Url               githubv4.String
+				Number            githubv4.Int
+				State             githubv4.String
+				CreatedAt         githubv4.DateTime
+				ClosedAt          *githubv4.DateTime
+				MergedAt          *githubv4.DateTime
+				AuthorAssociation *string
+				Author            struct {
+					Login        githubv4.String
+					ResourcePath githubv4.String
+				}
+				Comments      graphqlComments `graphql:"comments(last: $issueCommentsToAnalyze)"`
+				FirstComments graphqlComments `graphql:"firstComments: comments(first: $issueCommentsToAnalyze)"`
+				Reviews       struct {
+					Nodes []struct {
+						State             githubv4.String
+						SubmittedAt       *githubv4.DateTime
+						AuthorAssociation *string
+						Author            struct {
+							Login        githubv4.String
+							ResourcePath githubv4.String
+						}
+					}
+				} `graphql:"reviews(first: $reviewsToAnalyze)"`
+			}
+		} `graphql:"pullRequests(first: $recentPullRequestsToAnalyze, orderBy:{field:CREATED_AT, direction:DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
 type graphqlHandler struct {
 	client      *githubv4.Client
 	data        *graphqlData
 	setupOnce   *sync.Once
-	ctx         context.Context
 	errSetup    error
+	prsOnce     *sync.Once
+	errPRs      error
 	repourl     *Repo
 	commits     []clients.Commit
 	issues      []clients.Issue
+	prs         []clients.PullRequest
 	archived    bool
 	commitDepth int
 }
 
-func (handler *graphqlHandler) init(ctx context.Context, repourl *Repo, commitDepth int) {
-	handler.ctx = ctx
+func (handler *graphqlHandler) init(repourl *Repo, commitDepth int) {
 	handler.repourl = repourl
 	handler.data = new(graphqlData)
 	handler.errSetup = nil
 	handler.setupOnce = new(sync.Once)
+	handler.prsOnce = new(sync.Once)
+	handler.errPRs = nil
 	handler.commitDepth = commitDepth
 	handler.commits = nil
 	handler.issues = nil
+	handler.prs = nil
 }
 
-func populateCommits(handler *graphqlHandler, vars map[string]interface{}) ([]clients.Commit, error) {
+func populateCommits(ctx context.Context, handler *graphqlHandler, vars map[string]interface{}) ([]clients.Commit, error) {
 	var commits []clients.Commit
 	commitsLeft, ok := vars["commitsToAnalyze"].(githubv4.Int)
 	if !ok {
@@ -168,7 +230,7 @@ func populateCommits(handler *graphqlHandler, vars map[string]interface{}) ([]cl
 	var retries int
 	for commitsLeft > 0 {
 		vars["commitsToAnalyze"] = commitsRequested
-		if err := handler.client.Query(handler.ctx, handler.data, vars); err != nil {
+		if err := handler.client.Query(ctx, handler.data, vars); err != nil {
 			// 502 usually indicate timeouts, where we're requesting too much data
 			// so make our requests smaller and try again
 			if retries < retryLimit && strings.Contains(err.Error(), "502 Bad Gateway") {
@@ -190,7 +252,7 @@ func populateCommits(handler *graphqlHandler, vars map[string]interface{}) ([]cl
 	return commits, nil
 }
 
-func (handler *graphqlHandler) setup() error {
+func (handler *graphqlHandler) setup(ctx context.Context) error {
 	handler.setupOnce.Do(func() {
 		commitExpression := handler.repourl.commitExpression()
 		vars := map[string]interface{}{
@@ -205,35 +267,60 @@ func (handler *graphqlHandler) setup() error {
 			"commitExpression":       githubv4.String(commitExpression),
 			"historyCursor":          (*githubv4.String)(nil),
 		}
-		handler.commits, handler.errSetup = populateCommits(handler, vars)
+		handler.commits, handler.errSetup = populateCommits(ctx, handler, vars)
 		handler.issues = issuesFrom(handler.data)
 		handler.archived = bool(handler.data.Repository.IsArchived)
 	})
 	return handler.errSetup
 }
 
-func (handler *graphqlHandler) getCommits() ([]clients.Commit, error) {
-	if err := handler.setup(); err != nil {
+func (handler *graphqlHandler) getCommits(ctx context.Context) ([]clients.Commit, error) {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
 	}
 	return handler.commits, nil
 }
 
-func (handler *graphqlHandler) getIssues() ([]clients.Issue, error) {
+func (handler *graphqlHandler) getIssues(ctx context.Context) ([]clients.Issue, error) {
 	if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
 		return nil, fmt.Errorf("%w: ListIssues only supported for HEAD queries", clients.ErrUnsupportedFeature)
 	}
-	if err := handler.setup(); err != nil {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
 	}
 	return handler.issues, nil
 }
 
-func (handler *graphqlHandler) isArchived() (bool, error) {
+func (handler *graphqlHandler) getPullRequests(ctx context.Context) ([]clients.PullRequest, error) {
+	if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+		return nil, fmt.Errorf("%w: ListPullRequests only supported for HEAD queries", clients.ErrUnsupportedFeature)
+	}
+	handler.prsOnce.Do(func() {
+		vars := map[string]interface{}{
+			"owner":                       githubv4.String(handler.repourl.owner),
+			"name":                        githubv4.String(handler.repourl.repo),
+			"issueCommentsToAnalyze":      githubv4.Int(issueCommentsToAnalyze),
+			"recentPullRequestsToAnalyze": githubv4.Int(recentPullRequestsToAnalyze),
+			"reviewsToAnalyze":            githubv4.Int(reviewsToAnalyze),
+		}
+		data := new(graphqlPullRequestsData)
+		if err := handler.client.Query(ctx, data, vars); err != nil {
+			handler.errPRs = sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
+			return
+		}
+		handler.prs = pullRequestsFrom(data)
+	})
+	if handler.errPRs != nil {
+		return nil, handler.errPRs
+	}
+	return handler.prs, nil
+}
+
+func (handler *graphqlHandler) isArchived(ctx context.Context) (bool, error) {
 	if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
 		return false, fmt.Errorf("%w: IsArchived only supported for HEAD queries", clients.ErrUnsupportedFeature)
 	}
-	if err := handler.setup(); err != nil {
+	if err := handler.setup(ctx); err != nil {
 		return false, fmt.Errorf("error during graphqlHandler.setup: %w", err)
 	}
 	return handler.archived, nil
@@ -279,6 +366,10 @@ func commitsFrom(data *graphqlData, repoOwner, repoName string) ([]clients.Commi
 				MergedBy: clients.User{
 					Login: string(pr.MergedBy.Login),
 				},
+				CreatedAt: pr.CreatedAt.Time,
+			}
+			if pr.ReviewDecision != nil {
+				associatedPR.ReviewDecision = string(*pr.ReviewDecision)
 			}
 			for _, label := range pr.Labels.Nodes {
 				associatedPR.Labels = append(associatedPR.Labels, clients.Label{
@@ -286,12 +377,19 @@ func commitsFrom(data *graphqlData, repoOwner, repoName string) ([]clients.Commi
 				})
 			}
 			for _, review := range pr.Reviews.Nodes {
-				associatedPR.Reviews = append(associatedPR.Reviews, clients.Review{
+				r := clients.Review{
 					State: string(review.State),
 					Author: &clients.User{
 						Login: string(review.Author.Login),
+						IsBot: strings.HasPrefix(string(review.Author.ResourcePath), "/apps/"),
 					},
-				})
+					CommitSHA:         string(review.Commit.Oid),
+					AuthorAssociation: getRepoAssociation(review.AuthorAssociation),
+				}
+				if review.SubmittedAt != nil {
+					r.SubmittedAt = review.SubmittedAt.Time
+				}
+				associatedPR.Reviews = append(associatedPR.Reviews, r)
 			}
 			break
 		}
@@ -302,6 +400,10 @@ func commitsFrom(data *graphqlData, repoOwner, repoName string) ([]clients.Commi
 			Committer: clients.User{
 				Login: committer,
 			},
+			Author: clients.User{
+				Login: string(commit.Author.User.Login),
+				IsBot: strings.HasSuffix(string(commit.Author.User.Login), "[bot]"),
+			},
 			AssociatedMergeRequest: associatedPR,
 		})
 	}
@@ -315,12 +417,36 @@ func issuesFrom(data *graphqlData) []clients.Issue {
 		copyStringPtr(issue.Url, &tmpIssue.URI)
 		copyRepoAssociationPtr(getRepoAssociation(issue.AuthorAssociation), &tmpIssue.AuthorAssociation)
 		copyTimePtr(issue.CreatedAt, &tmpIssue.CreatedAt)
+		copyTimePtr(issue.ClosedAt, &tmpIssue.ClosedAt)
 		if issue.Author.Login != "" {
 			tmpIssue.Author = &clients.User{
 				Login: string(issue.Author.Login),
 			}
 		}
-		for _, comment := range issue.Comments.Nodes {
+		tmpIssue.Comments = commentsFrom(&issue.FirstComments, &issue.Comments)
+		for _, label := range issue.Labels.Nodes {
+			tmpIssue.Labels = append(tmpIssue.Labels, clients.Label{
+				Name: string(label.Name),
+			})
+		}
+		ret = append(ret, tmpIssue)
+	}
+	return ret
+}
+
+// commentsFrom returns the first comments followed by the last comments which
+// aren't among the first ones.
+func commentsFrom(first, last *graphqlComments) []clients.IssueComment {
+	var ret []clients.IssueComment
+	seen := make(map[githubv4.ID]bool, len(first.Nodes))
+	for _, comments := range []*graphqlComments{first, last} {
+		for _, comment := range comments.Nodes {
+			if comment.ID != nil {
+				if seen[comment.ID] {
+					continue
+				}
+				seen[comment.ID] = true
+			}
 			var tmpComment clients.IssueComment
 			copyRepoAssociationPtr(getRepoAssociation(comment.AuthorAssociation), &tmpComment.AuthorAssociation)
 			copyTimePtr(comment.CreatedAt, &tmpComment.CreatedAt)
@@ -329,9 +455,49 @@ func issuesFrom(data *graphqlData) []clients.Issue {
 					Login: string(comment.Author.Login),
 				}
 			}
-			tmpIssue.Comments = append(tmpIssue.Comments, tmpComment)
+			ret = append(ret, tmpComment)
 		}
-		ret = append(ret, tmpIssue)
+	}
+	return ret
+}
+
+func pullRequestsFrom(data *graphqlPullRequestsData) []clients.PullRequest {
+	var ret []clients.PullRequest
+	for i := range data.Repository.PullRequests.Nodes {
+		pr := &data.Repository.PullRequests.Nodes[i]
+		tmpPR := clients.PullRequest{
+			Number:    int(pr.Number),
+			URI:       string(pr.Url),
+			State:     string(pr.State),
+			CreatedAt: pr.CreatedAt.Time,
+			Author: clients.User{
+				Login: string(pr.Author.Login),
+				IsBot: strings.HasPrefix(string(pr.Author.ResourcePath), "/apps/"),
+			},
+			AuthorAssociation: getRepoAssociation(pr.AuthorAssociation),
+		}
+		if pr.ClosedAt != nil {
+			tmpPR.ClosedAt = pr.ClosedAt.Time
+		}
+		if pr.MergedAt != nil {
+			tmpPR.MergedAt = pr.MergedAt.Time
+		}
+		tmpPR.Comments = commentsFrom(&pr.FirstComments, &pr.Comments)
+		for _, review := range pr.Reviews.Nodes {
+			r := clients.Review{
+				State: string(review.State),
+				Author: &clients.User{
+					Login: string(review.Author.Login),
+					IsBot: strings.HasPrefix(string(review.Author.ResourcePath), "/apps/"),
+				},
+				AuthorAssociation: getRepoAssociation(review.AuthorAssociation),
+			}
+			if review.SubmittedAt != nil {
+				r.SubmittedAt = review.SubmittedAt.Time
+			}
+			tmpPR.Reviews = append(tmpPR.Reviews, r)
+		}
+		ret = append(ret, tmpPR)
 	}
 	return ret
 }
@@ -65,8 +65,8 @@ var _ = Describe("E2E TEST: githubrepo.graphqlHandler", func() {
 			handler := &graphqlHandler{
 				client: graphClient,
 			}
-			handler.init(context.Background(), repourl, 1)
-			commits, err := populateCommits(handler, vars)
+			handler.init(repourl, 1)
+			commits, err := populateCommits(ctx, handler, vars)
 			Expect(err).To(BeNil())
 			Expect(len(commits)).Should(BeEquivalentTo(1))
 		})
@@ -98,8 +98,8 @@ var _ = Describe("E2E TEST: githubrepo.graphqlHandler", func() {
 			handler := &graphqlHandler{
 				client: graphClient,
 			}
-			handler.init(context.Background(), repourl, 30)
-			commits, err := populateCommits(handler, vars)
+			handler.init(repourl, 30)
+			commits, err := populateCommits(ctx, handler, vars)
 			Expect(err).To(BeNil())
 			Expect(len(commits)).Should(BeEquivalentTo(30))
 		})
@@ -131,8 +131,8 @@ var _ = Describe("E2E TEST: githubrepo.graphqlHandler", func() {
 			handler := &graphqlHandler{
 				client: graphClient,
 			}
-			handler.init(context.Background(), repourl, 101)
-			commits, err := populateCommits(handler, vars)
+			handler.init(repourl, 101)
+			commits, err := populateCommits(ctx, handler, vars)
 			Expect(err).To(BeNil())
 			Expect(len(commits)).Should(BeEquivalentTo(101))
 		})
@@ -145,8 +145,8 @@ var _ = Describe("E2E TEST: githubrepo.graphqlHandler", func() {
 				repo:      "scorecard",
 				commitSHA: clients.HeadSHA,
 			}
-			graphqlhandler.init(context.Background(), repourl, 30)
-			Expect(graphqlhandler.setup()).Should(BeNil())
+			graphqlhandler.init(repourl, 30)
+			Expect(graphqlhandler.setup(context.Background())).Should(BeNil())
 			Expect(graphqlhandler.data).ShouldNot(BeNil())
 			Expect(graphqlhandler.data.RateLimit.Cost).ShouldNot(BeNil())
 			Expect(*graphqlhandler.data.RateLimit.Cost).Should(BeNumerically("<=", 1))
@@ -157,8 +157,8 @@ var _ = Describe("E2E TEST: githubrepo.graphqlHandler", func() {
 				repo:      "scorecard",
 				commitSHA: "de5224bbc56eceb7a25aece55d2d53bbc561ed2d",
 			}
-			graphqlhandler.init(context.Background(), repourl, 30)
-			Expect(graphqlhandler.setup()).Should(BeNil())
+			graphqlhandler.init(repourl, 30)
+			Expect(graphqlhandler.setup(context.Background())).Should(BeNil())
 			Expect(graphqlhandler.data).ShouldNot(BeNil())
 			Expect(graphqlhandler.data.RateLimit.Cost).ShouldNot(BeNil())
 			Expect(*graphqlhandler.data.RateLimit.Cost).Should(BeNumerically("<=", 1))
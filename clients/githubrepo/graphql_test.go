@@ -16,10 +16,15 @@ package githubrepo
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/shurcooL/githubv4"
+
+	"github.com/ossf/scorecard/v5/clients"
 )
 
 type badGatewayRoundTripper struct {
@@ -43,8 +48,8 @@ func Test_getCommits_retry(t *testing.T) {
 			Transport: rt,
 		}),
 	}
-	handler.init(context.Background(), &Repo{}, 1)
-	_, err := handler.getCommits()
+	handler.init(&Repo{}, 1)
+	_, err := handler.getCommits(context.Background())
 	if err == nil {
 		t.Error("expected error")
 	}
@@ -53,3 +58,84 @@ func Test_getCommits_retry(t *testing.T) {
 		t.Errorf("wanted %d retries, got %d", want, *rt.requestCounter)
 	}
 }
+
+func Test_getPullRequests_lazy(t *testing.T) {
+	t.Parallel()
+	var nRequests int
+	rt := badGatewayRoundTripper{requestCounter: &nRequests}
+	handler := graphqlHandler{
+		client: githubv4.NewClient(&http.Client{
+			Transport: rt,
+		}),
+	}
+	handler.init(&Repo{commitSHA: clients.HeadSHA}, 1)
+	if _, err := handler.getPullRequests(context.Background()); err == nil {
+		t.Error("expected error")
+	}
+	// The pull requests are queried once, without the commits.
+	if _, err := handler.getPullRequests(context.Background()); err == nil {
+		t.Error("expected error")
+	}
+	if nRequests != 1 {
+		t.Errorf("wanted 1 request, got %d", nRequests)
+	}
+}
+
+func Test_pullRequestsFrom(t *testing.T) {
+	t.Parallel()
+	var data graphqlPullRequestsData
+	err := json.Unmarshal([]byte(`{"repository": {"pullRequests": {"nodes": [{
+		"url": "https://github.com/o/r/pull/2",
+		"number": 2,
+		"state": "CLOSED",
+		"createdAt": "2026-01-01T00:00:00Z",
+		"closedAt": "2026-01-03T00:00:00Z",
+		"authorAssociation": "CONTRIBUTOR",
+		"author": {"login": "alice", "resourcePath": "/alice"},
+		"firstComments": {"nodes": [
+			{"id": "c1", "authorAssociation": "MEMBER", "createdAt": "2026-01-02T00:00:00Z", "author": {"login": "bob"}},
+			{"id": "c2", "authorAssociation": "CONTRIBUTOR", "createdAt": "2026-01-02T06:00:00Z", "author": {"login": "alice"}}
+		]},
+		"comments": {"nodes": [
+			{"id": "c2", "authorAssociation": "CONTRIBUTOR", "createdAt": "2026-01-02T06:00:00Z", "author": {"login": "alice"}},
+			{"id": "c3", "authorAssociation": "MEMBER", "createdAt": "2026-01-03T00:00:00Z", "author": {"login": "bob"}}
+		]},
+		"reviews": {"nodes": [
+			{"state": "COMMENTED", "submittedAt": "2026-01-02T12:00:00Z", "authorAssociation": "NONE",
+			 "author": {"login": "review-bot", "resourcePath": "/apps/review-bot"}}
+		]}
+	}]}}}`), &data)
+	if err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	member := clients.RepoAssociationMember
+	contributor := clients.RepoAssociationContributor
+	none := clients.RepoAssociationNone
+	commented := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	replied := time.Date(2026, 1, 2, 6, 0, 0, 0, time.UTC)
+	closed := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	want := []clients.PullRequest{{
+		Number:            2,
+		URI:               "https://github.com/o/r/pull/2",
+		State:             "CLOSED",
+		CreatedAt:         time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		ClosedAt:          closed,
+		Author:            clients.User{Login: "alice"},
+		AuthorAssociation: &contributor,
+		Comments: []clients.IssueComment{
+			{CreatedAt: &commented, Author: &clients.User{Login: "bob"}, AuthorAssociation: &member},
+			{CreatedAt: &replied, Author: &clients.User{Login: "alice"}, AuthorAssociation: &contributor},
+			{CreatedAt: &closed, Author: &clients.User{Login: "bob"}, AuthorAssociation: &member},
+		},
+		Reviews: []clients.Review{{
+			State:             "COMMENTED",
+			SubmittedAt:       time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC),
+			Author:            &clients.User{Login: "review-bot", IsBot: true},
+			AuthorAssociation: &none,
+		}},
+	}}
+	if diff := cmp.Diff(want, pullRequestsFrom(&data)); diff != "" {
+		t.Errorf("pullRequestsFrom() mismatch (-want +got):\n%s", diff)
+	}
+}
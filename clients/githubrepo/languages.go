@@ -28,14 +28,12 @@ import (
 type languagesHandler struct {
 	ghclient  *github.Client
 	once      *sync.Once
-	ctx       context.Context
 	errSetup  error
 	repourl   *Repo
 	languages []clients.Language
 }
 
-func (handler *languagesHandler) init(ctx context.Context, repourl *Repo) {
-	handler.ctx = ctx
+func (handler *languagesHandler) init(repourl *Repo) {
 	handler.repourl = repourl
 	handler.errSetup = nil
 	handler.once = new(sync.Once)
@@ -44,7 +42,7 @@ func (handler *languagesHandler) init(ctx context.Context, repourl *Repo) {
 
 // TODO: Can add support to parse the raw response JSON and mark languages that are not in
 // our defined Language consts in clients/languages.go as "not supported languages".
-func (handler *languagesHandler) setup() error {
+func (handler *languagesHandler) setup(ctx context.Context) error {
 	handler.once.Do(func() {
 		client := handler.ghclient
 		reqURL := path.Join("repos", handler.repourl.owner, handler.repourl.repo, "languages")
@@ -57,7 +55,7 @@ func (handler *languagesHandler) setup() error {
 		// The client.repoClient.Do API writes the response body to var bodyJSON,
 		// so we can ignore the first returned variable (the entire http response object)
 		// since we only need the response body here.
-		_, err = client.Do(handler.ctx, req, &bodyJSON)
+		_, err = client.Do(ctx, req, &bodyJSON)
 		if err != nil {
 			handler.errSetup = fmt.Errorf("response for repo languages failed with %w", err)
 			return
@@ -80,8 +78,8 @@ func (handler *languagesHandler) setup() error {
 	return handler.errSetup
 }
 
-func (handler *languagesHandler) listProgrammingLanguages() ([]clients.Language, error) {
-	if err := handler.setup(); err != nil {
+func (handler *languagesHandler) listProgrammingLanguages(ctx context.Context) ([]clients.Language, error) {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during languagesHandler.setup: %w", err)
 	}
 	return handler.languages, nil
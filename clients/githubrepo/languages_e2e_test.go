@@ -29,14 +29,12 @@ import (
 var _ = Describe("E2E TEST: githubrepo.languagesHandler", func() {
 	var langHandler *languagesHandler
 	BeforeEach(func() {
-		ctx := context.Background()
 		rt := roundtripper.NewTransport(context.Background(), &log.Logger{})
 		httpClient := &http.Client{
 			Transport: rt,
 		}
 		langHandler = &languagesHandler{
 			ghclient: github.NewClient(httpClient),
-			ctx:      ctx,
 		}
 	})
 	Context("listProgrammingLanguages()", func() {
@@ -45,8 +43,8 @@ var _ = Describe("E2E TEST: githubrepo.languagesHandler", func() {
 				owner: "ossf",
 				repo:  "scorecard",
 			}
-			langHandler.init(context.Background(), &repoURL)
-			languages, err := langHandler.listProgrammingLanguages()
+			langHandler.init(&repoURL)
+			languages, err := langHandler.listProgrammingLanguages(context.Background())
 			Expect(err).Should(BeNil())
 			Expect(languages).ShouldNot(BeEmpty())
 		})
@@ -29,14 +29,12 @@ import (
 type licensesHandler struct {
 	ghclient *github.Client
 	once     *sync.Once
-	ctx      context.Context
 	errSetup error
 	repourl  *Repo
 	licenses []clients.License
 }
 
-func (handler *licensesHandler) init(ctx context.Context, repourl *Repo) {
-	handler.ctx = ctx
+func (handler *licensesHandler) init(repourl *Repo) {
 	handler.repourl = repourl
 	handler.errSetup = nil
 	handler.once = new(sync.Once)
@@ -45,7 +43,7 @@ func (handler *licensesHandler) init(ctx context.Context, repourl *Repo) {
 
 // TODO: Can add support to parse the raw response JSON and mark licenses that are not in
 // our defined License consts in clients/licenses.go as "not supported licenses".
-func (handler *licensesHandler) setup() error {
+func (handler *licensesHandler) setup(ctx context.Context) error {
 	handler.once.Do(func() {
 		client := handler.ghclient
 		// defined at docs.github.com/en/rest/licenses#get-the-license-for-a-repository
@@ -59,7 +57,7 @@ func (handler *licensesHandler) setup() error {
 		// The client.repoClient.Do API writes the response body to var bodyJSON,
 		// so we can ignore the first returned variable (the entire http response object)
 		// since we only need the response body here.
-		resp, derr := client.Do(handler.ctx, req, &bodyJSON)
+		resp, derr := client.Do(ctx, req, &bodyJSON)
 		switch resp.StatusCode {
 		// Handle 400 error, perhaps the API changed.
 		case http.StatusBadRequest:
@@ -93,8 +91,8 @@ func (handler *licensesHandler) setup() error {
 	return handler.errSetup
 }
 
-func (handler *licensesHandler) listLicenses() ([]clients.License, error) {
-	if err := handler.setup(); err != nil {
+func (handler *licensesHandler) listLicenses(ctx context.Context) ([]clients.License, error) {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during licensesHandler.setup: %w", err)
 	}
 	return handler.licenses, nil
@@ -31,7 +31,6 @@ var _ = Describe("E2E TEST: githubrepo.licensesHandler", func() {
 	var lh *licensesHandler
 
 	BeforeEach(func() {
-		ctx := context.Background()
 		rt := roundtripper.NewTransport(context.Background(), &log.Logger{})
 		httpClient := &http.Client{
 			Transport: rt,
@@ -39,7 +38,6 @@ var _ = Describe("E2E TEST: githubrepo.licensesHandler", func() {
 		client := github.NewClient(httpClient)
 		lh = &licensesHandler{
 			ghclient: client,
-			ctx:      ctx,
 		}
 	})
 	Context("listLicenses()", func() {
@@ -50,8 +48,8 @@ var _ = Describe("E2E TEST: githubrepo.licensesHandler", func() {
 				commitSHA: clients.HeadSHA,
 			}
 
-			lh.init(context.Background(), &repoURL)
-			licenses, err := lh.listLicenses()
+			lh.init(&repoURL)
+			licenses, err := lh.listLicenses(context.Background())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(lh.errSetup).Should(BeNil())
 			Expect(len(licenses)).Should(Equal(1))
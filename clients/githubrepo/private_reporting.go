@@ -0,0 +1,43 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v53/github"
+)
+
+type privateReportingResponse struct {
+	Enabled bool `json:"enabled"`
+}
+
+// isPrivateReportingEnabled queries whether private vulnerability reporting
+// is enabled for the repository. The go-github version in use has no helper
+// for this endpoint, so the request is built by hand.
+func isPrivateReportingEnabled(ctx context.Context, ghClient *github.Client, repourl *Repo) (bool, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", repourl.owner, repourl.repo)
+	req, err := ghClient.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return false, fmt.Errorf("error during NewRequest: %w", err)
+	}
+	var resp privateReportingResponse
+	if _, err := ghClient.Do(ctx, req, &resp); err != nil {
+		return false, fmt.Errorf("error during private-vulnerability-reporting query: %w", err)
+	}
+	return resp.Enabled, nil
+}
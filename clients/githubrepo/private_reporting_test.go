@@ -0,0 +1,71 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v53/github"
+)
+
+func Test_isPrivateReportingEnabled(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		responsePath string
+		want         bool
+		wantErr      bool
+	}{
+		{
+			name:         "enabled",
+			responsePath: "./testdata/private-reporting-enabled.json",
+			want:         true,
+		},
+		{
+			name:         "disabled",
+			responsePath: "./testdata/private-reporting-disabled.json",
+			want:         false,
+		},
+		{
+			name:         "missing response",
+			responsePath: "./testdata/does-not-exist.json",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			httpClient := &http.Client{
+				Transport: stubTripper{
+					responsePath: tt.responsePath,
+				},
+			}
+			client := github.NewClient(httpClient)
+			repoURL := Repo{
+				owner: "ossf-tests",
+				repo:  "foo",
+			}
+			got, err := isPrivateReportingEnabled(context.Background(), client, &repoURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("isPrivateReportingEnabled error: %v, wantedErr: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("isPrivateReportingEnabled() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
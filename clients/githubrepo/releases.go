@@ -29,28 +29,26 @@ import (
 type releasesHandler struct {
 	client   *github.Client
 	once     *sync.Once
-	ctx      context.Context
 	errSetup error
 	repourl  *Repo
 	releases []clients.Release
 }
 
-func (handler *releasesHandler) init(ctx context.Context, repourl *Repo) {
-	handler.ctx = ctx
+func (handler *releasesHandler) init(repourl *Repo) {
 	handler.repourl = repourl
 	handler.errSetup = nil
 	handler.once = new(sync.Once)
 	handler.releases = nil
 }
 
-func (handler *releasesHandler) setup() error {
+func (handler *releasesHandler) setup(ctx context.Context) error {
 	handler.once.Do(func() {
 		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
 			handler.errSetup = fmt.Errorf("%w: ListReleases only supported for HEAD queries", clients.ErrUnsupportedFeature)
 			return
 		}
 		releases, _, err := handler.client.Repositories.ListReleases(
-			handler.ctx, handler.repourl.owner, handler.repourl.repo, &github.ListOptions{})
+			ctx, handler.repourl.owner, handler.repourl.repo, &github.ListOptions{})
 		if err != nil {
 			handler.errSetup = sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
 		}
@@ -59,8 +57,8 @@ func (handler *releasesHandler) setup() error {
 	return handler.errSetup
 }
 
-func (handler *releasesHandler) getReleases() ([]clients.Release, error) {
-	if err := handler.setup(); err != nil {
+func (handler *releasesHandler) getReleases(ctx context.Context) ([]clients.Release, error) {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
 	}
 	return handler.releases, nil
@@ -73,6 +71,7 @@ func releasesFrom(data []*github.RepositoryRelease) []clients.Release {
 			TagName:         r.GetTagName(),
 			URL:             r.GetURL(),
 			TargetCommitish: r.GetTargetCommitish(),
+			PublishedAt:     r.GetPublishedAt().Time,
 		}
 		for _, a := range r.Assets {
 			release.Assets = append(release.Assets, clients.ReleaseAsset{
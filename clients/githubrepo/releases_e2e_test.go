@@ -31,7 +31,6 @@ var _ = Describe("E2E TEST: githubrepo.releasesHandler", func() {
 	var releaseHandler *releasesHandler
 
 	BeforeEach(func() {
-		ctx := context.Background()
 		rt := roundtripper.NewTransport(context.Background(), &log.Logger{})
 		httpClient := &http.Client{
 			Transport: rt,
@@ -39,7 +38,6 @@ var _ = Describe("E2E TEST: githubrepo.releasesHandler", func() {
 		client := github.NewClient(httpClient)
 		releaseHandler = &releasesHandler{
 			client: client,
-			ctx:    ctx,
 		}
 	})
 	Context("getReleases()", func() {
@@ -50,8 +48,8 @@ var _ = Describe("E2E TEST: githubrepo.releasesHandler", func() {
 				commitSHA: clients.HeadSHA,
 			}
 
-			releaseHandler.init(context.Background(), &repoURL)
-			resp, err := releaseHandler.getReleases()
+			releaseHandler.init(&repoURL)
+			resp, err := releaseHandler.getReleases(context.Background())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(len(resp)).ShouldNot(Equal(0))
 			Expect(releaseHandler.errSetup).Should(BeNil())
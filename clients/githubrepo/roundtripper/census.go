@@ -21,6 +21,8 @@ import (
 	"go.opencensus.io/plugin/ochttp"
 	opencensusstats "go.opencensus.io/stats"
 	"go.opencensus.io/tag"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/stats"
@@ -49,17 +51,27 @@ func (ct *censusTransport) RoundTrip(r *http.Request) (*http.Response, error) {
 		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("tag.New: %v", err))
 	}
 
+	ctx, span := stats.Tracer().Start(ctx, "HTTP "+r.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(stats.AttrEndpoint.String(r.URL.Host+r.URL.Path)))
+	defer span.End()
+
 	r = r.WithContext(ctx)
 	resp, err := ct.innerTransport.RoundTrip(r)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("innerTransport.RoundTrip: %v", err))
 	}
+	cache := "miss"
 	if resp.Header.Get(fromCacheHeader) != "" {
+		cache = "hit"
 		ctx, err = tag.New(ctx, tag.Upsert(stats.RequestTag, fromCacheHeader))
 		if err != nil {
 			return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("tag.New: %v", err))
 		}
 	}
 	opencensusstats.Record(ctx, stats.HTTPRequests.M(1))
+	stats.PromHTTPRequests.WithLabelValues(r.URL.Host, cache).Inc()
 	return resp, nil
 }
@@ -26,16 +26,14 @@ import (
 
 type searchCommitsHandler struct {
 	ghClient *github.Client
-	ctx      context.Context
 	repourl  *Repo
 }
 
-func (handler *searchCommitsHandler) init(ctx context.Context, repourl *Repo) {
-	handler.ctx = ctx
+func (handler *searchCommitsHandler) init(repourl *Repo) {
 	handler.repourl = repourl
 }
 
-func (handler *searchCommitsHandler) search(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
+func (handler *searchCommitsHandler) search(ctx context.Context, request clients.SearchCommitsOptions) ([]clients.Commit, error) {
 	if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
 		return nil, fmt.Errorf(
 			"%w: Search only supported for HEAD queries", clients.ErrUnsupportedFeature)
@@ -45,7 +43,7 @@ func (handler *searchCommitsHandler) search(request clients.SearchCommitsOptions
 		return nil, fmt.Errorf("handler.buildQuery: %w", err)
 	}
 
-	resp, _, err := handler.ghClient.Search.Commits(handler.ctx,
+	resp, _, err := handler.ghClient.Search.Commits(ctx,
 		query,
 		&github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}})
 	if err != nil {
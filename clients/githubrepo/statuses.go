@@ -26,18 +26,16 @@ import (
 
 type statusesHandler struct {
 	client  *github.Client
-	ctx     context.Context
 	repourl *Repo
 }
 
-func (handler *statusesHandler) init(ctx context.Context, repourl *Repo) {
-	handler.ctx = ctx
+func (handler *statusesHandler) init(repourl *Repo) {
 	handler.repourl = repourl
 }
 
-func (handler *statusesHandler) listStatuses(ref string) ([]clients.Status, error) {
+func (handler *statusesHandler) listStatuses(ctx context.Context, ref string) ([]clients.Status, error) {
 	statuses, _, err := handler.client.Repositories.ListStatuses(
-		handler.ctx, handler.repourl.owner, handler.repourl.repo, ref, &github.ListOptions{})
+		ctx, handler.repourl.owner, handler.repourl.repo, ref, &github.ListOptions{})
 	if err != nil {
 		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("ListStatuses: %v", err))
 	}
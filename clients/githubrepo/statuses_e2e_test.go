@@ -31,7 +31,6 @@ var _ = Describe("E2E TEST: githubrepo.statusesHandler", func() {
 	var statusHandler *statusesHandler
 
 	BeforeEach(func() {
-		ctx := context.Background()
 		rt := roundtripper.NewTransport(context.Background(), &log.Logger{})
 		httpClient := &http.Client{
 			Transport: rt,
@@ -39,7 +38,6 @@ var _ = Describe("E2E TEST: githubrepo.statusesHandler", func() {
 		client := github.NewClient(httpClient)
 		statusHandler = &statusesHandler{
 			client: client,
-			ctx:    ctx,
 		}
 	})
 	Context("listStatuses()", func() {
@@ -51,8 +49,8 @@ var _ = Describe("E2E TEST: githubrepo.statusesHandler", func() {
 				commitSHA: clients.HeadSHA,
 			}
 
-			statusHandler.init(context.Background(), &repoURL)
-			resp, err := statusHandler.listStatuses("8c9e552f68e5fd070692b8376ac51d2e8a7f0aaa")
+			statusHandler.init(&repoURL)
+			resp, err := statusHandler.listStatuses(context.Background(), "8c9e552f68e5fd070692b8376ac51d2e8a7f0aaa")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(len(resp)).ShouldNot(Equal(0))
 		})
@@ -68,7 +68,6 @@ func extractAndValidateArchivePath(path, dest string) (string, error) {
 type tarballHandler struct {
 	errSetup    error
 	once        *sync.Once
-	ctx         context.Context
 	repo        *github.Repository
 	httpClient  *http.Client
 	commitSHA   string
@@ -77,15 +76,14 @@ type tarballHandler struct {
 	files       []string
 }
 
-func (handler *tarballHandler) init(ctx context.Context, repo *github.Repository, commitSHA string) {
+func (handler *tarballHandler) init(repo *github.Repository, commitSHA string) {
 	handler.errSetup = nil
 	handler.once = new(sync.Once)
-	handler.ctx = ctx
 	handler.repo = repo
 	handler.commitSHA = commitSHA
 }
 
-func (handler *tarballHandler) setup() error {
+func (handler *tarballHandler) setup(ctx context.Context) error {
 	handler.once.Do(func() {
 		// Cleanup any previous state.
 		if err := handler.cleanup(); err != nil {
@@ -94,7 +92,7 @@ func (handler *tarballHandler) setup() error {
 		}
 
 		// Setup temp dir/files and download repo tarball.
-		if err := handler.getTarball(); errors.Is(err, errTarballNotFound) {
+		if err := handler.getTarball(ctx); errors.Is(err, errTarballNotFound) {
 			// don't warn for "someorg/.github" repos
 			// https://github.com/ossf/scorecard/issues/3076
 			if handler.repo.GetName() == orgGithubRepo {
@@ -117,7 +115,7 @@ func (handler *tarballHandler) setup() error {
 	return handler.errSetup
 }
 
-func (handler *tarballHandler) getTarball() error {
+func (handler *tarballHandler) getTarball(ctx context.Context) error {
 	url := handler.repo.GetArchiveURL()
 	url = strings.Replace(url, "{archive_format}", "tarball/", 1)
 	if strings.EqualFold(handler.commitSHA, clients.HeadSHA) {
@@ -125,7 +123,7 @@ func (handler *tarballHandler) getTarball() error {
 	} else {
 		url = strings.Replace(url, "{/ref}", handler.commitSHA, 1)
 	}
-	req, err := http.NewRequestWithContext(handler.ctx, http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return fmt.Errorf("http.NewRequestWithContext: %w", err)
 	}
@@ -232,8 +230,8 @@ func (handler *tarballHandler) extractTarball() error {
 	return nil
 }
 
-func (handler *tarballHandler) listFiles(predicate func(string) (bool, error)) ([]string, error) {
-	if err := handler.setup(); err != nil {
+func (handler *tarballHandler) listFiles(ctx context.Context, predicate func(string) (bool, error)) ([]string, error) {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during tarballHandler.setup: %w", err)
 	}
 	ret := make([]string, 0)
@@ -249,8 +247,8 @@ func (handler *tarballHandler) listFiles(predicate func(string) (bool, error)) (
 	return ret, nil
 }
 
-func (handler *tarballHandler) getLocalPath() (string, error) {
-	if err := handler.setup(); err != nil {
+func (handler *tarballHandler) getLocalPath(ctx context.Context) (string, error) {
+	if err := handler.setup(ctx); err != nil {
 		return "", fmt.Errorf("error during tarballHandler.setup: %w", err)
 	}
 	absTempDir, err := filepath.Abs(handler.tempDir)
@@ -260,8 +258,8 @@ func (handler *tarballHandler) getLocalPath() (string, error) {
 	return absTempDir, nil
 }
 
-func (handler *tarballHandler) getFile(filename string) (*os.File, error) {
-	if err := handler.setup(); err != nil {
+func (handler *tarballHandler) getFile(ctx context.Context, filename string) (*os.File, error) {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during tarballHandler.setup: %w", err)
 	}
 	f, err := os.Open(filepath.Join(handler.tempDir, filename))
@@ -146,7 +146,7 @@ func TestExtractTarball(t *testing.T) {
 
 			// Test ListFiles API.
 			for _, listfiletest := range testcase.listfileTests {
-				matchedFiles, err := handler.listFiles(listfiletest.predicate)
+				matchedFiles, err := handler.listFiles(context.Background(), listfiletest.predicate)
 				if !errors.Is(err, listfiletest.err) {
 					t.Errorf("test failed: expected - %v, got - %v", listfiletest.err, err)
 					continue
@@ -160,7 +160,7 @@ func TestExtractTarball(t *testing.T) {
 
 			// Test GetFileContent API.
 			for _, getcontenttest := range testcase.getcontentTests {
-				f, err := handler.getFile(getcontenttest.filename)
+				f, err := handler.getFile(context.Background(), getcontenttest.filename)
 				if getcontenttest.err != nil && !errors.Is(err, getcontenttest.err) {
 					t.Errorf("test failed: expected - %v, got - %v", getcontenttest.err, err)
 				}
@@ -232,8 +232,8 @@ func Test_setup_empty_repo(t *testing.T) {
 			}
 			var buf bytes.Buffer
 			setLogOutput(t, &buf)
-			h.init(context.Background(), &r, clients.HeadSHA)
-			err := h.setup()
+			h.init(&r, clients.HeadSHA)
+			err := h.setup(context.Background())
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
@@ -28,28 +28,26 @@ import (
 type webhookHandler struct {
 	ghClient *github.Client
 	once     *sync.Once
-	ctx      context.Context
 	errSetup error
 	repourl  *Repo
 	webhook  []clients.Webhook
 }
 
-func (handler *webhookHandler) init(ctx context.Context, repourl *Repo) {
-	handler.ctx = ctx
+func (handler *webhookHandler) init(repourl *Repo) {
 	handler.repourl = repourl
 	handler.errSetup = nil
 	handler.once = new(sync.Once)
 	handler.webhook = nil
 }
 
-func (handler *webhookHandler) setup() error {
+func (handler *webhookHandler) setup(ctx context.Context) error {
 	handler.once.Do(func() {
 		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
 			handler.errSetup = fmt.Errorf("%w: ListWebHooks only supported for HEAD queries", clients.ErrUnsupportedFeature)
 			return
 		}
 		hooks, _, err := handler.ghClient.Repositories.ListHooks(
-			handler.ctx, handler.repourl.owner, handler.repourl.repo, &github.ListOptions{})
+			ctx, handler.repourl.owner, handler.repourl.repo, &github.ListOptions{})
 		if err != nil {
 			handler.errSetup = fmt.Errorf("error during ListHooks: %w", err)
 			return
@@ -77,8 +75,8 @@ func getAuthSecret(config map[string]interface{}) bool {
 	return false
 }
 
-func (handler *webhookHandler) listWebhooks() ([]clients.Webhook, error) {
-	if err := handler.setup(); err != nil {
+func (handler *webhookHandler) listWebhooks(ctx context.Context) ([]clients.Webhook, error) {
+	if err := handler.setup(ctx); err != nil {
 		return nil, fmt.Errorf("error during webhookHandler.setup: %w", err)
 	}
 	return handler.webhook, nil
@@ -74,7 +74,6 @@ func Test_listWebhooks(t *testing.T) {
 			client := github.NewClient(httpClient)
 			handler := &webhookHandler{
 				ghClient: client,
-				ctx:      ctx,
 			}
 
 			repoURL := Repo{
@@ -82,8 +81,8 @@ func Test_listWebhooks(t *testing.T) {
 				repo:      "foo",
 				commitSHA: clients.HeadSHA,
 			}
-			handler.init(ctx, &repoURL)
-			got, err := handler.listWebhooks()
+			handler.init(&repoURL)
+			got, err := handler.listWebhooks(ctx)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("listWebhooks error: %v, wantedErr: %t", err, tt.wantErr)
 			}
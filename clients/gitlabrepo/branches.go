@@ -36,6 +36,8 @@ type branchesHandler struct {
 	getProtectedBranch       fnProtectedBranch
 	getProjectChecks         fnListProjectStatusChecks
 	getApprovalConfiguration fnGetApprovalConfiguration
+	getPushRules             fnGetProjectPushRules
+	getApprovalRules         fnGetProjectApprovalRules
 }
 
 func (handler *branchesHandler) init(repourl *Repo) {
@@ -47,6 +49,8 @@ func (handler *branchesHandler) init(repourl *Repo) {
 	handler.getProtectedBranch = handler.glClient.ProtectedBranches.GetProtectedBranch
 	handler.getProjectChecks = handler.glClient.ExternalStatusChecks.ListProjectStatusChecks
 	handler.getApprovalConfiguration = handler.glClient.Projects.GetApprovalConfiguration
+	handler.getPushRules = handler.glClient.Projects.GetProjectPushRules
+	handler.getApprovalRules = handler.glClient.Projects.GetProjectApprovalRules
 }
 
 type (
@@ -60,8 +64,19 @@ type (
 		options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error)
 	fnGetApprovalConfiguration func(pid interface{},
 		options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+	fnGetProjectPushRules func(pid interface{},
+		options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
+	fnGetProjectApprovalRules func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions,
+		options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error)
 )
 
+// projectRules are the settings of a project which apply to its protected branches.
+type projectRules struct {
+	project       *gitlab.Project
+	pushRules     *gitlab.ProjectPushRules
+	approvalRules []*gitlab.ProjectApprovalRule
+}
+
 //nolint:nestif
 func (handler *branchesHandler) setup() error {
 	handler.once.Do(func() {
@@ -105,8 +120,14 @@ func (handler *branchesHandler) setup() error {
 				return
 			}
 
+			rules, err := handler.getProjectRules(proj)
+			if err != nil {
+				handler.errSetup = err
+				return
+			}
+
 			handler.defaultBranchRef = makeBranchRefFrom(branch, protectedBranch,
-				projectStatusChecks, projectApprovalRule)
+				projectStatusChecks, projectApprovalRule, rules)
 		} else {
 			handler.defaultBranchRef = &clients.BranchRef{
 				Name:      &branch.Name,
@@ -160,7 +181,16 @@ func (handler *branchesHandler) getBranch(branch string) (*clients.BranchRef, er
 			return nil, fmt.Errorf("request for project approval rule failed with %w", err)
 		}
 
-		return makeBranchRefFrom(bran, protectedBranch, projectStatusChecks, projectApprovalRule), nil
+		proj, _, err := handler.queryProject(handler.repourl.projectID, &gitlab.GetProjectOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("request for project failed with error %w", err)
+		}
+		rules, err := handler.getProjectRules(proj)
+		if err != nil {
+			return nil, err
+		}
+
+		return makeBranchRefFrom(bran, protectedBranch, projectStatusChecks, projectApprovalRule, rules), nil
 	} else {
 		ret := &clients.BranchRef{
 			Name:      &bran.Name,
@@ -170,6 +200,35 @@ func (handler *branchesHandler) getBranch(branch string) (*clients.BranchRef, er
 	}
 }
 
+// getProjectRules gets the push rules and the approval rules of the project. They're only
+// available in some tiers of GitLab, so they're left empty if unavailable.
+func (handler *branchesHandler) getProjectRules(project *gitlab.Project) (*projectRules, error) {
+	rules := &projectRules{project: project}
+
+	pushRules, resp, err := handler.getPushRules(handler.repourl.projectID)
+	switch {
+	case err == nil:
+		rules.pushRules = pushRules
+	case !isUnavailable(resp):
+		return nil, fmt.Errorf("request for push rules failed with error %w", err)
+	}
+
+	approvalRules, resp, err := handler.getApprovalRules(handler.repourl.projectID,
+		&gitlab.GetProjectApprovalRulesListsOptions{})
+	switch {
+	case err == nil:
+		rules.approvalRules = approvalRules
+	case !isUnavailable(resp):
+		return nil, fmt.Errorf("request for approval rules failed with error %w", err)
+	}
+	return rules, nil
+}
+
+func isUnavailable(resp *gitlab.Response) bool {
+	return resp != nil && resp.Response != nil &&
+		(resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden)
+}
+
 func makeContextsFromResp(checks []*gitlab.ProjectStatusCheck) []string {
 	ret := make([]string, len(checks))
 	for i, statusCheck := range checks {
@@ -181,6 +240,7 @@ func makeContextsFromResp(checks []*gitlab.ProjectStatusCheck) []string {
 func makeBranchRefFrom(branch *gitlab.Branch, protectedBranch *gitlab.ProtectedBranch,
 	projectStatusChecks []*gitlab.ProjectStatusCheck,
 	projectApprovalRule *gitlab.ProjectApprovals,
+	rules *projectRules,
 ) *clients.BranchRef {
 	requiresStatusChecks := newFalse()
 	if len(projectStatusChecks) > 0 {
@@ -213,12 +273,87 @@ func makeBranchRefFrom(branch *gitlab.Branch, protectedBranch *gitlab.ProtectedB
 			AllowForcePushes: &protectedBranch.AllowForcePush,
 			EnforceAdmins:    newTrue(),
 			CheckRules:       statusChecksRule,
+			Sources: []clients.RuleSource{{
+				Name: protectedBranch.Name,
+				Type: clients.RuleSourceProtectedBranch,
+			}},
+			BypassActors: makeBypassActorsFrom(protectedBranch),
 		},
 	}
+	applyProjectRules(&ret.BranchProtectionRule, branch.Name, rules)
 
 	return ret
 }
 
+// makeBypassActorsFrom returns the actors allowed to push to a protected branch without
+// a merge request.
+func makeBypassActorsFrom(protectedBranch *gitlab.ProtectedBranch) []clients.BypassActor {
+	var actors []clients.BypassActor
+	for _, access := range protectedBranch.PushAccessLevels {
+		actor := clients.BypassActor{
+			Name:   access.AccessLevelDescription,
+			Source: protectedBranch.Name,
+		}
+		switch {
+		case access.DeployKeyID != 0:
+			actor.Type = clients.BypassActorDeployKey
+		case access.UserID != 0:
+			actor.Type = clients.BypassActorUser
+		case access.GroupID != 0:
+			actor.Type = clients.BypassActorTeam
+		case access.AccessLevel != gitlab.NoPermissions:
+			actor.Type = clients.BypassActorAccessLevel
+		default:
+			continue
+		}
+		actors = append(actors, actor)
+	}
+	return actors
+}
+
+func applyProjectRules(rule *clients.BranchProtectionRule, branchName string, rules *projectRules) {
+	if rules == nil {
+		return
+	}
+	if rules.project != nil {
+		linear := rules.project.MergeMethod == gitlab.FastForwardMerge
+		rule.RequireLinearHistory = &linear
+		mergeTrains := rules.project.MergeTrainsEnabled
+		rule.RequireMergeQueue = &mergeTrains
+	}
+	if rules.pushRules != nil {
+		signed := rules.pushRules.RejectUnsignedCommits
+		rule.RequireSignedCommits = &signed
+		rule.Sources = append(rule.Sources, clients.RuleSource{Type: clients.RuleSourcePushRules})
+	}
+	for _, approvalRule := range rules.approvalRules {
+		if !approvalRuleAppliesTo(approvalRule, branchName) {
+			continue
+		}
+		rule.Sources = append(rule.Sources, clients.RuleSource{
+			Name: approvalRule.Name,
+			Type: clients.RuleSourceApprovalRule,
+		})
+		required := int32(approvalRule.ApprovalsRequired)
+		count := rule.PullRequestRule.RequiredApprovingReviewCount
+		if count == nil || *count < required {
+			rule.PullRequestRule.RequiredApprovingReviewCount = &required
+		}
+	}
+}
+
+func approvalRuleAppliesTo(rule *gitlab.ProjectApprovalRule, branchName string) bool {
+	if rule.AppliesToAllProtectedBranches || len(rule.ProtectedBranches) == 0 {
+		return true
+	}
+	for _, branch := range rule.ProtectedBranches {
+		if branch != nil && branch.Name == branchName {
+			return true
+		}
+	}
+	return false
+}
+
 func newTrue() *bool {
 	b := true
 	return &b
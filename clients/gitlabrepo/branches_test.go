@@ -19,7 +19,10 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/ossf/scorecard/v5/clients"
 )
 
 func TestGetBranches(t *testing.T) {
@@ -104,6 +107,21 @@ func TestGetBranches(t *testing.T) {
 				) {
 					return tt.apprvlReturn, tt.returnStatus, nil
 				},
+				queryProject: func(pid interface{}, opt *gitlab.GetProjectOptions,
+					options ...gitlab.RequestOptionFunc,
+				) (*gitlab.Project, *gitlab.Response, error) {
+					return &gitlab.Project{}, tt.returnStatus, nil
+				},
+				getPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (
+					*gitlab.ProjectPushRules, *gitlab.Response, error,
+				) {
+					return &gitlab.ProjectPushRules{}, tt.returnStatus, nil
+				},
+				getApprovalRules: func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions,
+					options ...gitlab.RequestOptionFunc,
+				) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+					return nil, tt.returnStatus, nil
+				},
 			}
 
 			handler.once.Do(func() {})
@@ -130,3 +148,57 @@ func TestGetBranches(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeBranchRefFromProjectRules(t *testing.T) {
+	t.Parallel()
+	branch := &gitlab.Branch{Name: "main", Protected: true}
+	protectedBranch := &gitlab.ProtectedBranch{
+		Name: "main",
+		PushAccessLevels: []*gitlab.BranchAccessDescription{
+			{AccessLevel: gitlab.NoPermissions, AccessLevelDescription: "No one"},
+			{AccessLevel: gitlab.MaintainerPermissions, AccessLevelDescription: "Maintainers"},
+			{DeployKeyID: 7, AccessLevelDescription: "release key"},
+		},
+	}
+	rules := &projectRules{
+		project: &gitlab.Project{
+			MergeMethod:        gitlab.FastForwardMerge,
+			MergeTrainsEnabled: true,
+		},
+		pushRules: &gitlab.ProjectPushRules{RejectUnsignedCommits: true},
+		approvalRules: []*gitlab.ProjectApprovalRule{
+			{Name: "security", ApprovalsRequired: 2, AppliesToAllProtectedBranches: true},
+			{Name: "docs", ApprovalsRequired: 3, ProtectedBranches: []*gitlab.ProtectedBranch{{Name: "docs"}}},
+		},
+	}
+
+	got := makeBranchRefFrom(branch, protectedBranch, nil, &gitlab.ProjectApprovals{ApprovalsBeforeMerge: 1}, rules)
+	rule := got.BranchProtectionRule
+	if rule.RequireSignedCommits == nil || !*rule.RequireSignedCommits {
+		t.Error("signed commits should be required")
+	}
+	if rule.RequireLinearHistory == nil || !*rule.RequireLinearHistory {
+		t.Error("linear history should be required")
+	}
+	if rule.RequireMergeQueue == nil || !*rule.RequireMergeQueue {
+		t.Error("merge trains should be required")
+	}
+	if c := rule.PullRequestRule.RequiredApprovingReviewCount; c == nil || *c != 2 {
+		t.Errorf("RequiredApprovingReviewCount = %v, want 2", c)
+	}
+	wantActors := []clients.BypassActor{
+		{Name: "Maintainers", Type: clients.BypassActorAccessLevel, Source: "main"},
+		{Name: "release key", Type: clients.BypassActorDeployKey, Source: "main"},
+	}
+	if diff := cmp.Diff(wantActors, rule.BypassActors); diff != "" {
+		t.Errorf("BypassActors mismatch (-want +got):\n%s", diff)
+	}
+	wantSources := []clients.RuleSource{
+		{Name: "main", Type: clients.RuleSourceProtectedBranch},
+		{Type: clients.RuleSourcePushRules},
+		{Name: "security", Type: clients.RuleSourceApprovalRule},
+	}
+	if diff := cmp.Diff(wantSources, rule.Sources); diff != "" {
+		t.Errorf("Sources mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -220,6 +220,17 @@ func (client *Client) IsArchived() (bool, error) {
 	return client.project.isArchived()
 }
 
+// IsPrivateVulnerabilityReportingEnabled returns whether confidential issues
+// can be opened, which is the case when issues are enabled.
+func (client *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return client.project.hasConfidentialIssues()
+}
+
+// IsPrivate returns whether the project isn't public, i.e. private or internal.
+func (client *Client) IsPrivate() (bool, error) {
+	return client.project.isPrivate()
+}
+
 func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
 	return client.branches.getDefaultBranch()
 }
@@ -240,6 +251,10 @@ func (client *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient,
 	return nil, fmt.Errorf("GetOrgRepoClient (GitLab): %w", clients.ErrUnsupportedFeature)
 }
 
+func (client *Client) ListPullRequests() ([]clients.PullRequest, error) {
+	return nil, fmt.Errorf("ListPullRequests (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
 func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return client.webhook.listWebhooks()
 }
@@ -184,6 +184,7 @@ func (handler *commitsHandler) zip(commitsRaw []*gitlab.Commit, data graphqlData
 				Message:                cRaw.Message,
 				SHA:                    cRaw.ID,
 				AssociatedMergeRequest: associatedMr,
+				Author:                 clients.User{Login: cRaw.AuthorEmail},
 			})
 	}
 
@@ -67,16 +67,22 @@ func (handler *issuesHandler) setup() error {
 				}
 			}
 
+			var labels []clients.Label
+			for _, label := range issue.Labels {
+				labels = append(labels, clients.Label{Name: label})
+			}
 			issueIDString := fmt.Sprint(issue.ID)
 			handler.issues = append(handler.issues,
 				clients.Issue{
 					URI:       &issueIDString,
 					CreatedAt: issue.CreatedAt,
+					ClosedAt:  issue.ClosedAt,
 					Author: &clients.User{
 						ID: int64(issue.Author.ID),
 					},
 					AuthorAssociation: &authorAssociation,
 					Comments:          nil,
+					Labels:            labels,
 				})
 		}
 	})
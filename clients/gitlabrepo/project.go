@@ -29,6 +29,9 @@ type projectHandler struct {
 	repourl   *Repo
 	createdAt time.Time
 	archived  bool
+	private   bool
+	// confidentialIssues is whether confidential issues can be opened.
+	confidentialIssues bool
 }
 
 func (handler *projectHandler) init(repourl *Repo) {
@@ -47,6 +50,8 @@ func (handler *projectHandler) setup() error {
 
 		handler.createdAt = *proj.CreatedAt
 		handler.archived = proj.Archived
+		handler.private = proj.Visibility != gitlab.PublicVisibility
+		handler.confidentialIssues = proj.IssuesAccessLevel != gitlab.DisabledAccessControl
 	})
 
 	return handler.errSetup
@@ -60,6 +65,14 @@ func (handler *projectHandler) isArchived() (bool, error) {
 	return handler.archived, nil
 }
 
+func (handler *projectHandler) isPrivate() (bool, error) {
+	if err := handler.setup(); err != nil {
+		return false, fmt.Errorf("error during projectHandler.setup: %w", err)
+	}
+
+	return handler.private, nil
+}
+
 func (handler *projectHandler) getCreatedAt() (time.Time, error) {
 	if err := handler.setup(); err != nil {
 		return time.Now(), fmt.Errorf("error during projectHandler.setup: %w", err)
@@ -67,3 +80,11 @@ func (handler *projectHandler) getCreatedAt() (time.Time, error) {
 
 	return handler.createdAt, nil
 }
+
+func (handler *projectHandler) hasConfidentialIssues() (bool, error) {
+	if err := handler.setup(); err != nil {
+		return false, fmt.Errorf("error during projectHandler.setup: %w", err)
+	}
+
+	return handler.confidentialIssues, nil
+}
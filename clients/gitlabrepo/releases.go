@@ -72,6 +72,9 @@ func releasesFrom(data []*gitlab.Release) []clients.Release {
 			TagName:         r.TagName,
 			TargetCommitish: r.CommitPath,
 		}
+		if r.ReleasedAt != nil {
+			release.PublishedAt = *r.ReleasedAt
+		}
 		if len(r.Assets.Links) > 0 {
 			release.URL = r.Assets.Links[0].DirectAssetURL
 		}
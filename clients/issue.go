@@ -18,11 +18,16 @@ import "time"
 
 // Issue represents a thread like GitHub issue comment thread.
 type Issue struct {
-	URI               *string
-	CreatedAt         *time.Time
+	URI       *string
+	CreatedAt *time.Time
+	// ClosedAt is when the issue was closed. It is nil if the issue is open.
+	ClosedAt          *time.Time
 	Author            *User
 	AuthorAssociation *RepoAssociation
-	Comments          []IssueComment
+	// Comments are the first and the most recent comments on the issue,
+	// oldest first.
+	Comments []IssueComment
+	Labels   []Label
 }
 
 // IssueComment represents a comment on an issue.
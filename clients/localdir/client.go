@@ -229,6 +229,21 @@ func (client *Client) ListStatuses(ref string) ([]clients.Status, error) {
 	return nil, fmt.Errorf("ListStatuses: %w", clients.ErrUnsupportedFeature)
 }
 
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled.
+func (client *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, fmt.Errorf("IsPrivateVulnerabilityReportingEnabled: %w", clients.ErrUnsupportedFeature)
+}
+
+// IsPrivate implements RepoClient.IsPrivate.
+func (client *Client) IsPrivate() (bool, error) {
+	return false, fmt.Errorf("IsPrivate: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListPullRequests implements RepoClient.ListPullRequests.
+func (client *Client) ListPullRequests() ([]clients.PullRequest, error) {
+	return nil, fmt.Errorf("ListPullRequests: %w", clients.ErrUnsupportedFeature)
+}
+
 // ListWebhooks implements RepoClient.ListWebhooks.
 func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return nil, fmt.Errorf("ListWebhooks: %w", clients.ErrUnsupportedFeature)
@@ -242,6 +242,21 @@ func (c *client) ListStatuses(ref string) ([]clients.Status, error) {
 	return nil, fmt.Errorf("ListStatuses: %w", clients.ErrUnsupportedFeature)
 }
 
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled.
+func (c *client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, fmt.Errorf("IsPrivateVulnerabilityReportingEnabled: %w", clients.ErrUnsupportedFeature)
+}
+
+// IsPrivate implements RepoClient.IsPrivate.
+func (c *client) IsPrivate() (bool, error) {
+	return false, fmt.Errorf("IsPrivate: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListPullRequests implements RepoClient.ListPullRequests.
+func (c *client) ListPullRequests() ([]clients.PullRequest, error) {
+	return nil, fmt.Errorf("ListPullRequests: %w", clients.ErrUnsupportedFeature)
+}
+
 // ListWebhooks implements RepoClient.ListWebhooks.
 func (c *client) ListWebhooks() ([]clients.Webhook, error) {
 	return nil, fmt.Errorf("ListWebhooks: %w", clients.ErrUnsupportedFeature)
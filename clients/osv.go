@@ -32,18 +32,60 @@ type osvClient struct {
 	local bool
 }
 
+// maxRunningScans bounds the osv-scanner scans running at once, including the
+// scans abandoned by ListUnfixedVulnerabilities.
+const maxRunningScans = 4
+
+// runningScans holds a slot for each osv-scanner scan still running.
+var runningScans = make(chan struct{}, maxRunningScans)
+
 // ListUnfixedVulnerabilities implements VulnerabilityClient.ListUnfixedVulnerabilities.
+//
+// This is not a real cancellation: osv-scanner takes no context, so once ctx is
+// done the scan is abandoned to finish in the background and the context's error
+// is returned. The abandoned scans keep their slot among the maxRunningScans until
+// they finish, so new scans wait for a slot, or for ctx to be done, rather than
+// piling up.
 func (v osvClient) ListUnfixedVulnerabilities(
 	ctx context.Context,
 	commit,
 	localPath string,
-) (_ VulnerabilitiesResponse, err error) {
+) (VulnerabilitiesResponse, error) {
+	select {
+	case runningScans <- struct{}{}:
+	case <-ctx.Done():
+		return VulnerabilitiesResponse{}, fmt.Errorf("osv-scanner: %w", ctx.Err())
+	}
+	type scanResult struct {
+		err  error
+		resp VulnerabilitiesResponse
+	}
+	done := make(chan scanResult, 1)
+	go func() {
+		defer func() { <-runningScans }()
+		resp, err := v.scan(ctx, commit, localPath)
+		done <- scanResult{resp: resp, err: err}
+	}()
+	select {
+	case res := <-done:
+		return res.resp, res.err
+	case <-ctx.Done():
+		return VulnerabilitiesResponse{}, fmt.Errorf("osv-scanner: %w", ctx.Err())
+	}
+}
+
+// scan runs osv-scanner. It only checks ctx before starting, as osv-scanner
+// can't be interrupted.
+func (v osvClient) scan(ctx context.Context, commit, localPath string) (_ VulnerabilitiesResponse, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = sce.CreateInternal(sce.ErrScorecardInternal, fmt.Sprintf("osv-scanner panic: %v", r))
 			fmt.Fprintf(os.Stderr, "osv-scanner panic: %v\n%s\n", r, string(debug.Stack()))
 		}
 	}()
+	if err := ctx.Err(); err != nil {
+		return VulnerabilitiesResponse{}, fmt.Errorf("osv-scanner: %w", err)
+	}
 	directoryPaths := []string{}
 	if localPath != "" {
 		directoryPaths = append(directoryPaths, localPath)
@@ -15,6 +15,7 @@ package clients
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -57,3 +58,14 @@ func TestEmptyProject(t *testing.T) {
 		t.Fatalf("empty directory shouldn't throw an error: %v", err)
 	}
 }
+
+func TestCancelledScan(t *testing.T) {
+	t.Parallel()
+	var client osvClient
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.ListUnfixedVulnerabilities(ctx, "", t.TempDir())
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("ListUnfixedVulnerabilities() error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -29,6 +29,24 @@ type PullRequest struct {
 	Labels   []Label
 	Reviews  []Review
 	MergedBy User
+	// CreatedAt is when the pull request was opened.
+	CreatedAt time.Time
+	// ReviewDecision is the review status of the pull request when it was
+	// merged: APPROVED, CHANGES_REQUESTED or REVIEW_REQUIRED. It is empty if
+	// the repository doesn't require reviews.
+	ReviewDecision string
+	// The fields below are only set for the pull requests returned by
+	// RepoClient.ListPullRequests.
+	URI string
+	// State is OPEN, CLOSED or MERGED.
+	State string
+	// ClosedAt is when the pull request was closed or merged. It is zero if
+	// the pull request is open.
+	ClosedAt          time.Time
+	AuthorAssociation *RepoAssociation
+	// Comments are the first and the most recent comments on the pull
+	// request, oldest first.
+	Comments []IssueComment
 }
 
 // Label represents a PR label.
@@ -40,4 +58,12 @@ type Label struct {
 type Review struct {
 	Author *User
 	State  string
+	// SubmittedAt is when the review was submitted. It is zero if the
+	// review was inferred, e.g. from the merge of the pull request.
+	SubmittedAt time.Time
+	// CommitSHA is the head commit of the pull request when the review was
+	// submitted.
+	CommitSHA string
+	// AuthorAssociation is the association of the reviewer with the repository.
+	AuthorAssociation *RepoAssociation
 }
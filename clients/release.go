@@ -14,12 +14,20 @@
 
 package clients
 
+import "time"
+
 // Release represents a release version of a package/repo.
 type Release struct {
 	TagName         string
 	URL             string
 	TargetCommitish string
 	Assets          []ReleaseAsset
+	// PublishedAt is when the release was published. It is zero if the
+	// forge doesn't report it.
+	PublishedAt time.Time
+	// TagSignature is the signature of the release's tag, e.g. of a signed
+	// annotated git tag. It is empty if the tag isn't signed.
+	TagSignature string
 }
 
 // ReleaseAsset is part of the Release bundle.
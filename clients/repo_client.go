@@ -33,6 +33,13 @@ type RepoClient interface {
 	InitRepo(repo Repo, commitSHA string, commitDepth int) error
 	URI() string
 	IsArchived() (bool, error)
+	// IsPrivateVulnerabilityReportingEnabled returns whether vulnerabilities
+	// can be reported privately through the forge, e.g. with GitHub private
+	// vulnerability reporting or GitLab confidential issues.
+	IsPrivateVulnerabilityReportingEnabled() (bool, error)
+	// IsPrivate returns whether the repository is hidden from the public,
+	// e.g. a private or internal repository.
+	IsPrivate() (bool, error)
 	ListFiles(predicate func(string) (bool, error)) ([]string, error)
 	// Returns an absolute path to the local repository
 	// in the format that matches the local OS
@@ -47,6 +54,9 @@ type RepoClient interface {
 	GetOrgRepoClient(context.Context) (RepoClient, error)
 	ListCommits() ([]Commit, error)
 	ListIssues() ([]Issue, error)
+	// ListPullRequests returns the most recently created pull requests,
+	// regardless of their state.
+	ListPullRequests() ([]PullRequest, error)
 	ListLicenses() ([]License, error)
 	ListReleases() ([]Release, error)
 	ListContributors() ([]User, error)
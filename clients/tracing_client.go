@@ -0,0 +1,238 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ossf/scorecard/v5/stats"
+)
+
+// tracingRepoClient wraps a RepoClient, recording a span and a latency
+// measurement for every call. RepoClient methods don't take a context, so
+// spans are parented to the context the wrapper was created with.
+// Calls also fail fast once that context is done.
+// If the inner client implements contextRepoClient, it makes each call with
+// the context of the call's span, so its requests are traced as children.
+type tracingRepoClient struct {
+	inner RepoClient
+	ctx   context.Context
+	repo  string
+	check string
+}
+
+// NewTracingRepoClient returns a RepoClient which traces calls to client as
+// children of the span in ctx, attributed to the given repo and check.
+// Use UnwrapRepoClient to access the underlying implementation.
+func NewTracingRepoClient(ctx context.Context, client RepoClient, repo, check string) RepoClient {
+	if client == nil {
+		return nil
+	}
+	// Avoid stacking wrappers if a request is traced more than once.
+	client = UnwrapRepoClient(client)
+	return &tracingRepoClient{inner: client, ctx: ctx, repo: repo, check: check}
+}
+
+// UnwrapRepoClient returns the RepoClient implementation underneath any
+// tracing wrapper. Callers switching on the concrete client type should
+// switch on the unwrapped client.
+func UnwrapRepoClient(client RepoClient) RepoClient {
+	if t, ok := client.(*tracingRepoClient); ok {
+		return t.inner
+	}
+	return client
+}
+
+// contextRepoClient is implemented by RepoClients which can make their calls
+// with a per-call context, e.g. githubrepo.Client.
+type contextRepoClient interface {
+	WithContext(ctx context.Context) RepoClient
+}
+
+func (t *tracingRepoClient) start(method string) (context.Context, func(error)) {
+	ctx, span := stats.Tracer().Start(t.ctx, "RepoClient."+method,
+		trace.WithAttributes(
+			stats.AttrClientMethod.String(method),
+			stats.AttrCheck.String(t.check),
+			stats.AttrRepo.String(t.repo),
+		))
+	start := time.Now()
+	return ctx, func(err error) {
+		stats.PromClientCallDuration.WithLabelValues(method, t.check).Observe(time.Since(start).Seconds())
+		if err != nil && !errors.Is(err, ErrUnsupportedFeature) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// call traces a call to the inner client. Calls made once the context of the
+// wrapper is done fail with the context's error, so a check that was
+// cancelled or timed out stops querying the repository.
+func call[T any](t *tracingRepoClient, method string, fn func(RepoClient) (T, error)) (T, error) {
+	ctx, end := t.start(method)
+	if err := t.ctx.Err(); err != nil {
+		end(err)
+		var zero T
+		return zero, err //nolint:wrapcheck // context errors are checked with errors.Is
+	}
+	client := t.inner
+	if c, ok := client.(contextRepoClient); ok {
+		client = c.WithContext(ctx)
+	}
+	ret, err := fn(client)
+	end(err)
+	return ret, err //nolint:wrapcheck // transparent wrapper
+}
+
+func (t *tracingRepoClient) InitRepo(repo Repo, commitSHA string, commitDepth int) error {
+	_, end := t.start("InitRepo")
+	err := t.inner.InitRepo(repo, commitSHA, commitDepth)
+	end(err)
+	return err //nolint:wrapcheck // transparent wrapper
+}
+
+func (t *tracingRepoClient) URI() string {
+	return t.inner.URI()
+}
+
+func (t *tracingRepoClient) IsArchived() (bool, error) {
+	return call(t, "IsArchived", RepoClient.IsArchived)
+}
+
+func (t *tracingRepoClient) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
+	return call(t, "ListFiles", func(c RepoClient) ([]string, error) {
+		return c.ListFiles(predicate)
+	})
+}
+
+func (t *tracingRepoClient) LocalPath() (string, error) {
+	return t.inner.LocalPath() //nolint:wrapcheck // transparent wrapper
+}
+
+func (t *tracingRepoClient) GetFileReader(filename string) (io.ReadCloser, error) {
+	return call(t, "GetFileReader", func(c RepoClient) (io.ReadCloser, error) {
+		return c.GetFileReader(filename)
+	})
+}
+
+func (t *tracingRepoClient) GetBranch(branch string) (*BranchRef, error) {
+	return call(t, "GetBranch", func(c RepoClient) (*BranchRef, error) {
+		return c.GetBranch(branch)
+	})
+}
+
+func (t *tracingRepoClient) GetCreatedAt() (time.Time, error) {
+	return call(t, "GetCreatedAt", RepoClient.GetCreatedAt)
+}
+
+func (t *tracingRepoClient) GetDefaultBranchName() (string, error) {
+	return call(t, "GetDefaultBranchName", RepoClient.GetDefaultBranchName)
+}
+
+func (t *tracingRepoClient) GetDefaultBranch() (*BranchRef, error) {
+	return call(t, "GetDefaultBranch", RepoClient.GetDefaultBranch)
+}
+
+func (t *tracingRepoClient) GetOrgRepoClient(ctx context.Context) (RepoClient, error) {
+	ret, err := call(t, "GetOrgRepoClient", func(c RepoClient) (RepoClient, error) {
+		return c.GetOrgRepoClient(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewTracingRepoClient(t.ctx, ret, t.repo, t.check), nil
+}
+
+func (t *tracingRepoClient) ListCommits() ([]Commit, error) {
+	return call(t, "ListCommits", RepoClient.ListCommits)
+}
+
+func (t *tracingRepoClient) ListIssues() ([]Issue, error) {
+	return call(t, "ListIssues", RepoClient.ListIssues)
+}
+
+func (t *tracingRepoClient) ListLicenses() ([]License, error) {
+	return call(t, "ListLicenses", RepoClient.ListLicenses)
+}
+
+func (t *tracingRepoClient) ListReleases() ([]Release, error) {
+	return call(t, "ListReleases", RepoClient.ListReleases)
+}
+
+func (t *tracingRepoClient) ListContributors() ([]User, error) {
+	return call(t, "ListContributors", RepoClient.ListContributors)
+}
+
+func (t *tracingRepoClient) ListSuccessfulWorkflowRuns(filename string) ([]WorkflowRun, error) {
+	return call(t, "ListSuccessfulWorkflowRuns", func(c RepoClient) ([]WorkflowRun, error) {
+		return c.ListSuccessfulWorkflowRuns(filename)
+	})
+}
+
+func (t *tracingRepoClient) ListCheckRunsForRef(ref string) ([]CheckRun, error) {
+	return call(t, "ListCheckRunsForRef", func(c RepoClient) ([]CheckRun, error) {
+		return c.ListCheckRunsForRef(ref)
+	})
+}
+
+func (t *tracingRepoClient) ListStatuses(ref string) ([]Status, error) {
+	return call(t, "ListStatuses", func(c RepoClient) ([]Status, error) {
+		return c.ListStatuses(ref)
+	})
+}
+
+func (t *tracingRepoClient) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return call(t, "IsPrivateVulnerabilityReportingEnabled", RepoClient.IsPrivateVulnerabilityReportingEnabled)
+}
+
+func (t *tracingRepoClient) IsPrivate() (bool, error) {
+	return call(t, "IsPrivate", RepoClient.IsPrivate)
+}
+
+func (t *tracingRepoClient) ListPullRequests() ([]PullRequest, error) {
+	return call(t, "ListPullRequests", RepoClient.ListPullRequests)
+}
+
+func (t *tracingRepoClient) ListWebhooks() ([]Webhook, error) {
+	return call(t, "ListWebhooks", RepoClient.ListWebhooks)
+}
+
+func (t *tracingRepoClient) ListProgrammingLanguages() ([]Language, error) {
+	return call(t, "ListProgrammingLanguages", RepoClient.ListProgrammingLanguages)
+}
+
+func (t *tracingRepoClient) Search(request SearchRequest) (SearchResponse, error) {
+	return call(t, "Search", func(c RepoClient) (SearchResponse, error) {
+		return c.Search(request)
+	})
+}
+
+func (t *tracingRepoClient) SearchCommits(request SearchCommitsOptions) ([]Commit, error) {
+	return call(t, "SearchCommits", func(c RepoClient) ([]Commit, error) {
+		return c.SearchCommits(request)
+	})
+}
+
+func (t *tracingRepoClient) Close() error {
+	return t.inner.Close() //nolint:wrapcheck // transparent wrapper
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ossf/scorecard/v5/stats"
+)
+
+var errStub = errors.New("stub error")
+
+// stubRepoClient implements only the methods exercised by the tests.
+type stubRepoClient struct {
+	RepoClient
+}
+
+func (s *stubRepoClient) ListCommits() ([]Commit, error) {
+	return []Commit{{SHA: "abc"}}, nil
+}
+
+func (s *stubRepoClient) ListReleases() ([]Release, error) {
+	return nil, errStub
+}
+
+func (s *stubRepoClient) ListWebhooks() ([]Webhook, error) {
+	return nil, ErrUnsupportedFeature
+}
+
+// contextStubRepoClient records the context of the last call.
+type contextStubRepoClient struct {
+	stubRepoClient
+	ctx context.Context
+}
+
+func (s *contextStubRepoClient) WithContext(ctx context.Context) RepoClient {
+	s.ctx = ctx
+	return s
+}
+
+//nolint:paralleltest // modifies the global tracer provider.
+func TestTracingRepoClient(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "check")
+	inner := &stubRepoClient{}
+	client := NewTracingRepoClient(ctx, inner, "github.com/owner/repo", "Some-Check")
+
+	if got := UnwrapRepoClient(client); got != inner {
+		t.Errorf("UnwrapRepoClient() = %v, want the inner client", got)
+	}
+	if got := UnwrapRepoClient(NewTracingRepoClient(ctx, client, "github.com/owner/repo", "Other-Check")); got != inner {
+		t.Errorf("wrapping twice should not stack wrappers")
+	}
+
+	commits, err := client.ListCommits()
+	if err != nil || len(commits) != 1 {
+		t.Fatalf("ListCommits() = %v, %v", commits, err)
+	}
+	if _, err := client.ListReleases(); !errors.Is(err, errStub) {
+		t.Errorf("ListReleases() error = %v, want %v", err, errStub)
+	}
+	if _, err := client.ListWebhooks(); !errors.Is(err, ErrUnsupportedFeature) {
+		t.Errorf("ListWebhooks() error = %v, want %v", err, ErrUnsupportedFeature)
+	}
+	parent.End()
+
+	want := map[string]codes.Code{
+		"RepoClient.ListCommits":  codes.Unset,
+		"RepoClient.ListReleases": codes.Error,
+		// Unsupported features are expected and not reported as errors.
+		"RepoClient.ListWebhooks": codes.Unset,
+	}
+	spans := recorder.Ended()
+	if len(spans) != len(want)+1 {
+		t.Fatalf("got %d spans, want %d", len(spans), len(want)+1)
+	}
+	for _, span := range spans {
+		if span.Name() == "check" {
+			continue
+		}
+		code, ok := want[span.Name()]
+		if !ok {
+			t.Errorf("unexpected span %q", span.Name())
+			continue
+		}
+		if span.Status().Code != code {
+			t.Errorf("span %q status = %v, want %v", span.Name(), span.Status().Code, code)
+		}
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("span %q is not a child of the check span", span.Name())
+		}
+		var check, repo string
+		for _, attr := range span.Attributes() {
+			switch attr.Key {
+			case stats.AttrCheck:
+				check = attr.Value.AsString()
+			case stats.AttrRepo:
+				repo = attr.Value.AsString()
+			}
+		}
+		if check != "Some-Check" {
+			t.Errorf("span %q check attribute = %q, want %q", span.Name(), check, "Some-Check")
+		}
+		if repo != "github.com/owner/repo" {
+			t.Errorf("span %q repo attribute = %q, want %q", span.Name(), repo, "github.com/owner/repo")
+		}
+	}
+}
+
+//nolint:paralleltest // modifies the global tracer provider.
+func TestTracingRepoClient_callContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	inner := &contextStubRepoClient{}
+	client := NewTracingRepoClient(context.Background(), inner, "github.com/owner/repo", "Some-Check")
+	if _, err := client.ListCommits(); err != nil {
+		t.Fatalf("ListCommits() error = %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	if inner.ctx == nil {
+		t.Fatal("the inner client was not called with a context")
+	}
+	if got := trace.SpanContextFromContext(inner.ctx).SpanID(); got != spans[0].SpanContext().SpanID() {
+		t.Errorf("call context span = %v, want the RepoClient.ListCommits span %v", got, spans[0].SpanContext().SpanID())
+	}
+}
+
+func TestTracingRepoClient_cancelled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	client := NewTracingRepoClient(ctx, &stubRepoClient{}, "github.com/owner/repo", "Some-Check")
+	if _, err := client.ListCommits(); err != nil {
+		t.Fatalf("ListCommits() error = %v", err)
+	}
+	cancel()
+	if _, err := client.ListCommits(); !errors.Is(err, context.Canceled) {
+		t.Errorf("ListCommits() error = %v, want %v", err, context.Canceled)
+	}
+}
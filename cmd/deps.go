@@ -0,0 +1,228 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/githubrepo"
+	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
+	"github.com/ossf/scorecard/v5/clients/localdir"
+	pmc "github.com/ossf/scorecard/v5/cmd/internal/packagemanager"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sclog "github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/deps"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+var (
+	errRepoOrLocalRequired = errors.New("exactly one of a repository or a local directory must be set")
+	errDepsFormat          = errors.New("unsupported format")
+	errDepsPolicy          = errors.New("dependencies violate the policy")
+)
+
+type depsOptions struct {
+	cacheDir        string
+	cacheMaxAge     time.Duration
+	minScore        float64
+	maxDependencies int
+	lowest          int
+	failUnscored    bool
+	scan            bool
+}
+
+func depsCmd(o *options.Options) *cobra.Command {
+	opts := depsOptions{}
+	cmd := &cobra.Command{
+		Use:   "deps --repo=<repo>|--local=<dir>",
+		Short: "Score the dependencies of a project",
+		Long: `Read the lockfiles and SBOMs of a project, map each dependency to its source
+repository using deps.dev and the package registries, and report the
+dependency tree with the Scorecard score of each dependency.
+
+Scores are read from the cache directory, then from the results of the weekly
+Scorecard scans, and the repositories missing from both are scanned when
+--scan is set. With --min-score, the command fails when a dependency scores
+below the threshold.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if (o.Repo == "") == (o.Local == "") {
+				return fmt.Errorf("--%s, --%s: %w", options.FlagRepo, options.FlagLocal, errRepoOrLocalRequired)
+			}
+			if o.Format != options.FormatDefault && o.Format != options.FormatJSON {
+				return fmt.Errorf("--%s %q: %w", options.FlagFormat, o.Format, errDepsFormat)
+			}
+			cmd.SilenceUsage = true
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return scoreDependencies(cmd.Context(), o, &opts)
+		},
+	}
+	cmd.Flags().StringVar(&o.Repo, options.FlagRepo, o.Repo, "repository whose dependencies are scored")
+	cmd.Flags().StringVar(&o.Local, options.FlagLocal, o.Local, "local directory whose dependencies are scored")
+	cmd.Flags().StringVar(&o.Commit, options.FlagCommit, o.Commit, "commit to read the dependencies from")
+	cmd.Flags().StringVar(&o.Format, options.FlagFormat, o.Format, "output format: default or json")
+	cmd.Flags().StringVar(&o.LogLevel, options.FlagLogLevel, o.LogLevel, "log level")
+	cmd.Flags().Float64Var(&opts.minScore, "min-score", 0,
+		"fail when a dependency scores below this score (if <= 0, no dependency fails)")
+	cmd.Flags().BoolVar(&opts.failUnscored, "fail-unscored", false,
+		"with --min-score, also fail when a dependency can't be scored")
+	cmd.Flags().BoolVar(&opts.scan, "scan", false,
+		"scan the repositories of the dependencies which have no published score")
+	cmd.Flags().StringVar(&opts.cacheDir, "cache-dir", "",
+		"directory caching the scores of the dependencies, as Scorecard JSON results")
+	cmd.Flags().DurationVar(&opts.cacheMaxAge, "cache-max-age", 7*24*time.Hour,
+		"age after which cached scores are ignored (if <= 0, cached scores never expire)")
+	cmd.Flags().IntVar(&opts.maxDependencies, "max-dependencies", 500,
+		"maximum number of dependencies scored (if <= 0, all are scored)")
+	cmd.Flags().IntVar(&opts.lowest, "lowest", 10, "number of lowest-scoring dependencies to report")
+	return cmd
+}
+
+func scoreDependencies(ctx context.Context, o *options.Options, opts *depsOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	logger := sclog.NewLogger(sclog.ParseLevel(o.LogLevel))
+	client, err := depsRepoClient(ctx, o, logger)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	checkDocs, err := docs.Read()
+	if err != nil {
+		return fmt.Errorf("cannot read yaml file: %w", err)
+	}
+	scorers := []deps.Scorer{&deps.APIScorer{}}
+	if opts.scan {
+		scorers = append(scorers, deps.ScorerFunc(func(ctx context.Context, repo string) (deps.Score, error) {
+			return scanDependency(ctx, repo, o, checkDocs)
+		}))
+	}
+	scorer := deps.FirstOf(scorers...)
+	if opts.cacheDir != "" {
+		scorer = &deps.CacheScorer{Dir: opts.cacheDir, MaxAge: opts.cacheMaxAge, Next: scorer}
+	}
+
+	manager := &pmc.PackageManagerClient{}
+	report, err := deps.Analyze(ctx, client,
+		deps.WithScorer(scorer),
+		deps.WithResolver(func(_ context.Context, purl string) (string, error) {
+			resolution, err := fetchGitRepositoryFromPURL(purl, manager)
+			if err != nil {
+				return "", err
+			}
+			return resolution.repo, nil
+		}),
+		deps.WithMaxDependencies(opts.maxDependencies),
+		deps.WithLowest(opts.lowest),
+	)
+	if err != nil {
+		return fmt.Errorf("deps.Analyze: %w", err)
+	}
+
+	if o.Format == options.FormatJSON {
+		err = report.AsJSON(os.Stdout)
+	} else {
+		err = report.AsString(os.Stdout)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to format results: %w", err)
+	}
+
+	if opts.minScore <= 0 {
+		return nil
+	}
+	policy := deps.Policy{MinScore: opts.minScore, FailUnscored: opts.failUnscored}
+	violations := policy.Violations(report)
+	for _, d := range violations {
+		if d.Score == nil {
+			fmt.Fprintf(os.Stderr, "%s has no score: %s\n", d.PURL, d.Error)
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "%s (%s) scores %.1f, below %.1f\n", d.PURL, d.Repo, d.Score.Value, opts.minScore)
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("%w: %d dependencies", errDepsPolicy, len(violations))
+	}
+	return nil
+}
+
+// depsRepoClient returns a client initialized with the repository whose
+// dependencies are scored.
+func depsRepoClient(ctx context.Context, o *options.Options, logger *sclog.Logger) (clients.RepoClient, error) {
+	var repo clients.Repo
+	var client clients.RepoClient
+	var err error
+	if o.Local != "" {
+		repo, err = localdir.MakeLocalDirRepo(o.Local)
+		if err != nil {
+			return nil, fmt.Errorf("making local dir: %w", err)
+		}
+		client = localdir.CreateLocalDirClient(ctx, logger)
+	} else {
+		repo, err = makeRepo(o.Repo)
+		if err != nil {
+			return nil, fmt.Errorf("making remote repo: %w", err)
+		}
+		switch repo.(type) {
+		case *githubrepo.Repo:
+			client, err = githubrepo.NewRepoClient(ctx)
+		case *gitlabrepo.Repo:
+			client, err = gitlabrepo.CreateGitlabClient(ctx, repo.Host())
+		case *azuredevopsrepo.Repo:
+			client, err = azuredevopsrepo.CreateAzureDevOpsClient(ctx, repo)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("creating repo client: %w", err)
+		}
+	}
+	if err := client.InitRepo(repo, o.Commit, 0); err != nil {
+		return nil, fmt.Errorf("InitRepo: %w", err)
+	}
+	return client, nil
+}
+
+// scanDependency runs Scorecard on the repository of a dependency.
+func scanDependency(ctx context.Context, uri string, o *options.Options, checkDocs docs.Doc) (deps.Score, error) {
+	repo, err := makeRepo(uri)
+	if err != nil {
+		return deps.Score{}, fmt.Errorf("making remote repo: %w", err)
+	}
+	result, err := scorecard.Run(ctx, repo, scorecard.WithLogLevel(sclog.ParseLevel(o.LogLevel)))
+	if err != nil {
+		return deps.Score{}, fmt.Errorf("scorecard.Run: %w", err)
+	}
+	score, err := result.GetAggregateScore(checkDocs)
+	if err != nil {
+		return deps.Score{}, fmt.Errorf("GetAggregateScore: %w", err)
+	}
+	return deps.Score{
+		Date:   result.Date,
+		Commit: result.Repo.CommitSHA,
+		Source: "scan",
+		Value:  score,
+	}, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/bundle"
+	"github.com/ossf/scorecard/v5/clients/githubrepo"
+	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
+	"github.com/ossf/scorecard/v5/clients/ossfuzz"
+	"github.com/ossf/scorecard/v5/internal/packageclient"
+	"github.com/ossf/scorecard/v5/options"
+)
+
+var (
+	errRepoRequired         = errors.New("a repository must be set")
+	errBundleOutputRequired = errors.New("an output file must be set")
+)
+
+func exportBundleCmd(o *options.Options) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "export-bundle --repo=<repo> --output=<file>",
+		Short: "Export the data needed to check a repository offline",
+		Long: `Export everything Scorecard's clients return for a repository into a single
+archive. The archive can be checked later without network access using
+"scorecard --bundle=<file>".`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if o.Repo == "" {
+				return fmt.Errorf("--%s: %w", options.FlagRepo, errRepoRequired)
+			}
+			if output == "" {
+				return fmt.Errorf("--%s: %w", options.FlagResultsFile, errBundleOutputRequired)
+			}
+			cmd.SilenceUsage = true
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return exportBundle(cmd.Context(), o, output)
+		},
+	}
+	cmd.Flags().StringVar(&o.Repo, options.FlagRepo, o.Repo, "repository to export")
+	cmd.Flags().StringVar(&o.Commit, options.FlagCommit, o.Commit, "commit to export")
+	cmd.Flags().IntVar(&o.CommitDepth, options.FlagCommitDepth, o.CommitDepth,
+		"number of commits to export (if <= 0, the client default is used)")
+	cmd.Flags().StringVarP(&output, options.FlagResultsFile, options.ShorthandFlagResultsFile, "",
+		"file to write the bundle to")
+	return cmd
+}
+
+func exportBundle(ctx context.Context, o *options.Options, output string) error {
+	repo, err := makeRepo(o.Repo)
+	if err != nil {
+		return fmt.Errorf("making remote repo: %w", err)
+	}
+
+	cfg := &bundle.ExportConfig{
+		CIIClient:     clients.DefaultCIIBestPracticesClient(),
+		ProjectClient: packageclient.CreateDepsDevClient(),
+		VulnClient:    clients.DefaultVulnerabilitiesClient(),
+		CommitSHA:     o.Commit,
+		CommitDepth:   o.CommitDepth,
+	}
+	switch repo.(type) {
+	case *githubrepo.Repo:
+		cfg.Forge = bundle.ForgeGitHub
+		cfg.RepoClient, err = githubrepo.NewRepoClient(ctx)
+	case *gitlabrepo.Repo:
+		cfg.Forge = bundle.ForgeGitLab
+		cfg.RepoClient, err = gitlabrepo.CreateGitlabClient(ctx, repo.Host())
+	case *azuredevopsrepo.Repo:
+		cfg.Forge = bundle.ForgeAzureDevOps
+		cfg.RepoClient, err = azuredevopsrepo.CreateAzureDevOpsClient(ctx, repo)
+	}
+	if err != nil {
+		return fmt.Errorf("creating repo client: %w", err)
+	}
+
+	ossFuzzClient, err := ossfuzz.CreateOSSFuzzClientEager(ossfuzz.StatusURL)
+	if err != nil {
+		return fmt.Errorf("creating OSS-Fuzz client: %w", err)
+	}
+	defer ossFuzzClient.Close()
+	cfg.OSSFuzzClient = ossFuzzClient
+
+	f, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("creating bundle file: %w", err)
+	}
+	defer f.Close()
+
+	if err := bundle.Export(ctx, f, repo, cfg); err != nil {
+		return fmt.Errorf("exporting bundle: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Wrote bundle for %s to %s\n", repo.URI(), output)
+	return nil
+}
@@ -27,6 +27,9 @@ type Client interface {
 	GetURI(URI string) (*http.Response, error)
 }
 
+// userAgent identifies scorecard to the package registries.
+const userAgent = "ossf-scorecard"
+
 type PackageManagerClient struct{}
 
 func (c *PackageManagerClient) Get(url, packageName string) (*http.Response, error) {
@@ -43,6 +46,12 @@ func (c *PackageManagerClient) getRemoteURL(url string) (*http.Response, error)
 	client := &http.Client{
 		Timeout: timeout * time.Second,
 	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequest: %w", err)
+	}
+	// Some registries, e.g. crates.io, reject requests without a user agent.
+	req.Header.Set("User-Agent", userAgent)
 	//nolint:wrapcheck
-	return client.Get(url)
+	return client.Do(req)
 }
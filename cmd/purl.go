@@ -0,0 +1,422 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/package-url/packageurl-go"
+
+	ngt "github.com/ossf/scorecard/v5/cmd/internal/nuget"
+	pmc "github.com/ossf/scorecard/v5/cmd/internal/packagemanager"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// maxParentPOMs bounds the number of parent POMs followed to find the scm of
+// a Maven artifact.
+const maxParentPOMs = 3
+
+var azureDevOpsDomainRegexp = regexp.MustCompile(`^https?://dev[.]azure[.]com/([^/]+)/([^/]+)/_git/([^/?#]+)`)
+
+// repoMatchers normalize the URLs of the forges supported by scorecard.
+var repoMatchers = append(slices.Clone(pypiMatchers), func(url string) string {
+	match := azureDevOpsDomainRegexp.FindStringSubmatch(url)
+	if len(match) >= 4 {
+		return fmt.Sprintf("https://dev.azure.com/%s/%s/_git/%s", match[1], match[2], match[3])
+	}
+	return ""
+})
+
+// purlResolver returns the source repository of the package.
+type purlResolver func(p *packageurl.PackageURL, manager pmc.Client) (string, error)
+
+var purlResolvers = map[string]purlResolver{
+	packageurl.TypeNPM: func(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+		return fetchGitRepositoryFromNPM(purlName(p, "/"), manager)
+	},
+	packageurl.TypePyPi: func(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+		return fetchGitRepositoryFromPYPI(p.Name, manager)
+	},
+	packageurl.TypeGem: func(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+		return fetchGitRepositoryFromRubyGems(p.Name, manager)
+	},
+	packageurl.TypeNuget: func(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+		return fetchGitRepositoryFromNuget(p.Name, &ngt.NugetClient{Manager: manager})
+	},
+	packageurl.TypeCargo:     fetchGitRepositoryFromCratesIO,
+	packageurl.TypeMaven:     fetchGitRepositoryFromMavenCentral,
+	packageurl.TypeGolang:    fetchGitRepositoryFromGoProxy,
+	packageurl.TypeComposer:  fetchGitRepositoryFromPackagist,
+	packageurl.TypeHex:       fetchGitRepositoryFromHex,
+	packageurl.TypePub:       fetchGitRepositoryFromPubDev,
+	packageurl.TypeCocoapods: fetchGitRepositoryFromCocoaPods,
+	packageurl.TypeConda:     fetchGitRepositoryFromConda,
+}
+
+// purlResolution is the source repository resolved for a package URL.
+type purlResolution struct {
+	purl        packageurl.PackageURL
+	repo        string
+	reciprocity reciprocity
+}
+
+// metadata returns the result metadata recording the resolution.
+func (r *purlResolution) metadata() []string {
+	return []string{
+		"purl=" + r.purl.ToString(),
+		"purl-repository=" + r.repo,
+		"purl-reciprocity=" + string(r.reciprocity),
+	}
+}
+
+// fetchGitRepositoryFromPURL resolves the source repository of the package
+// identified by a package URL, and checks whether the repository claims it.
+func fetchGitRepositoryFromPURL(purl string, manager pmc.Client) (purlResolution, error) {
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		return purlResolution{}, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("invalid purl %q: %v", purl, err))
+	}
+	resolve, ok := purlResolvers[p.Type]
+	if !ok {
+		return purlResolution{}, sce.WithMessage(sce.ErrScorecardInternal,
+			fmt.Sprintf("unsupported purl type: %s", p.Type))
+	}
+	repo, err := resolve(&p, manager)
+	if err != nil {
+		return purlResolution{}, err
+	}
+	repo = normalizeRepoURL(repo)
+	return purlResolution{
+		purl:        p,
+		repo:        repo,
+		reciprocity: checkReciprocity(&p, repo, manager),
+	}, nil
+}
+
+// purlName returns the namespace and name of the package joined by sep.
+func purlName(p *packageurl.PackageURL, sep string) string {
+	if p.Namespace == "" {
+		return p.Name
+	}
+	return p.Namespace + sep + p.Name
+}
+
+// normalizeRepoURL returns the canonical URL of repositories hosted on the
+// supported forges, or the URL stripped of its git decorations otherwise.
+func normalizeRepoURL(repo string) string {
+	repo = strings.TrimSpace(repo)
+	repo = strings.TrimPrefix(repo, "git+")
+	repo = strings.TrimPrefix(repo, "scm:git:")
+	repo = strings.Replace(repo, "git://", "https://", 1)
+	repo = strings.Replace(repo, "ssh://git@", "https://", 1)
+	if strings.HasPrefix(repo, "git@") {
+		repo = "https://" + strings.Replace(strings.TrimPrefix(repo, "git@"), ":", "/", 1)
+	}
+	if match := findRepoInURLs(repo); match != "" {
+		return match
+	}
+	return strings.TrimSuffix(repo, ".git")
+}
+
+// findRepoInURLs returns the first URL pointing to a repository on a
+// supported forge.
+func findRepoInURLs(urls ...string) string {
+	for _, u := range urls {
+		for _, matcher := range repoMatchers {
+			if repo := matcher(u); repo != "" {
+				return repo
+			}
+		}
+	}
+	return ""
+}
+
+// getJSON decodes the JSON response of a package registry.
+func getJSON(manager pmc.Client, uri, registry string, v any) error {
+	resp, err := manager.GetURI(uri)
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to get %s package json: %v", registry, err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return sce.WithMessage(sce.ErrScorecardInternal,
+			fmt.Sprintf("failed to get %s package json: %s", registry, resp.Status))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to parse %s package json: %v", registry, err))
+	}
+	return nil
+}
+
+func errNoSourceRepo(registry, name string) error {
+	return sce.WithMessage(sce.ErrScorecardInternal,
+		fmt.Sprintf("could not find source repo for %s package: %s", registry, name))
+}
+
+type cratesIOResult struct {
+	Crate struct {
+		Repository string `json:"repository"`
+		Homepage   string `json:"homepage"`
+	} `json:"crate"`
+}
+
+// Gets the source repository URL for the crates.io package.
+func fetchGitRepositoryFromCratesIO(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+	v := &cratesIOResult{}
+	if err := getJSON(manager, "https://crates.io/api/v1/crates/"+url.PathEscape(p.Name), "crates.io", v); err != nil {
+		return "", err
+	}
+	if repo := findRepoInURLs(v.Crate.Repository, v.Crate.Homepage); repo != "" {
+		return repo, nil
+	}
+	if v.Crate.Repository == "" {
+		return "", errNoSourceRepo("crates.io", p.Name)
+	}
+	return v.Crate.Repository, nil
+}
+
+type mavenMetadata struct {
+	Versioning struct {
+		Release string `xml:"release"`
+		Latest  string `xml:"latest"`
+	} `xml:"versioning"`
+}
+
+type mavenPOM struct {
+	Parent struct {
+		GroupID    string `xml:"groupId"`
+		ArtifactID string `xml:"artifactId"`
+		Version    string `xml:"version"`
+	} `xml:"parent"`
+	SCM struct {
+		URL        string `xml:"url"`
+		Connection string `xml:"connection"`
+	} `xml:"scm"`
+	URL string `xml:"url"`
+}
+
+const mavenCentralURL = "https://repo1.maven.org/maven2"
+
+func getXML(manager pmc.Client, uri, registry string, v any) error {
+	resp, err := manager.GetURI(uri)
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to get %s metadata: %v", registry, err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to get %s metadata: %s", registry, resp.Status))
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(v); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to parse %s metadata: %v", registry, err))
+	}
+	return nil
+}
+
+// Gets the source repository URL for the Maven Central artifact from the
+// scm section of its POM, or of its parents' POMs.
+func fetchGitRepositoryFromMavenCentral(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+	if p.Namespace == "" {
+		return "", sce.WithMessage(sce.ErrScorecardInternal, "maven purl must have a group id namespace")
+	}
+	group, artifact, version := p.Namespace, p.Name, p.Version
+	if version == "" {
+		metadata := &mavenMetadata{}
+		uri := fmt.Sprintf("%s/%s/%s/maven-metadata.xml", mavenCentralURL, strings.ReplaceAll(group, ".", "/"), artifact)
+		if err := getXML(manager, uri, "maven", metadata); err != nil {
+			return "", err
+		}
+		version = metadata.Versioning.Release
+		if version == "" {
+			version = metadata.Versioning.Latest
+		}
+	}
+	for i := 0; i <= maxParentPOMs && version != ""; i++ {
+		pom := &mavenPOM{}
+		uri := fmt.Sprintf("%s/%s/%s/%s/%s-%s.pom", mavenCentralURL,
+			strings.ReplaceAll(group, ".", "/"), artifact, version, artifact, version)
+		if err := getXML(manager, uri, "maven", pom); err != nil {
+			return "", err
+		}
+		if repo := findRepoInURLs(pom.SCM.URL, pom.SCM.Connection, pom.URL); repo != "" {
+			return repo, nil
+		}
+		group, artifact, version = pom.Parent.GroupID, pom.Parent.ArtifactID, pom.Parent.Version
+	}
+	return "", errNoSourceRepo("maven", purlName(p, ":"))
+}
+
+type goProxyResult struct {
+	Origin struct {
+		VCS string `json:"VCS"`
+		URL string `json:"URL"`
+	} `json:"Origin"`
+}
+
+// escapeModulePath escapes a module path for the module proxy protocol.
+func escapeModulePath(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if unicode.IsUpper(r) {
+			b.WriteByte('!')
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Gets the source repository URL for the Go module from the module proxy.
+func fetchGitRepositoryFromGoProxy(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+	module := purlName(p, "/")
+	v := &goProxyResult{}
+	err := getJSON(manager, fmt.Sprintf("https://proxy.golang.org/%s/@latest", escapeModulePath(module)), "go", v)
+	if err == nil && v.Origin.URL != "" {
+		return v.Origin.URL, nil
+	}
+	// Modules hosted on the supported forges are named after their repository.
+	if repo := findRepoInURLs("https://" + module); repo != "" {
+		return repo, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return "", errNoSourceRepo("go", module)
+}
+
+type packagistResult struct {
+	Packages map[string][]struct {
+		Source struct {
+			URL string `json:"url"`
+		} `json:"source"`
+		Homepage string `json:"homepage"`
+	} `json:"packages"`
+}
+
+// Gets the source repository URL for the Packagist package.
+func fetchGitRepositoryFromPackagist(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+	name := purlName(p, "/")
+	v := &packagistResult{}
+	if err := getJSON(manager, fmt.Sprintf("https://repo.packagist.org/p2/%s.json", name), "packagist", v); err != nil {
+		return "", err
+	}
+	for _, version := range v.Packages[name] {
+		if version.Source.URL != "" {
+			return version.Source.URL, nil
+		}
+	}
+	return "", errNoSourceRepo("packagist", name)
+}
+
+type hexResult struct {
+	Meta struct {
+		Links map[string]string `json:"links"`
+	} `json:"meta"`
+}
+
+// Gets the source repository URL for the Hex package.
+func fetchGitRepositoryFromHex(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+	v := &hexResult{}
+	if err := getJSON(manager, "https://hex.pm/api/packages/"+url.PathEscape(p.Name), "hex", v); err != nil {
+		return "", err
+	}
+	links := make([]string, 0, len(v.Meta.Links))
+	for _, link := range v.Meta.Links {
+		links = append(links, link)
+	}
+	if repo := findRepoInURLs(links...); repo != "" {
+		return repo, nil
+	}
+	return "", errNoSourceRepo("hex", p.Name)
+}
+
+type pubDevResult struct {
+	Latest struct {
+		Pubspec struct {
+			Repository string `json:"repository"`
+			Homepage   string `json:"homepage"`
+		} `json:"pubspec"`
+	} `json:"latest"`
+}
+
+// Gets the source repository URL for the pub.dev package.
+func fetchGitRepositoryFromPubDev(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+	v := &pubDevResult{}
+	if err := getJSON(manager, "https://pub.dev/api/packages/"+url.PathEscape(p.Name), "pub.dev", v); err != nil {
+		return "", err
+	}
+	pubspec := v.Latest.Pubspec
+	if repo := findRepoInURLs(pubspec.Repository, pubspec.Homepage); repo != "" {
+		return repo, nil
+	}
+	if pubspec.Repository == "" {
+		return "", errNoSourceRepo("pub.dev", p.Name)
+	}
+	return pubspec.Repository, nil
+}
+
+type cocoaPodsResult struct {
+	Source struct {
+		Git string `json:"git"`
+	} `json:"source"`
+	Homepage string `json:"homepage"`
+}
+
+// Gets the source repository URL for the CocoaPods pod from its latest podspec.
+func fetchGitRepositoryFromCocoaPods(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+	v := &cocoaPodsResult{}
+	uri := fmt.Sprintf("https://trunk.cocoapods.org/api/v1/pods/%s/specs/latest", url.PathEscape(p.Name))
+	if err := getJSON(manager, uri, "cocoapods", v); err != nil {
+		return "", err
+	}
+	if repo := findRepoInURLs(v.Source.Git, v.Homepage); repo != "" {
+		return repo, nil
+	}
+	if v.Source.Git == "" {
+		return "", errNoSourceRepo("cocoapods", p.Name)
+	}
+	return v.Source.Git, nil
+}
+
+type condaResult struct {
+	DevURL string `json:"dev_url"`
+	Home   string `json:"home"`
+}
+
+// Gets the source repository URL for the conda package, from the conda-forge
+// channel unless the purl has a channel qualifier.
+func fetchGitRepositoryFromConda(p *packageurl.PackageURL, manager pmc.Client) (string, error) {
+	channel := p.Qualifiers.Map()["channel"]
+	if channel == "" {
+		channel = "conda-forge"
+	}
+	v := &condaResult{}
+	uri := fmt.Sprintf("https://api.anaconda.org/package/%s/%s", url.PathEscape(channel), url.PathEscape(p.Name))
+	if err := getJSON(manager, uri, "conda", v); err != nil {
+		return "", err
+	}
+	if repo := findRepoInURLs(v.DevURL, v.Home); repo != "" {
+		return repo, nil
+	}
+	return "", errNoSourceRepo("conda", p.Name)
+}
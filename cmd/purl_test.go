@@ -0,0 +1,247 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	pmc "github.com/ossf/scorecard/v5/cmd/internal/packagemanager"
+)
+
+// newRegistryClient returns a package manager client serving responses from
+// a map of URIs to bodies, and 404s for the other URIs.
+func newRegistryClient(t *testing.T, responses map[string]string) *pmc.MockClient {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	p := pmc.NewMockClient(ctrl)
+	p.EXPECT().GetURI(gomock.Any()).DoAndReturn(func(uri string) (*http.Response, error) {
+		body, ok := responses[uri]
+		if !ok {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Status:     "404 Not Found",
+				Body:       io.NopCloser(bytes.NewBufferString("")),
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+		}, nil
+	}).AnyTimes()
+	return p
+}
+
+func Test_fetchGitRepositoryFromPURL(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name        string
+		purl        string
+		responses   map[string]string
+		wantRepo    string
+		reciprocity reciprocity
+		wantErr     bool
+	}{
+		{
+			name: "cargo",
+			purl: "pkg:cargo/serde",
+			responses: map[string]string{
+				"https://crates.io/api/v1/crates/serde": `{"crate": {"repository": "https://github.com/serde-rs/serde"}}`,
+				"https://raw.githubusercontent.com/serde-rs/serde/HEAD/serde/Cargo.toml": `[package]
+name = "serde"
+version = "1.0.0"`,
+			},
+			wantRepo:    "https://github.com/serde-rs/serde",
+			reciprocity: reciprocityVerified,
+		},
+		{
+			name: "cargo typosquat",
+			purl: "pkg:cargo/serdee",
+			responses: map[string]string{
+				"https://crates.io/api/v1/crates/serdee": `{"crate": {"repository": "https://github.com/serde-rs/serde.git"}}`,
+				"https://raw.githubusercontent.com/serde-rs/serde/HEAD/Cargo.toml": `[workspace]
+members = ["serde"]`,
+			},
+			wantRepo:    "https://github.com/serde-rs/serde",
+			reciprocity: reciprocityUnverified,
+		},
+		{
+			name: "maven scm inherited from parent",
+			purl: "pkg:maven/com.example/child",
+			responses: map[string]string{
+				"https://repo1.maven.org/maven2/com/example/child/maven-metadata.xml": `<metadata>
+  <versioning><latest>1.1-SNAPSHOT</latest><release>1.0</release></versioning>
+</metadata>`,
+				"https://repo1.maven.org/maven2/com/example/child/1.0/child-1.0.pom": `<project>
+  <parent><groupId>com.example</groupId><artifactId>parent</artifactId><version>3</version></parent>
+  <artifactId>child</artifactId>
+</project>`,
+				"https://repo1.maven.org/maven2/com/example/parent/3/parent-3.pom": `<project>
+  <scm><url>https://github.com/Example/Project/tree/main</url></scm>
+</project>`,
+				"https://raw.githubusercontent.com/example/project/HEAD/child/pom.xml": `<project>
+  <artifactId>child</artifactId>
+</project>`,
+			},
+			wantRepo:    "https://github.com/example/project",
+			reciprocity: reciprocityVerified,
+		},
+		{
+			name: "go module proxy origin",
+			purl: "pkg:golang/example.com/mod/v2",
+			responses: map[string]string{
+				"https://proxy.golang.org/example.com/mod/v2/@latest": `{"Version": "v2.1.0", "Origin": {"VCS": "git", "URL": "https://gitlab.com/example/mod"}}`,
+				"https://gitlab.com/example/mod/-/raw/HEAD/go.mod":    "module example.com/Mod/v2\n\ngo 1.22\n",
+			},
+			wantRepo:    "https://gitlab.com/example/mod",
+			reciprocity: reciprocityVerified,
+		},
+		{
+			name: "go module named after its repository",
+			purl: "pkg:golang/github.com/example/repo/sub/v3",
+			responses: map[string]string{
+				"https://raw.githubusercontent.com/example/repo/HEAD/sub/go.mod": "module github.com/example/repo/sub/v3\n",
+			},
+			wantRepo:    "https://github.com/example/repo",
+			reciprocity: reciprocityVerified,
+		},
+		{
+			name: "packagist",
+			purl: "pkg:composer/laravel/framework",
+			responses: map[string]string{
+				"https://repo.packagist.org/p2/laravel/framework.json": `{"packages": {"laravel/framework": [
+					{"source": {"url": "https://github.com/laravel/framework.git", "type": "git"}}]}}`,
+				"https://raw.githubusercontent.com/laravel/framework/HEAD/composer.json": `{"name": "laravel/framework"}`,
+			},
+			wantRepo:    "https://github.com/laravel/framework",
+			reciprocity: reciprocityVerified,
+		},
+		{
+			name: "hex",
+			purl: "pkg:hex/phoenix",
+			responses: map[string]string{
+				"https://hex.pm/api/packages/phoenix": `{"meta": {"links": {"GitHub": "https://github.com/phoenixframework/phoenix"}}}`,
+				"https://raw.githubusercontent.com/phoenixframework/phoenix/HEAD/mix.exs": `def project do
+    [app: :phoenix, version: @version]`,
+			},
+			wantRepo:    "https://github.com/phoenixframework/phoenix",
+			reciprocity: reciprocityVerified,
+		},
+		{
+			name: "pub.dev",
+			purl: "pkg:pub/http",
+			responses: map[string]string{
+				"https://pub.dev/api/packages/http":                                  `{"latest": {"pubspec": {"repository": "https://github.com/dart-lang/http/tree/master/pkgs/http"}}}`,
+				"https://raw.githubusercontent.com/dart-lang/http/HEAD/pubspec.yaml": "name: http_workspace\n",
+			},
+			wantRepo:    "https://github.com/dart-lang/http",
+			reciprocity: reciprocityUnverified,
+		},
+		{
+			name: "cocoapods",
+			purl: "pkg:cocoapods/Alamofire",
+			responses: map[string]string{
+				"https://trunk.cocoapods.org/api/v1/pods/Alamofire/specs/latest":               `{"source": {"git": "https://github.com/Alamofire/Alamofire.git", "tag": "5.9.1"}}`,
+				"https://raw.githubusercontent.com/alamofire/alamofire/HEAD/Alamofire.podspec": "Pod::Spec.new do |s|\n  s.name = 'Alamofire'\n",
+			},
+			wantRepo:    "https://github.com/alamofire/alamofire",
+			reciprocity: reciprocityVerified,
+		},
+		{
+			name: "conda",
+			purl: "pkg:conda/numpy",
+			responses: map[string]string{
+				"https://api.anaconda.org/package/conda-forge/numpy": `{"dev_url": "https://github.com/numpy/numpy", "home": "https://numpy.org"}`,
+			},
+			wantRepo:    "https://github.com/numpy/numpy",
+			reciprocity: reciprocityUnsupported,
+		},
+		{
+			name: "npm scoped package",
+			purl: "pkg:npm/%40pulumi/pulumi",
+			responses: map[string]string{
+				"https://raw.githubusercontent.com/pulumi/pulumi/HEAD/package.json": `{"name": "@pulumi/pulumi"}`,
+			},
+			wantRepo:    "https://github.com/pulumi/pulumi",
+			reciprocity: reciprocityVerified,
+		},
+		{
+			name:    "unsupported type",
+			purl:    "pkg:deb/debian/curl",
+			wantErr: true,
+		},
+		{
+			name:    "invalid purl",
+			purl:    "cargo/serde",
+			wantErr: true,
+		},
+		{
+			name:    "package not found",
+			purl:    "pkg:cargo/does-not-exist",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := newRegistryClient(t, tt.responses)
+			// npm packages are fetched with the package name.
+			p.EXPECT().Get(gomock.Any(), "@pulumi/pulumi").DoAndReturn(func(url, packageName string) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"repository": {"url": "git+https://github.com/pulumi/pulumi.git"}}`)),
+				}, nil
+			}).AnyTimes()
+			got, err := fetchGitRepositoryFromPURL(tt.purl, p)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("fetchGitRepositoryFromPURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.repo != tt.wantRepo {
+				t.Errorf("fetchGitRepositoryFromPURL() repo = %v, want %v", got.repo, tt.wantRepo)
+			}
+			if got.reciprocity != tt.reciprocity {
+				t.Errorf("fetchGitRepositoryFromPURL() reciprocity = %v, want %v", got.reciprocity, tt.reciprocity)
+			}
+		})
+	}
+}
+
+func Test_purlResolution_metadata(t *testing.T) {
+	t.Parallel()
+	p := newRegistryClient(t, map[string]string{
+		"https://crates.io/api/v1/crates/serde": `{"crate": {"repository": "https://github.com/serde-rs/serde"}}`,
+	})
+	got, err := fetchGitRepositoryFromPURL("pkg:cargo/serde@1.0.0", p)
+	if err != nil {
+		t.Fatalf("fetchGitRepositoryFromPURL: %v", err)
+	}
+	want := []string{
+		"purl=pkg:cargo/serde@1.0.0",
+		"purl-repository=https://github.com/serde-rs/serde",
+		"purl-reciprocity=unverified",
+	}
+	if diff := cmp.Diff(want, got.metadata()); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+
+	pmc "github.com/ossf/scorecard/v5/cmd/internal/packagemanager"
+)
+
+// reciprocity records whether the resolved repository claims the package.
+// Registries let publishers point to any repository, so a package which
+// isn't claimed by its repository may be a typosquat borrowing the
+// reputation of a popular project.
+type reciprocity string
+
+const (
+	// reciprocityVerified means a manifest of the repository declares the package.
+	reciprocityVerified reciprocity = "verified"
+	// reciprocityUnverified means no manifest declaring the package was found.
+	reciprocityUnverified reciprocity = "unverified"
+	// reciprocityUnsupported means the ecosystem or the forge isn't supported.
+	reciprocityUnsupported reciprocity = "unsupported"
+
+	// maxManifestSize is the maximum size of the manifests read.
+	maxManifestSize = 1 << 20
+)
+
+var (
+	githubRawRegexp = regexp.MustCompile(`^https://github[.]com/([^/]+)/([^/]+)$`)
+	gitlabRawRegexp = regexp.MustCompile(`^https://gitlab[.]com/([^/]+)/([^/]+)$`)
+
+	pypiNameRegexp    = regexp.MustCompile(`\bname\s*=\s*["']?([A-Za-z0-9._\-]+)`)
+	pypiNormalizer    = regexp.MustCompile(`[-_.]+`)
+	cargoNameRegexp   = regexp.MustCompile(`(?m)^\s*name\s*=\s*"([^"]+)"`)
+	pubspecNameRegexp = regexp.MustCompile(`(?m)^name:\s*["']?([\w\-]+)`)
+	goModuleRegexp    = regexp.MustCompile(`(?m)^module\s+"?([^\s"]+)"?\s*$`)
+	goMajorSuffix     = regexp.MustCompile(`/v[0-9]+$`)
+)
+
+// manifestClaim describes where a repository declares a package, and how to
+// recognize the declaration.
+type manifestClaim struct {
+	paths  func(p *packageurl.PackageURL, repo string) []string
+	claims func(p *packageurl.PackageURL, content []byte) bool
+}
+
+var manifestClaims = map[string]manifestClaim{
+	packageurl.TypeNPM: {
+		paths: func(p *packageurl.PackageURL, _ string) []string {
+			return []string{"package.json", path.Join("packages", p.Name, "package.json")}
+		},
+		claims: func(p *packageurl.PackageURL, content []byte) bool {
+			return jsonName(content) == purlName(p, "/")
+		},
+	},
+	packageurl.TypePyPi: {
+		paths: func(p *packageurl.PackageURL, _ string) []string {
+			return []string{"pyproject.toml", "setup.cfg", "setup.py", path.Join(p.Name, "pyproject.toml")}
+		},
+		claims: func(p *packageurl.PackageURL, content []byte) bool {
+			return anySubmatch(pypiNameRegexp, content, func(name string) bool {
+				return normalizePyPIName(name) == normalizePyPIName(p.Name)
+			})
+		},
+	},
+	packageurl.TypeGem: {
+		paths: func(p *packageurl.PackageURL, _ string) []string {
+			return []string{p.Name + ".gemspec"}
+		},
+		claims: specClaims,
+	},
+	packageurl.TypeCocoapods: {
+		paths: func(p *packageurl.PackageURL, _ string) []string {
+			return []string{p.Name + ".podspec", p.Name + ".podspec.json"}
+		},
+		claims: specClaims,
+	},
+	packageurl.TypeCargo: {
+		paths: func(p *packageurl.PackageURL, _ string) []string {
+			return []string{"Cargo.toml", path.Join(p.Name, "Cargo.toml"), path.Join("crates", p.Name, "Cargo.toml")}
+		},
+		claims: func(p *packageurl.PackageURL, content []byte) bool {
+			return anySubmatch(cargoNameRegexp, content, func(name string) bool { return name == p.Name })
+		},
+	},
+	packageurl.TypeMaven: {
+		paths: func(p *packageurl.PackageURL, _ string) []string {
+			return []string{"pom.xml", path.Join(p.Name, "pom.xml")}
+		},
+		claims: func(p *packageurl.PackageURL, content []byte) bool {
+			return strings.Contains(string(content), "<artifactId>"+p.Name+"</artifactId>")
+		},
+	},
+	packageurl.TypeGolang: {
+		paths: goModPaths,
+		claims: func(p *packageurl.PackageURL, content []byte) bool {
+			// Package URLs of Go modules are lowercased.
+			return anySubmatch(goModuleRegexp, content, func(module string) bool {
+				return strings.EqualFold(module, purlName(p, "/"))
+			})
+		},
+	},
+	packageurl.TypeComposer: {
+		paths: func(*packageurl.PackageURL, string) []string {
+			return []string{"composer.json"}
+		},
+		claims: func(p *packageurl.PackageURL, content []byte) bool {
+			return strings.EqualFold(jsonName(content), purlName(p, "/"))
+		},
+	},
+	packageurl.TypeHex: {
+		paths: func(p *packageurl.PackageURL, _ string) []string {
+			return []string{"mix.exs", path.Join("apps", p.Name, "mix.exs")}
+		},
+		claims: func(p *packageurl.PackageURL, content []byte) bool {
+			re := regexp.MustCompile(`\bapp:\s*:` + regexp.QuoteMeta(p.Name) + `\b`)
+			return re.Match(content)
+		},
+	},
+	packageurl.TypePub: {
+		paths: func(p *packageurl.PackageURL, _ string) []string {
+			return []string{"pubspec.yaml", path.Join(p.Name, "pubspec.yaml"), path.Join("packages", p.Name, "pubspec.yaml")}
+		},
+		claims: func(p *packageurl.PackageURL, content []byte) bool {
+			return anySubmatch(pubspecNameRegexp, content, func(name string) bool { return name == p.Name })
+		},
+	},
+}
+
+// checkReciprocity checks whether one of the repository's manifests declares
+// the package.
+func checkReciprocity(p *packageurl.PackageURL, repo string, manager pmc.Client) reciprocity {
+	claim, ok := manifestClaims[p.Type]
+	if !ok || rawFileURL(repo, "") == "" {
+		return reciprocityUnsupported
+	}
+	for _, manifest := range claim.paths(p, repo) {
+		content, ok := fetchRawFile(manager, repo, manifest)
+		if ok && claim.claims(p, content) {
+			return reciprocityVerified
+		}
+	}
+	return reciprocityUnverified
+}
+
+// rawFileURL returns the URL of the raw content of a file at the head of
+// the repository, or an empty string if the forge isn't supported.
+func rawFileURL(repo, file string) string {
+	if match := githubRawRegexp.FindStringSubmatch(repo); match != nil {
+		return "https://raw.githubusercontent.com/" + match[1] + "/" + match[2] + "/HEAD/" + file
+	}
+	if match := gitlabRawRegexp.FindStringSubmatch(repo); match != nil {
+		return "https://gitlab.com/" + match[1] + "/" + match[2] + "/-/raw/HEAD/" + file
+	}
+	return ""
+}
+
+func fetchRawFile(manager pmc.Client, repo, file string) ([]byte, bool) {
+	resp, err := manager.GetURI(rawFileURL(repo, file))
+	if err != nil {
+		return nil, false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, false
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return nil, false
+	}
+	return content, true
+}
+
+// goModPaths returns the go.mod files which may declare the module: the one
+// in the module's directory, with and without its major version suffix, and
+// the one at the root of the repository.
+func goModPaths(p *packageurl.PackageURL, repo string) []string {
+	module := purlName(p, "/")
+	repoPath := strings.TrimPrefix(repo, "https://")
+	var paths []string
+	if rel, ok := strings.CutPrefix(module, repoPath+"/"); ok {
+		paths = append(paths, path.Join(rel, "go.mod"))
+		if trimmed := goMajorSuffix.ReplaceAllString(rel, ""); trimmed != rel && trimmed != "" {
+			paths = append(paths, path.Join(trimmed, "go.mod"))
+		}
+	}
+	return append(paths, "go.mod")
+}
+
+// specClaims recognizes the name declared by gemspecs and podspecs.
+func specClaims(p *packageurl.PackageURL, content []byte) bool {
+	re := regexp.MustCompile(`(?:\.name\s*=|"name"\s*:)\s*["']` + regexp.QuoteMeta(p.Name) + `["']`)
+	return re.Match(content)
+}
+
+func jsonName(content []byte) string {
+	var manifest struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return ""
+	}
+	return manifest.Name
+}
+
+func anySubmatch(re *regexp.Regexp, content []byte, match func(string) bool) bool {
+	for _, m := range re.FindAllSubmatch(content, -1) {
+		if match(string(m[1])) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizePyPIName normalizes a Python package name as specified by PEP 503.
+func normalizePyPIName(name string) string {
+	return pypiNormalizer.ReplaceAllString(strings.ToLower(name), "-")
+}
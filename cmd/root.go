@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"sort"
 	"strings"
@@ -29,6 +30,7 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/bundle"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
 	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
 	"github.com/ossf/scorecard/v5/clients/localdir"
@@ -39,12 +41,14 @@ import (
 	"github.com/ossf/scorecard/v5/options"
 	"github.com/ossf/scorecard/v5/pkg/scorecard"
 	"github.com/ossf/scorecard/v5/policy"
+	"github.com/ossf/scorecard/v5/scoring"
+	"github.com/ossf/scorecard/v5/stats"
 )
 
 const (
 	scorecardLong = "A program that shows the OpenSSF scorecard for an open source software."
-	scorecardUse  = `./scorecard (--repo=<repo> | --local=<folder> | --{npm,pypi,rubygems,nuget}=<package_name>)
-	 [--checks=check1,...] [--show-details] [--show-annotations]`
+	scorecardUse  = `./scorecard (--repo=<repo> | --local=<folder> | --bundle=<file> | --{npm,pypi,rubygems,nuget}=<package_name>
+	 | --purl=<package_url>) [--checks=check1,...] [--show-details] [--show-annotations]`
 	scorecardShort = "OpenSSF Scorecard"
 )
 
@@ -72,6 +76,8 @@ func New(o *options.Options) *cobra.Command {
 
 	// Add sub-commands.
 	cmd.AddCommand(serveCmd(o))
+	cmd.AddCommand(exportBundleCmd(o))
+	cmd.AddCommand(depsCmd(o))
 	cmd.AddCommand(version.Version())
 	return cmd
 }
@@ -90,21 +96,69 @@ func rootCmd(o *options.Options) error {
 	if pkgResp.exists {
 		o.Repo = pkgResp.associatedRepo
 	}
+	var purlResp purlResolution
+	if o.PURL != "" {
+		purlResp, err = fetchGitRepositoryFromPURL(o.PURL, p)
+		if err != nil {
+			return fmt.Errorf("fetchGitRepositoryFromPURL: %w", err)
+		}
+		o.Repo = purlResp.repo
+		if purlResp.reciprocity == reciprocityUnverified {
+			fmt.Fprintf(os.Stderr, "warning: %s does not appear to declare %s, the package may not be built from it\n",
+				purlResp.repo, o.PURL)
+		}
+	}
 
 	pol, err := policy.ParseFromFile(o.PolicyFile)
 	if err != nil {
 		return fmt.Errorf("readPolicy: %w", err)
 	}
 
+	scoringConfig, err := scoring.ParseFromFile(o.ScoringFile)
+	if err != nil {
+		return fmt.Errorf("readScoring: %w", err)
+	}
+	// Custom checks aren't known to the policy package, only to the formatters.
+	pol, resultsPol := scoringConfig.Policies(pol)
+
 	ctx := context.Background()
 
+	if stats.TracingConfigured() {
+		shutdown, err := stats.InitTracing(ctx, "scorecard")
+		if err != nil {
+			return fmt.Errorf("initializing tracing: %w", err)
+		}
+		defer func() {
+			if err := shutdown(ctx); err != nil {
+				fmt.Fprintf(os.Stderr, "shutting down tracing: %v\n", err)
+			}
+		}()
+	}
+
 	var repo clients.Repo
-	if o.Local != "" {
+	var bundleOpts []scorecard.Option
+	switch {
+	case o.Bundle != "":
+		var b *bundle.Bundle
+		b, err = bundle.Open(o.Bundle)
+		if err != nil {
+			return fmt.Errorf("opening bundle: %w", err)
+		}
+		defer b.Close()
+		repo = b.Repo()
+		bundleOpts = []scorecard.Option{
+			scorecard.WithRepoClient(b.RepoClient()),
+			scorecard.WithOSSFuzzClient(b.OSSFuzzClient()),
+			scorecard.WithOpenSSFBestPraticesClient(b.CIIClient()),
+			scorecard.WithVulnerabilitiesClient(b.VulnerabilitiesClient()),
+			scorecard.WithProjectPackageClient(b.ProjectClient()),
+		}
+	case o.Local != "":
 		repo, err = localdir.MakeLocalDirRepo(o.Local)
 		if err != nil {
 			return fmt.Errorf("making local dir: %w", err)
 		}
-	} else {
+	default:
 		repo, err = makeRepo(o.Repo)
 		if err != nil {
 			return fmt.Errorf("making remote repo: %w", err)
@@ -116,6 +170,7 @@ func rootCmd(o *options.Options) error {
 	if err != nil {
 		return fmt.Errorf("cannot read yaml file: %w", err)
 	}
+	checkDocs = scoringConfig.Doc(checkDocs)
 
 	var requiredRequestTypes []checker.RequestType
 	// if local option not set add file based
@@ -157,6 +212,31 @@ func rootCmd(o *options.Options) error {
 	if strings.EqualFold(o.FileMode, options.FileModeGit) {
 		opts = append(opts, scorecard.WithFileModeGit())
 	}
+	if o.LicenseDeepScan {
+		opts = append(opts, scorecard.WithLicenseDeepScan())
+	}
+	if o.SecretsHistory {
+		opts = append(opts, scorecard.WithSecretsHistoryScan())
+	}
+	if o.MaintainedLookBackDays > 0 {
+		opts = append(opts, scorecard.WithMaintainedLookBackDays(o.MaintainedLookBackDays))
+	}
+	if scoringConfig != nil {
+		opts = append(opts, scorecard.WithScoring(scoringConfig))
+	}
+	checkTimeout, checkTimeouts, err := o.ParseCheckTimeouts()
+	if err != nil {
+		return fmt.Errorf("ParseCheckTimeouts: %w", err)
+	}
+	// Per-check timeouts given on the command line take precedence over the policy.
+	timeouts := policy.GetTimeouts(pol)
+	maps.Copy(timeouts, checkTimeouts)
+	opts = append(opts,
+		scorecard.WithCheckTimeout(checkTimeout),
+		scorecard.WithCheckTimeouts(timeouts),
+		scorecard.WithMaxParallelChecks(o.MaxParallelChecks),
+	)
+	opts = append(opts, bundleOpts...)
 
 	repoResult, err = scorecard.Run(ctx, repo, opts...)
 	if err != nil {
@@ -164,6 +244,9 @@ func rootCmd(o *options.Options) error {
 	}
 
 	repoResult.Metadata = append(repoResult.Metadata, o.Metadata...)
+	if o.PURL != "" {
+		repoResult.Metadata = append(repoResult.Metadata, purlResp.metadata()...)
+	}
 
 	// Sort them by name
 	sort.Slice(repoResult.Checks, func(i, j int) bool {
@@ -182,7 +265,7 @@ func rootCmd(o *options.Options) error {
 		o,
 		&repoResult,
 		checkDocs,
-		pol,
+		resultsPol,
 	)
 	if resultsErr != nil {
 		return fmt.Errorf("failed to format results: %w", resultsErr)
@@ -242,14 +325,11 @@ func makeRepo(uri string) (clients.Repo, error) {
 	}
 	compositeErr = errors.Join(compositeErr, errGitLab)
 
-	_, experimental := os.LookupEnv("SCORECARD_EXPERIMENTAL")
-	if experimental {
-		repo, errAzureDevOps = azuredevopsrepo.MakeAzureDevOpsRepo(uri)
-		if errAzureDevOps == nil {
-			return repo, nil
-		}
-		compositeErr = errors.Join(compositeErr, errAzureDevOps)
+	repo, errAzureDevOps = azuredevopsrepo.MakeAzureDevOpsRepo(uri)
+	if errAzureDevOps == nil {
+		return repo, nil
 	}
+	compositeErr = errors.Join(compositeErr, errAzureDevOps)
 
 	return nil, fmt.Errorf("unable to parse as github, gitlab, or azuredevops: %w", compositeErr)
 }
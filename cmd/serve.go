@@ -15,6 +15,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"html/template"
 	"net/http"
@@ -28,6 +29,7 @@ import (
 	"github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/options"
 	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	"github.com/ossf/scorecard/v5/stats"
 )
 
 // TODO(cmd): Determine if this should be exported.
@@ -46,6 +48,20 @@ func serveCmd(o *options.Options) *cobra.Command {
 				panic(err)
 			}
 
+			if stats.TracingConfigured() {
+				shutdown, err := stats.InitTracing(context.Background(), "scorecard-serve")
+				if err != nil {
+					logger.Error(err, "initializing tracing")
+					panic(err)
+				}
+				defer func() {
+					if err := shutdown(context.Background()); err != nil {
+						logger.Error(err, "shutting down tracing")
+					}
+				}()
+			}
+
+			http.Handle("/metrics", stats.MetricsHandler())
 			http.HandleFunc("/", func(rw http.ResponseWriter, r *http.Request) {
 				repoParam := r.URL.Query().Get("repo")
 				const length = 3
@@ -14,6 +14,12 @@
 
 package config
 
+import (
+	"path"
+	"slices"
+	"strings"
+)
+
 // Reason is the reason behind an annotation.
 type Reason string
 
@@ -48,8 +54,12 @@ type ReasonGroup struct {
 }
 
 // Annotation defines a group of checks that are being annotated for various reasons.
+// Probes which are not part of a check can be annotated by name, and Paths
+// restricts the annotation of probes to the files matching one of its patterns.
 type Annotation struct {
 	Checks  []string      `yaml:"checks"`
+	Probes  []string      `yaml:"probes,omitempty"`
+	Paths   []string      `yaml:"paths,omitempty"`
 	Reasons []ReasonGroup `yaml:"reasons"`
 }
 
@@ -81,3 +91,47 @@ func isValidReason(r Reason) bool {
 		return false
 	}
 }
+
+// IsTestData reports whether the file at fullpath was annotated as test data
+// for the given probe.
+func (c *Config) IsTestData(probe, fullpath string) bool {
+	for i := range c.Annotations {
+		a := &c.Annotations[i]
+		if !a.hasReason(TestData) || !slices.Contains(a.Probes, probe) {
+			continue
+		}
+		if len(a.Paths) == 0 || a.matchesPath(fullpath) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Annotation) hasReason(r Reason) bool {
+	for _, reasonGroup := range a.Reasons {
+		if reasonGroup.Reason == r {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesPath reports whether fullpath matches one of the annotation's
+// patterns, or is in a directory matching one of them. As in .gitignore files,
+// a pattern without a slash matches a file or directory name at any depth.
+func (a *Annotation) matchesPath(fullpath string) bool {
+	for _, pattern := range a.Paths {
+		pattern = strings.TrimSuffix(pattern, "/")
+		anyDepth := !strings.Contains(pattern, "/")
+		for p := fullpath; p != "." && p != "/"; p = path.Dir(p) {
+			name := p
+			if anyDepth {
+				name = path.Base(p)
+			}
+			if match, err := path.Match(pattern, name); err == nil && match {
+				return true
+			}
+		}
+	}
+	return false
+}
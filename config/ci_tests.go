@@ -0,0 +1,40 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"path"
+	"strings"
+)
+
+// CITests configures how the CI-Tests check recognizes the CI jobs running tests.
+type CITests struct {
+	// TestContexts are patterns of the status contexts and check run names
+	// of CI jobs which run tests, e.g. for a CI system Scorecard doesn't know.
+	// The patterns are matched case-insensitively.
+	TestContexts []string `yaml:"test-contexts,omitempty"`
+}
+
+// IsTestContext reports whether the status context or check run name matches
+// one of the patterns the maintainers declared as tests.
+func (c *CITests) IsTestContext(name string) bool {
+	name = strings.ToLower(name)
+	for _, pattern := range c.TestContexts {
+		if match, err := path.Match(strings.ToLower(pattern), name); err == nil && match {
+			return true
+		}
+	}
+	return false
+}
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -27,13 +28,17 @@ import (
 )
 
 var (
-	errInvalidCheck  = errors.New("check is not valid")
-	errInvalidReason = errors.New("reason is not valid")
+	errInvalidCheck   = errors.New("check is not valid")
+	errInvalidReason  = errors.New("reason is not valid")
+	errInvalidPath    = errors.New("path is not valid")
+	errCheckPaths     = errors.New("paths only apply to probe annotations")
+	errInvalidContext = errors.New("test context is not valid")
 )
 
 // Config contains configurations defined by maintainers.
 type Config struct {
 	Annotations []Annotation `yaml:"annotations"`
+	CITests     CITests      `yaml:"ci-tests,omitempty"`
 }
 
 // parseFile takes the scorecard.yml file content and returns a `Config`.
@@ -62,12 +67,25 @@ func validate(c Config) error {
 				return fmt.Errorf("%w: %s", errInvalidCheck, check)
 			}
 		}
+		if len(annotation.Paths) > 0 && len(annotation.Checks) > 0 {
+			return fmt.Errorf("%w: %s", errCheckPaths, strings.Join(annotation.Checks, ", "))
+		}
+		for _, p := range annotation.Paths {
+			if _, err := path.Match(p, ""); err != nil {
+				return fmt.Errorf("%w: %s", errInvalidPath, p)
+			}
+		}
 		for _, reasonGroup := range annotation.Reasons {
 			if !isValidReason(reasonGroup.Reason) {
 				return fmt.Errorf("%w: %s", errInvalidReason, reasonGroup.Reason)
 			}
 		}
 	}
+	for _, p := range c.CITests.TestContexts {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("%w: %s", errInvalidContext, p)
+		}
+	}
 	return nil
 }
 
@@ -106,6 +106,43 @@ func Test_Parse_Checks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "Annotation on probe paths",
+			configPath: "testdata/probe_paths.yml",
+			want: Config{
+				Annotations: []Annotation{
+					{
+						Probes:  []string{"hasLeakedSecrets"},
+						Paths:   []string{"fixtures/", "*.pem"},
+						Reasons: []ReasonGroup{{Reason: "test-data"}},
+					},
+				},
+			},
+		},
+		{
+			name:       "Paths on a check annotation",
+			configPath: "testdata/check_paths.yml",
+			wantErr:    true,
+		},
+		{
+			name:       "Invalid path",
+			configPath: "testdata/invalid_path.yml",
+			wantErr:    true,
+		},
+		{
+			name:       "CI test contexts",
+			configPath: "testdata/ci_tests.yml",
+			want: Config{
+				CITests: CITests{
+					TestContexts: []string{"internal-ci/unit-tests", "gerrit/*"},
+				},
+			},
+		},
+		{
+			name:       "Invalid test context",
+			configPath: "testdata/invalid_test_context.yml",
+			wantErr:    true,
+		},
 		{
 			name:       "Invalid check",
 			configPath: "testdata/invalid_check.yml",
@@ -134,3 +171,74 @@ func Test_Parse_Checks(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_IsTestData(t *testing.T) {
+	t.Parallel()
+	c := Config{
+		Annotations: []Annotation{
+			{
+				Probes:  []string{"hasLeakedSecrets"},
+				Paths:   []string{"fixtures/", "*.pem"},
+				Reasons: []ReasonGroup{{Reason: TestData}},
+			},
+			{
+				Probes:  []string{"otherProbe"},
+				Reasons: []ReasonGroup{{Reason: TestData}},
+			},
+			{
+				Probes:  []string{"remediatedProbe"},
+				Reasons: []ReasonGroup{{Reason: Remediated}},
+			},
+		},
+	}
+	tests := []struct {
+		probe string
+		path  string
+		want  bool
+	}{
+		{probe: "hasLeakedSecrets", path: "fixtures/creds.json", want: true},
+		{probe: "hasLeakedSecrets", path: "fixtures/nested/creds.json", want: true},
+		{probe: "hasLeakedSecrets", path: "server.pem", want: true},
+		{probe: "hasLeakedSecrets", path: "certs/key.pem", want: true},
+		{probe: "hasLeakedSecrets", path: "test/fixtures/creds.json", want: true},
+		{probe: "hasLeakedSecrets", path: "config/creds.json", want: false},
+		{probe: "hasLeakedSecrets", path: "src/fixtures.go", want: false},
+		{probe: "otherProbe", path: "config/creds.json", want: true},
+		{probe: "remediatedProbe", path: "config/creds.json", want: false},
+		{probe: "unknownProbe", path: "fixtures/creds.json", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.probe+"/"+tt.path, func(t *testing.T) {
+			t.Parallel()
+			if got := c.IsTestData(tt.probe, tt.path); got != tt.want {
+				t.Errorf("IsTestData(%q, %q) = %v, want %v", tt.probe, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCITests_IsTestContext(t *testing.T) {
+	t.Parallel()
+	c := CITests{
+		TestContexts: []string{"internal-ci/unit-tests", "Gerrit/*"},
+	}
+	tests := []struct {
+		context string
+		want    bool
+	}{
+		{context: "internal-ci/unit-tests", want: true},
+		{context: "Internal-CI/Unit-Tests", want: true},
+		{context: "internal-ci/lint", want: false},
+		{context: "gerrit/verify", want: true},
+		{context: "gerrit/verify/nested", want: false},
+		{context: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.context, func(t *testing.T) {
+			t.Parallel()
+			if got := c.IsTestContext(tt.context); got != tt.want {
+				t.Errorf("IsTestContext(%q) = %v, want %v", tt.context, got, tt.want)
+			}
+		})
+	}
+}
@@ -89,6 +89,7 @@ type ScorecardWorker struct {
 	ciiClient         clients.CIIBestPracticesClient
 	ossFuzzRepoClient clients.RepoClient
 	vulnsClient       clients.VulnerabilitiesClient
+	shutdownTracing   func(context.Context) error
 	apiBucketURL      string
 	rawBucketURL      string
 	blacklistedChecks []string
@@ -140,7 +141,14 @@ func newScorecardWorker() (*ScorecardWorker, error) {
 		return nil, fmt.Errorf("startMetricsExporter: %w", err)
 	}
 
-	// Exposed for monitoring runtime profiles
+	if stats.TracingConfigured() {
+		if sw.shutdownTracing, err = stats.InitTracing(sw.ctx, "scorecard-worker"); err != nil {
+			return nil, fmt.Errorf("stats.InitTracing: %w", err)
+		}
+	}
+
+	// Exposed for monitoring runtime profiles and Prometheus scraping.
+	http.Handle("/metrics", stats.MetricsHandler())
 	go func() {
 		// TODO(log): Previously Fatal. Need to handle the error here.
 		//nolint:gosec // not internet facing.
@@ -152,6 +160,11 @@ func newScorecardWorker() (*ScorecardWorker, error) {
 func (sw *ScorecardWorker) Close() {
 	sw.exporter.StopMetricsExporter()
 	sw.ossFuzzRepoClient.Close()
+	if sw.shutdownTracing != nil {
+		if err := sw.shutdownTracing(sw.ctx); err != nil {
+			sw.logger.Error(err, "shutting down tracing")
+		}
+	}
 }
 
 func (sw *ScorecardWorker) Process(ctx context.Context, req *data.ScorecardBatchRequest, bucketURL string) error {
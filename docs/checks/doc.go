@@ -33,3 +33,10 @@ type CheckDoc interface {
 	GetSupportedRepoTypes() []string
 	GetDocumentationURL(commitish string) string
 }
+
+// WeightedCheckDoc is implemented by a CheckDoc whose weight in the
+// aggregate score overrides the one derived from its risk.
+type WeightedCheckDoc interface {
+	CheckDoc
+	GetWeight() float64
+}
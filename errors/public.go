@@ -39,6 +39,8 @@ var (
 	ErrUnsupportedCheck = errors.New("check is not supported for this request")
 	// ErrCheckRuntime indicates an individual check had a runtime error.
 	ErrCheckRuntime = errors.New("check runtime error")
+	// ErrCheckTimeout indicates an individual check did not complete within its timeout.
+	ErrCheckTimeout = errors.New("check timed out")
 )
 
 // WithMessage wraps any of the errors listed above.
@@ -61,6 +63,8 @@ func GetName(err error) string {
 		return "ErrRepoUnreachable"
 	case errors.Is(err, ErrShellParsing):
 		return "ErrShellParsing"
+	case errors.Is(err, ErrCheckTimeout):
+		return "ErrCheckTimeout"
 	default:
 		return "ErrUnknown"
 	}
@@ -88,6 +88,13 @@ func TestGetName(t *testing.T) {
 			},
 			want: "ErrShellParsing",
 		},
+		{
+			name: "ErrCheckTimeout",
+			args: args{
+				err: WithMessage(ErrCheckTimeout, "after 1m0s"),
+			},
+			want: "ErrCheckTimeout",
+		},
 		{
 			name: "unknown error",
 			args: args{
@@ -0,0 +1,201 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packageclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// PackageVersionClient looks up the source repository and the direct
+// dependencies of a version of a package.
+type PackageVersionClient interface {
+	GetPackageVersion(ctx context.Context, key PackageKey) (*PackageVersion, error)
+}
+
+// PackageKey identifies a version of a package in a package management system.
+// System uses the deps.dev names: GO, NPM, CARGO, MAVEN, PYPI, NUGET or RUBYGEMS.
+// An empty Version refers to the default version of the package.
+type PackageKey struct {
+	System  string `json:"system"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// PackageVersion is the metadata of a version of a package.
+type PackageVersion struct {
+	Key PackageKey
+	// SourceRepo is the repository the package is built from, e.g. github.com/owner/repo.
+	SourceRepo string
+	// Dependencies are the direct dependencies of the version, if known.
+	Dependencies []PackageKey
+}
+
+var ErrPackageNotFoundInDepsDev = errors.New("package not found in deps.dev")
+
+// CreateDepsDevPackageVersionClient returns a PackageVersionClient backed by the deps.dev API.
+func CreateDepsDevPackageVersionClient() PackageVersionClient {
+	return depsDevClient{
+		client: &http.Client{},
+	}
+}
+
+type depsDevVersion struct {
+	VersionKey      PackageKey `json:"versionKey"`
+	RelatedProjects []struct {
+		ProjectKey struct {
+			ID string `json:"id"`
+		} `json:"projectKey"`
+		RelationType string `json:"relationType"`
+	} `json:"relatedProjects"`
+	IsDefault bool `json:"isDefault"`
+}
+
+type depsDevPackage struct {
+	Versions []depsDevVersion `json:"versions"`
+}
+
+type depsDevDependencies struct {
+	Nodes []struct {
+		VersionKey PackageKey `json:"versionKey"`
+		Relation   string     `json:"relation"`
+	} `json:"nodes"`
+	Edges []struct {
+		FromNode int `json:"fromNode"`
+		ToNode   int `json:"toNode"`
+	} `json:"edges"`
+}
+
+func (d depsDevClient) GetPackageVersion(ctx context.Context, key PackageKey) (*PackageVersion, error) {
+	if key.Version == "" {
+		var pkg depsDevPackage
+		if err := d.get(ctx, packagePath(key), &pkg); err != nil {
+			return nil, err
+		}
+		for i := range pkg.Versions {
+			if pkg.Versions[i].IsDefault {
+				key.Version = pkg.Versions[i].VersionKey.Version
+			}
+		}
+		if key.Version == "" {
+			return nil, fmt.Errorf("%w: %s/%s has no default version", ErrPackageNotFoundInDepsDev, key.System, key.Name)
+		}
+	}
+
+	versionPath := packagePath(key) + "/versions/" + url.PathEscape(key.Version)
+	var version depsDevVersion
+	if err := d.get(ctx, versionPath, &version); err != nil {
+		return nil, err
+	}
+	ret := PackageVersion{Key: key}
+	for i := range version.RelatedProjects {
+		if version.RelatedProjects[i].RelationType == "SOURCE_REPO" {
+			ret.SourceRepo = version.RelatedProjects[i].ProjectKey.ID
+			break
+		}
+	}
+
+	// The dependency graph isn't available for every system: the package is
+	// still useful without it.
+	var graph depsDevDependencies
+	if err := d.get(ctx, versionPath+":dependencies", &graph); err == nil {
+		for _, edge := range graph.Edges {
+			if edge.FromNode != 0 || edge.ToNode <= 0 || edge.ToNode >= len(graph.Nodes) {
+				continue
+			}
+			ret.Dependencies = append(ret.Dependencies, graph.Nodes[edge.ToNode].VersionKey)
+		}
+	}
+	return &ret, nil
+}
+
+func packagePath(key PackageKey) string {
+	return fmt.Sprintf("systems/%s/packages/%s", strings.ToLower(key.System), url.PathEscape(key.Name))
+}
+
+func (d depsDevClient) get(ctx context.Context, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.deps.dev/v3/"+path, nil)
+	if err != nil {
+		return fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("deps.dev GetPackageVersion: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrPackageNotFoundInDepsDev, path)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s", ErrDepsDevAPI, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("resp.Body.Read: %w", err)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("deps.dev json.Unmarshal: %w", err)
+	}
+	return nil
+}
+
+// LocalPackageVersionClient is an in-memory PackageVersionClient, for tests and
+// for scanning without access to deps.dev.
+type LocalPackageVersionClient struct {
+	versions map[PackageKey]*PackageVersion
+	mu       sync.Mutex
+}
+
+// NewLocalPackageVersionClient returns a client serving the given versions.
+func NewLocalPackageVersionClient(versions ...PackageVersion) *LocalPackageVersionClient {
+	c := &LocalPackageVersionClient{versions: make(map[PackageKey]*PackageVersion)}
+	for i := range versions {
+		c.Add(versions[i])
+	}
+	return c
+}
+
+// Add adds or replaces a version. A version added without a version string is
+// returned for any version of the package that isn't otherwise known.
+func (c *LocalPackageVersionClient) Add(v PackageVersion) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v.Key.System = strings.ToUpper(v.Key.System)
+	c.versions[v.Key] = &v
+}
+
+func (c *LocalPackageVersionClient) GetPackageVersion(_ context.Context, key PackageKey) (*PackageVersion, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key.System = strings.ToUpper(key.System)
+	v, ok := c.versions[key]
+	if !ok {
+		key.Version = ""
+		v, ok = c.versions[key]
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: %s/%s@%s", ErrPackageNotFoundInDepsDev, key.System, key.Name, key.Version)
+	}
+	ret := *v
+	return &ret, nil
+}
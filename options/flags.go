@@ -30,6 +30,9 @@ const (
 	// FlagLocal is the flag name for specifying a local run.
 	FlagLocal = "local"
 
+	// FlagBundle is the flag name for specifying an offline data bundle.
+	FlagBundle = "bundle"
+
 	// FlagCommit is the flag name for specifying a commit.
 	FlagCommit = "commit"
 
@@ -48,6 +51,9 @@ const (
 	// FlagNuget is the flag name for specifying a Nuget repository.
 	FlagNuget = "nuget"
 
+	// FlagPURL is the flag name for specifying a package by its Package URL.
+	FlagPURL = "purl"
+
 	// FlagMetadata is the flag name for specifying metadata for the project.
 	FlagMetadata = "metadata"
 
@@ -60,12 +66,30 @@ const (
 	// FlagShowAnnotations is the flag name for outputting annotations on checks.
 	FlagShowAnnotations = "show-annotations"
 
+	// FlagLicenseDeepScan is the flag name for scanning the license declarations of source files.
+	FlagLicenseDeepScan = "license-deep-scan"
+
+	// FlagSecretsHistory is the flag name for scanning the recent history of the repository for leaked secrets.
+	FlagSecretsHistory = "secrets-history"
+
+	// FlagMaintainedLookBackDays is the flag name for the activity window of the Maintained check.
+	FlagMaintainedLookBackDays = "maintained-look-back-days"
+
+	// FlagCheckTimeout is the flag name for specifying the maximum run time of checks.
+	FlagCheckTimeout = "check-timeout"
+
+	// FlagMaxParallelChecks is the flag name for specifying how many checks run concurrently.
+	FlagMaxParallelChecks = "max-parallel-checks"
+
 	// FlagChecks is the flag name for specifying which checks to run.
 	FlagChecks = "checks"
 
 	// FlagPolicyFile is the flag name for specifying a policy file.
 	FlagPolicyFile = "policy"
 
+	// FlagScoringFile is the flag name for specifying a scoring file.
+	FlagScoringFile = "scoring"
+
 	// FlagFormat is the flag name for specifying output format.
 	FlagFormat = "format"
 
@@ -102,6 +126,13 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		"local folder to check",
 	)
 
+	cmd.Flags().StringVar(
+		&o.Bundle,
+		FlagBundle,
+		o.Bundle,
+		"offline data bundle to check, as written by `scorecard export-bundle`",
+	)
+
 	cmd.Flags().StringVar(
 		&o.Commit,
 		FlagCommit,
@@ -144,6 +175,13 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		"nuget package to check, given that the nuget package has a GitHub repository",
 	)
 
+	cmd.Flags().StringVar(
+		&o.PURL,
+		FlagPURL,
+		o.PURL,
+		"package URL (e.g. pkg:cargo/serde) of the package to check, given that the package has a source repository",
+	)
+
 	cmd.Flags().StringSliceVar(
 		&o.Metadata,
 		FlagMetadata,
@@ -165,6 +203,41 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		"show maintainers annotations for checks",
 	)
 
+	cmd.Flags().BoolVar(
+		&o.LicenseDeepScan,
+		FlagLicenseDeepScan,
+		o.LicenseDeepScan,
+		"scan source files for SPDX license headers and REUSE metadata in the License check",
+	)
+
+	cmd.Flags().BoolVar(
+		&o.SecretsHistory,
+		FlagSecretsHistory,
+		o.SecretsHistory,
+		"clone the repository with git to scan the lines added by recent commits in the hasLeakedSecrets probe",
+	)
+
+	cmd.Flags().IntVar(
+		&o.MaintainedLookBackDays,
+		FlagMaintainedLookBackDays,
+		o.MaintainedLookBackDays,
+		"number of days of activity considered by the Maintained check, 0 means the default of 90 days",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&o.CheckTimeouts,
+		FlagCheckTimeout,
+		o.CheckTimeouts,
+		"maximum run time of checks, for all checks (e.g. 10m) or a given check (e.g. Vulnerabilities=30m)",
+	)
+
+	cmd.Flags().IntVar(
+		&o.MaxParallelChecks,
+		FlagMaxParallelChecks,
+		o.MaxParallelChecks,
+		"maximum number of checks run concurrently, 0 means no limit",
+	)
+
 	cmd.Flags().IntVar(
 		&o.CommitDepth,
 		FlagCommitDepth,
@@ -196,16 +269,26 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		FormatJSON,
 		FormatProbe,
 		FormatInToto,
+		FormatHTML,
+		FormatJUnit,
+		FormatMarkdown,
 	}
 
-	if o.isSarifEnabled() {
-		cmd.Flags().StringVar(
-			&o.PolicyFile,
-			FlagPolicyFile,
-			o.PolicyFile,
-			"policy to enforce",
-		)
+	cmd.Flags().StringVar(
+		&o.PolicyFile,
+		FlagPolicyFile,
+		o.PolicyFile,
+		"policy to enforce",
+	)
 
+	cmd.Flags().StringVar(
+		&o.ScoringFile,
+		FlagScoringFile,
+		o.ScoringFile,
+		"YAML file configuring check weights and custom checks composed from probes",
+	)
+
+	if o.isSarifEnabled() {
 		allowedFormats = append(allowedFormats, FormatSarif)
 	}
 
@@ -38,10 +38,12 @@ func TestOptions_AddFlags(t *testing.T) {
 				NPM:         "npm-package",
 				PyPI:        "pypi-package",
 				RubyGems:    "rubygems-package",
+				PURL:        "pkg:cargo/serde",
 				Metadata:    []string{"key1=value1", "key2=value2"},
 				ShowDetails: true,
 				ChecksToRun: []string{"check1", "check2"},
 				PolicyFile:  "policy-file",
+				ScoringFile: "scoring-file",
 				Format:      "json",
 				ResultsFile: "result.json",
 			},
@@ -89,6 +91,17 @@ func TestOptions_AddFlags(t *testing.T) {
 				t.Errorf("expected FlagRubyGems to be %q, but got %q", tt.opts.RubyGems, cmd.Flag(FlagRubyGems).Value.String())
 			}
 
+			// check FlagPURL
+			if cmd.Flag(FlagPURL).Value.String() != tt.opts.PURL {
+				t.Errorf("expected FlagPURL to be %q, but got %q", tt.opts.PURL, cmd.Flag(FlagPURL).Value.String())
+			}
+
+			// check FlagScoringFile
+			if cmd.Flag(FlagScoringFile).Value.String() != tt.opts.ScoringFile {
+				t.Errorf("expected FlagScoringFile to be %q, but got %q", tt.opts.ScoringFile,
+					cmd.Flag(FlagScoringFile).Value.String())
+			}
+
 			var e1 []string
 			for _, f := range strings.Split(cmd.Flag(FlagChecks).Value.String(), ",") {
 				f = strings.TrimPrefix(f, "[")
@@ -212,3 +225,63 @@ func TestOptions_AddFlags_Annotations(t *testing.T) {
 		})
 	}
 }
+
+func TestOptions_AddFlags_LicenseDeepScan(t *testing.T) {
+	t.Parallel()
+	opts := &Options{}
+	cmd := &cobra.Command{}
+	opts.AddFlags(cmd)
+	if err := cmd.ParseFlags([]string{"--" + FlagLicenseDeepScan}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !opts.LicenseDeepScan {
+		t.Errorf("expected %s to enable LicenseDeepScan", FlagLicenseDeepScan)
+	}
+}
+
+func TestOptions_AddFlags_SecretsHistory(t *testing.T) {
+	t.Parallel()
+	opts := &Options{}
+	cmd := &cobra.Command{}
+	opts.AddFlags(cmd)
+	if err := cmd.ParseFlags([]string{"--" + FlagSecretsHistory}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !opts.SecretsHistory {
+		t.Errorf("expected %s to enable SecretsHistory", FlagSecretsHistory)
+	}
+}
+
+func TestOptions_AddFlags_CheckLimits(t *testing.T) {
+	t.Parallel()
+	opts := &Options{}
+	cmd := &cobra.Command{}
+	opts.AddFlags(cmd)
+	args := []string{
+		"--" + FlagCheckTimeout, "10m",
+		"--" + FlagCheckTimeout, "Vulnerabilities=30m",
+		"--" + FlagMaxParallelChecks, "4",
+	}
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"10m", "Vulnerabilities=30m"}, opts.CheckTimeouts); diff != "" {
+		t.Errorf("CheckTimeouts mismatch (-want +got):\n%s", diff)
+	}
+	if opts.MaxParallelChecks != 4 {
+		t.Errorf("expected MaxParallelChecks to be 4, got %d", opts.MaxParallelChecks)
+	}
+}
+
+func TestOptions_AddFlags_MaintainedLookBackDays(t *testing.T) {
+	t.Parallel()
+	opts := &Options{}
+	cmd := &cobra.Command{}
+	opts.AddFlags(cmd)
+	if err := cmd.ParseFlags([]string{"--" + FlagMaintainedLookBackDays, "180"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.MaintainedLookBackDays != 180 {
+		t.Errorf("expected MaintainedLookBackDays to be 180, got %d", opts.MaintainedLookBackDays)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v6"
 
@@ -32,6 +33,7 @@ import (
 type Options struct {
 	Repo            string
 	Local           string
+	Bundle          string
 	Commit          string
 	LogLevel        string
 	Format          string
@@ -39,7 +41,9 @@ type Options struct {
 	PyPI            string
 	RubyGems        string
 	Nuget           string
+	PURL            string
 	PolicyFile      string
+	ScoringFile     string
 	ResultsFile     string
 	FileMode        string
 	ChecksToRun     []string
@@ -48,6 +52,14 @@ type Options struct {
 	CommitDepth     int
 	ShowDetails     bool
 	ShowAnnotations bool
+	LicenseDeepScan bool
+	SecretsHistory  bool
+	// MaintainedLookBackDays is the number of days of activity considered
+	// by the Maintained check, 0 means the default.
+	MaintainedLookBackDays int
+	// Check runner limits.
+	CheckTimeouts     []string
+	MaxParallelChecks int
 	// Feature flags.
 	EnableSarif                 bool `env:"ENABLE_SARIF"`
 	EnableScorecardV6           bool `env:"SCORECARD_V6"`
@@ -85,6 +97,12 @@ const (
 	FormatRaw = "raw"
 	// FormatInToyo specifies that results should be output in an in-toto statement.
 	FormatInToto = "intoto"
+	// FormatHTML specifies that results should be output as a self-contained HTML report.
+	FormatHTML = "html"
+	// FormatJUnit specifies that results should be output as JUnit XML.
+	FormatJUnit = "junit"
+	// FormatMarkdown specifies that results should be output as a Markdown summary.
+	FormatMarkdown = "markdown"
 
 	// File Modes
 	// FileModeGit specifies that files should be fetched using git.
@@ -114,10 +132,13 @@ var (
 	errPolicyFileNotSupported = errors.New("policy file is not supported yet")
 	errRawOptionNotSupported  = errors.New("raw option is not supported yet")
 	errRepoOptionMustBeSet    = errors.New(
-		"exactly one of `repo`, `npm`, `pypi`, `rubygems`, `nuget` or `local` must be set",
+		"exactly one of `repo`, `npm`, `pypi`, `rubygems`, `nuget`, `purl`, `local` or `bundle` must be set",
 	)
 	errSARIFNotSupported = errors.New("SARIF format is not supported yet")
 	errValidate          = errors.New("some options could not be validated")
+	errInvalidTimeout    = errors.New("invalid check timeout")
+	errInvalidParallel   = errors.New("max parallel checks must not be negative")
+	errInvalidLookBack   = errors.New("maintained look-back days must not be negative")
 )
 
 // Validate validates scorecard configuration options.
@@ -125,13 +146,16 @@ var (
 func (o *Options) Validate() error {
 	var errs []error
 
-	// Validate exactly one of `--repo`, `--npm`, `--pypi`, `--rubygems`, `--nuget`, `--local` is enabled.
+	// Validate exactly one of `--repo`, `--npm`, `--pypi`, `--rubygems`, `--nuget`, `--purl`, `--local`, `--bundle`
+	// is enabled.
 	if boolSum(o.Repo != "",
 		o.NPM != "",
 		o.PyPI != "",
 		o.RubyGems != "",
 		o.Nuget != "",
-		o.Local != "") != 1 {
+		o.PURL != "",
+		o.Local != "",
+		o.Bundle != "") != 1 {
 		errs = append(
 			errs,
 			errRepoOptionMustBeSet,
@@ -146,7 +170,8 @@ func (o *Options) Validate() error {
 				errSARIFNotSupported,
 			)
 		}
-		if o.PolicyFile != "" {
+		// JUnit output uses the policy to decide which checks fail.
+		if o.PolicyFile != "" && o.Format != FormatJUnit {
 			errs = append(
 				errs,
 				errPolicyFileNotSupported,
@@ -187,6 +212,27 @@ func (o *Options) Validate() error {
 		)
 	}
 
+	if _, _, err := o.ParseCheckTimeouts(); err != nil {
+		errs = append(
+			errs,
+			err,
+		)
+	}
+
+	if o.MaxParallelChecks < 0 {
+		errs = append(
+			errs,
+			errInvalidParallel,
+		)
+	}
+
+	if o.MaintainedLookBackDays < 0 {
+		errs = append(
+			errs,
+			errInvalidLookBack,
+		)
+	}
+
 	if len(errs) != 0 {
 		return fmt.Errorf(
 			"%w: %+v",
@@ -240,6 +286,30 @@ func (o *Options) Probes() []string {
 	return o.ProbesToRun
 }
 
+// ParseCheckTimeouts parses the check timeouts. Each timeout is either a
+// duration which applies to all checks, such as "10m", or a check name and
+// a duration, such as "Vulnerabilities=30m", overriding it for that check.
+func (o *Options) ParseCheckTimeouts() (time.Duration, map[string]time.Duration, error) {
+	var timeout time.Duration
+	timeouts := map[string]time.Duration{}
+	for _, value := range o.CheckTimeouts {
+		check, duration, found := strings.Cut(value, "=")
+		if !found {
+			check, duration = "", value
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(duration))
+		if err != nil || d <= 0 {
+			return 0, nil, fmt.Errorf("%w: %q", errInvalidTimeout, value)
+		}
+		if check = strings.TrimSpace(check); check == "" {
+			timeout = d
+			continue
+		}
+		timeouts[check] = d
+	}
+	return timeout, timeouts, nil
+}
+
 // isSarifEnabled returns true if SARIF format was specified in options or via
 // environment variable.
 func (o *Options) isSarifEnabled() bool {
@@ -257,7 +327,8 @@ func (o *Options) isV6Enabled() bool {
 
 func validateFormat(format string) bool {
 	switch format {
-	case FormatJSON, FormatProbe, FormatSarif, FormatDefault, FormatRaw, FormatInToto:
+	case FormatJSON, FormatProbe, FormatSarif, FormatDefault, FormatRaw, FormatInToto, FormatHTML,
+		FormatJUnit, FormatMarkdown:
 		return true
 	default:
 		return false
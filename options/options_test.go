@@ -17,6 +17,9 @@ package options
 
 import (
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestOptions_Validate(t *testing.T) {
@@ -30,11 +33,15 @@ func TestOptions_Validate(t *testing.T) {
 		PyPI              string
 		RubyGems          string
 		Nuget             string
+		PURL              string
 		PolicyFile        string
 		ResultsFile       string
 		FileMode          string
 		ChecksToRun       []string
 		Metadata          []string
+		CheckTimeouts     []string
+		MaxParallelChecks int
+		LookBackDays      int
 		ShowDetails       bool
 		EnableSarif       bool
 		EnableScorecardV6 bool
@@ -76,6 +83,25 @@ func TestOptions_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "format junit accepts a policy file without SARIF enabled",
+			fields: fields{
+				Repo:       "github.com/ossf/scorecard",
+				Commit:     "HEAD",
+				Format:     "junit",
+				PolicyFile: "testdata/policy.yaml",
+			},
+			wantErr: false,
+		},
+		{
+			name: "format markdown is valid",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "markdown",
+			},
+			wantErr: false,
+		},
 		{
 			name: "format raw is not supported when V6 is not enabled",
 			fields: fields{
@@ -85,6 +111,25 @@ func TestOptions_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "purl is a valid input",
+			fields: fields{
+				PURL:   "pkg:cargo/serde",
+				Commit: "HEAD",
+				Format: "default",
+			},
+			wantErr: false,
+		},
+		{
+			name: "purl and repo are mutually exclusive",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				PURL:   "pkg:cargo/serde",
+				Commit: "HEAD",
+				Format: "default",
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid filemode flagged",
 			fields: fields{
@@ -105,6 +150,47 @@ func TestOptions_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "check timeouts are valid",
+			fields: fields{
+				Repo:              "github.com/ossf/scorecard",
+				Commit:            "HEAD",
+				Format:            "default",
+				CheckTimeouts:     []string{"10m", "Vulnerabilities=30m"},
+				MaxParallelChecks: 4,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid check timeout flagged",
+			fields: fields{
+				Repo:          "github.com/ossf/scorecard",
+				Commit:        "HEAD",
+				Format:        "default",
+				CheckTimeouts: []string{"Vulnerabilities=soon"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative max parallel checks flagged",
+			fields: fields{
+				Repo:              "github.com/ossf/scorecard",
+				Commit:            "HEAD",
+				Format:            "default",
+				MaxParallelChecks: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative maintained look-back days flagged",
+			fields: fields{
+				Repo:         "github.com/ossf/scorecard",
+				Commit:       "HEAD",
+				Format:       "default",
+				LookBackDays: -30,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		if tt.fields.FileMode == "" {
@@ -112,23 +198,27 @@ func TestOptions_Validate(t *testing.T) {
 		}
 		t.Run(tt.name, func(t *testing.T) {
 			o := &Options{
-				Repo:              tt.fields.Repo,
-				Local:             tt.fields.Local,
-				Commit:            tt.fields.Commit,
-				LogLevel:          tt.fields.LogLevel,
-				Format:            tt.fields.Format,
-				FileMode:          tt.fields.FileMode,
-				NPM:               tt.fields.NPM,
-				PyPI:              tt.fields.PyPI,
-				RubyGems:          tt.fields.RubyGems,
-				Nuget:             tt.fields.Nuget,
-				PolicyFile:        tt.fields.PolicyFile,
-				ResultsFile:       tt.fields.ResultsFile,
-				ChecksToRun:       tt.fields.ChecksToRun,
-				Metadata:          tt.fields.Metadata,
-				ShowDetails:       tt.fields.ShowDetails,
-				EnableSarif:       tt.fields.EnableSarif,
-				EnableScorecardV6: tt.fields.EnableScorecardV6,
+				Repo:                   tt.fields.Repo,
+				Local:                  tt.fields.Local,
+				Commit:                 tt.fields.Commit,
+				LogLevel:               tt.fields.LogLevel,
+				Format:                 tt.fields.Format,
+				FileMode:               tt.fields.FileMode,
+				NPM:                    tt.fields.NPM,
+				PyPI:                   tt.fields.PyPI,
+				RubyGems:               tt.fields.RubyGems,
+				Nuget:                  tt.fields.Nuget,
+				PURL:                   tt.fields.PURL,
+				PolicyFile:             tt.fields.PolicyFile,
+				ResultsFile:            tt.fields.ResultsFile,
+				ChecksToRun:            tt.fields.ChecksToRun,
+				Metadata:               tt.fields.Metadata,
+				CheckTimeouts:          tt.fields.CheckTimeouts,
+				MaxParallelChecks:      tt.fields.MaxParallelChecks,
+				ShowDetails:            tt.fields.ShowDetails,
+				MaintainedLookBackDays: tt.fields.LookBackDays,
+				EnableSarif:            tt.fields.EnableSarif,
+				EnableScorecardV6:      tt.fields.EnableScorecardV6,
 			}
 			if o.EnableSarif {
 				t.Setenv(EnvVarEnableSarif, "1")
@@ -140,3 +230,54 @@ func TestOptions_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestOptions_ParseCheckTimeouts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		wantTimeouts map[string]time.Duration
+		name         string
+		values       []string
+		wantTimeout  time.Duration
+		wantErr      bool
+	}{
+		{
+			name:         "no timeouts",
+			wantTimeouts: map[string]time.Duration{},
+		},
+		{
+			name:         "default and per-check timeouts",
+			values:       []string{"10m", "Vulnerabilities=30m", " SAST = 1h "},
+			wantTimeout:  10 * time.Minute,
+			wantTimeouts: map[string]time.Duration{"Vulnerabilities": 30 * time.Minute, "SAST": time.Hour},
+		},
+		{
+			name:    "invalid duration",
+			values:  []string{"Vulnerabilities=soon"},
+			wantErr: true,
+		},
+		{
+			name:    "zero duration",
+			values:  []string{"0s"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			o := &Options{CheckTimeouts: tt.values}
+			timeout, timeouts, err := o.ParseCheckTimeouts()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCheckTimeouts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if timeout != tt.wantTimeout {
+				t.Errorf("ParseCheckTimeouts() timeout = %v, want %v", timeout, tt.wantTimeout)
+			}
+			if diff := cmp.Diff(tt.wantTimeouts, timeouts); diff != "" {
+				t.Errorf("ParseCheckTimeouts() timeouts mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/google/osv-scanner/pkg/lockfile"
+	"github.com/package-url/packageurl-go"
+
+	"github.com/ossf/scorecard/v5/checks/raw"
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// Package is a dependency declared by the lockfiles, manifests or SBOMs of
+// the analyzed repository.
+type Package struct {
+	// System is the deps.dev package management system of the package,
+	// empty for the ecosystems deps.dev doesn't know.
+	System  string `json:"system,omitempty"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl"`
+}
+
+// ecosystem describes how a package ecosystem is named by lockfiles, package
+// URLs and deps.dev.
+type ecosystem struct {
+	osv      lockfile.Ecosystem
+	purlType string
+	system   string
+	// separator joins the namespace and the name of the package URL into
+	// the name used by the ecosystem.
+	separator string
+}
+
+var ecosystems = []ecosystem{
+	{osv: lockfile.NpmEcosystem, purlType: packageurl.TypeNPM, system: "NPM", separator: "/"},
+	{osv: lockfile.PipEcosystem, purlType: packageurl.TypePyPi, system: "PYPI", separator: "/"},
+	{osv: lockfile.GoEcosystem, purlType: packageurl.TypeGolang, system: "GO", separator: "/"},
+	{osv: lockfile.CargoEcosystem, purlType: packageurl.TypeCargo, system: "CARGO", separator: "/"},
+	{osv: lockfile.MavenEcosystem, purlType: packageurl.TypeMaven, system: "MAVEN", separator: ":"},
+	{osv: lockfile.NuGetEcosystem, purlType: packageurl.TypeNuget, system: "NUGET", separator: "/"},
+	{osv: lockfile.BundlerEcosystem, purlType: packageurl.TypeGem, system: "RUBYGEMS", separator: "/"},
+	{osv: lockfile.ComposerEcosystem, purlType: packageurl.TypeComposer, separator: "/"},
+	{osv: lockfile.PubEcosystem, purlType: packageurl.TypePub, separator: "/"},
+	{osv: lockfile.MixEcosystem, purlType: packageurl.TypeHex, separator: "/"},
+	{osv: lockfile.CRANEcosystem, purlType: packageurl.TypeCran, separator: "/"},
+	{osv: lockfile.ConanEcosystem, purlType: packageurl.TypeConan, separator: "/"},
+}
+
+// collectPackages returns the packages declared by the lockfiles and manifests
+// of the repository, and listed by the SBOMs at its root.
+func collectPackages(c clients.RepoClient) ([]Package, error) {
+	declared, err := raw.LockfilePackages(c)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+	seen := make(map[string]bool)
+	var packages []Package
+	add := func(p Package) {
+		if p.PURL == "" || seen[p.PURL] {
+			return
+		}
+		seen[p.PURL] = true
+		packages = append(packages, p)
+	}
+	for _, d := range declared {
+		if p, ok := lockfilePackage(d); ok {
+			add(p)
+		}
+	}
+
+	sboms, err := c.ListFiles(func(name string) (bool, error) {
+		return raw.IsSBOMFile(name), nil
+	})
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("RepoClient.ListFiles: %v", err))
+	}
+	for _, name := range sboms {
+		if !raw.IsSBOMFile(name) {
+			continue
+		}
+		content, err := readFile(c, name)
+		if err != nil {
+			return nil, err
+		}
+		doc, err := raw.ParseSBOM(name, content)
+		if err != nil {
+			// Unparsable SBOMs don't list anything we can analyze.
+			continue
+		}
+		for i := range doc.Components {
+			for _, id := range doc.Components[i].Identifiers {
+				if p, ok := purlPackage(id); ok {
+					add(p)
+				}
+			}
+		}
+	}
+
+	sort.Slice(packages, func(i, j int) bool {
+		return packages[i].PURL < packages[j].PURL
+	})
+	return packages, nil
+}
+
+func readFile(c clients.RepoClient, name string) ([]byte, error) {
+	r, err := c.GetFileReader(name)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("RepoClient.GetFileReader: %v", err))
+	}
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("io.ReadAll: %v", err))
+	}
+	return content, nil
+}
+
+// lockfilePackage converts a package read from a lockfile.
+func lockfilePackage(d lockfile.PackageDetails) (Package, bool) {
+	for _, e := range ecosystems {
+		if e.osv != d.Ecosystem {
+			continue
+		}
+		namespace, name := "", d.Name
+		if i := strings.LastIndex(d.Name, e.separator); i >= 0 {
+			namespace, name = d.Name[:i], d.Name[i+len(e.separator):]
+		}
+		purl := packageurl.NewPackageURL(e.purlType, namespace, name, d.Version, nil, "")
+		return Package{
+			System:  e.system,
+			Name:    d.Name,
+			Version: d.Version,
+			PURL:    purl.ToString(),
+		}, true
+	}
+	return Package{}, false
+}
+
+// purlPackage converts a package URL listed by an SBOM.
+func purlPackage(s string) (Package, bool) {
+	if !strings.HasPrefix(s, "pkg:") {
+		return Package{}, false
+	}
+	purl, err := packageurl.FromString(s)
+	if err != nil {
+		return Package{}, false
+	}
+	e := ecosystem{purlType: purl.Type, separator: "/"}
+	for _, known := range ecosystems {
+		if known.purlType == purl.Type {
+			e = known
+		}
+	}
+	name := purl.Name
+	if purl.Namespace != "" {
+		name = purl.Namespace + e.separator + purl.Name
+	}
+	// Qualifiers and subpaths don't identify a different package.
+	purl.Qualifiers = nil
+	purl.Subpath = ""
+	return Package{
+		System:  e.system,
+		Name:    name,
+		Version: purl.Version,
+		PURL:    purl.ToString(),
+	}, true
+}
@@ -0,0 +1,343 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deps aggregates the Scorecard results of the dependencies of a project.
+package deps
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/internal/packageclient"
+)
+
+const (
+	defaultMaxDependencies = 500
+	defaultConcurrency     = 4
+	defaultLowest          = 10
+)
+
+var errNoScorer = errors.New("no scorer configured")
+
+// Resolver returns the source repository of the package identified by a
+// package URL, for the packages deps.dev can't map to a repository.
+type Resolver func(ctx context.Context, purl string) (string, error)
+
+// Dependency is a package in the dependency tree, with the score of its
+// source repository.
+type Dependency struct {
+	Score *Score `json:"scorecard,omitempty"`
+	Package
+	Repo string `json:"repo,omitempty"`
+	// Error explains why the dependency has no repository or no score.
+	Error        string        `json:"error,omitempty"`
+	Dependencies []*Dependency `json:"dependencies,omitempty"`
+	// Transitive is set for the dependencies of other dependencies.
+	Transitive bool `json:"transitive"`
+}
+
+type config struct {
+	versions        packageclient.PackageVersionClient
+	resolver        Resolver
+	scorer          Scorer
+	maxDependencies int
+	concurrency     int
+	lowest          int
+}
+
+// Option configures Analyze.
+type Option func(*config) error
+
+// WithPackageVersionClient sets the client mapping packages to their source
+// repositories and dependencies, deps.dev by default.
+func WithPackageVersionClient(client packageclient.PackageVersionClient) Option {
+	return func(c *config) error {
+		c.versions = client
+		return nil
+	}
+}
+
+// WithResolver sets the resolver used for the packages the package version
+// client can't map to a repository.
+func WithResolver(resolver Resolver) Option {
+	return func(c *config) error {
+		c.resolver = resolver
+		return nil
+	}
+}
+
+// WithScorer sets how the repositories of the dependencies are scored.
+func WithScorer(scorer Scorer) Option {
+	return func(c *config) error {
+		c.scorer = scorer
+		return nil
+	}
+}
+
+// WithMaxDependencies limits the number of dependencies analyzed.
+func WithMaxDependencies(n int) Option {
+	return func(c *config) error {
+		c.maxDependencies = n
+		return nil
+	}
+}
+
+// WithConcurrency sets the number of dependencies looked up and scored at once.
+func WithConcurrency(n int) Option {
+	return func(c *config) error {
+		c.concurrency = n
+		return nil
+	}
+}
+
+// WithLowest sets the number of lowest-scoring dependencies reported.
+func WithLowest(n int) Option {
+	return func(c *config) error {
+		c.lowest = n
+		return nil
+	}
+}
+
+// Analyze collects the dependencies declared by the lockfiles and SBOMs of the
+// repository the client was initialized with, maps them to their source
+// repositories and scores them.
+func Analyze(ctx context.Context, c clients.RepoClient, opts ...Option) (*Report, error) {
+	cfg := config{
+		maxDependencies: defaultMaxDependencies,
+		concurrency:     defaultConcurrency,
+		lowest:          defaultLowest,
+	}
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.scorer == nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, errNoScorer.Error())
+	}
+	if cfg.versions == nil {
+		cfg.versions = packageclient.CreateDepsDevPackageVersionClient()
+	}
+	if cfg.concurrency <= 0 {
+		cfg.concurrency = 1
+	}
+
+	packages, err := collectPackages(c)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Repo: c.URI(), Total: len(packages)}
+	if cfg.maxDependencies > 0 && len(packages) > cfg.maxDependencies {
+		packages = packages[:cfg.maxDependencies]
+	}
+
+	deps := make([]*Dependency, len(packages))
+	edges := make([][]packageclient.PackageKey, len(packages))
+	parallel(len(packages), cfg.concurrency, func(i int) {
+		deps[i], edges[i] = resolve(ctx, &cfg, packages[i])
+	})
+
+	scoreRepos(ctx, &cfg, deps)
+	report.Dependencies = buildTree(deps, edges)
+	report.Lowest = lowest(deps, cfg.lowest)
+	report.summarize(deps)
+	return report, nil
+}
+
+// resolve maps a package to its source repository and direct dependencies.
+func resolve(ctx context.Context, cfg *config, p Package) (*Dependency, []packageclient.PackageKey) {
+	dep := &Dependency{Package: p}
+	var edges []packageclient.PackageKey
+	var errs []error
+	if p.System != "" {
+		version, err := cfg.versions.GetPackageVersion(ctx, packageclient.PackageKey{
+			System:  p.System,
+			Name:    p.Name,
+			Version: p.Version,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			dep.Repo = normalizeRepo(version.SourceRepo)
+			edges = version.Dependencies
+		}
+	}
+	if dep.Repo == "" && cfg.resolver != nil {
+		repo, err := cfg.resolver(ctx, p.PURL)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			dep.Repo = normalizeRepo(repo)
+		}
+	}
+	if dep.Repo == "" {
+		if len(errs) == 0 {
+			dep.Error = "no source repository found"
+		} else {
+			dep.Error = errorString(errors.Join(errs...))
+		}
+	}
+	return dep, edges
+}
+
+// normalizeRepo returns the host and path of a repository URL.
+func normalizeRepo(repo string) string {
+	repo = strings.TrimSpace(repo)
+	repo = strings.TrimPrefix(repo, "https://")
+	repo = strings.TrimPrefix(repo, "http://")
+	repo = strings.TrimSuffix(repo, "/")
+	return strings.TrimSuffix(repo, ".git")
+}
+
+// scoreRepos scores each repository once, however many packages it publishes.
+func scoreRepos(ctx context.Context, cfg *config, deps []*Dependency) {
+	byRepo := make(map[string][]*Dependency)
+	var repos []string
+	for _, d := range deps {
+		if d.Repo == "" {
+			continue
+		}
+		if _, ok := byRepo[d.Repo]; !ok {
+			repos = append(repos, d.Repo)
+		}
+		byRepo[d.Repo] = append(byRepo[d.Repo], d)
+	}
+	parallel(len(repos), cfg.concurrency, func(i int) {
+		score, err := cfg.scorer.Score(ctx, repos[i])
+		for _, d := range byRepo[repos[i]] {
+			if err != nil {
+				d.Error = "scoring " + repos[i] + ": " + errorString(err)
+				continue
+			}
+			s := score
+			d.Score = &s
+		}
+	})
+}
+
+// errorString returns the message of an error on a single line, errors joined
+// by errors.Join being separated by newlines.
+func errorString(err error) string {
+	return strings.ReplaceAll(err.Error(), "\n", "; ")
+}
+
+// parallel calls f for 0 <= i < n, running at most concurrency calls at once.
+func parallel(n, concurrency int, f func(i int)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			f(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// buildTree links the dependencies to the dependencies they depend on, and
+// returns the roots of the tree: the dependencies no other dependency needs.
+// Cycles are broken so that the tree can be walked and serialized.
+func buildTree(deps []*Dependency, edges [][]packageclient.PackageKey) []*Dependency {
+	byName := make(map[string]int)
+	for i, d := range deps {
+		if d.System == "" {
+			continue
+		}
+		if _, ok := byName[packageID(d.System, d.Name)]; !ok {
+			byName[packageID(d.System, d.Name)] = i
+		}
+	}
+	children := make([][]int, len(deps))
+	needed := make([]bool, len(deps))
+	for i := range deps {
+		seen := make(map[int]bool)
+		for _, key := range edges[i] {
+			j, ok := byName[packageID(key.System, key.Name)]
+			if !ok || j == i || seen[j] {
+				continue
+			}
+			seen[j] = true
+			children[i] = append(children[i], j)
+			needed[j] = true
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(deps))
+	var visit func(i int)
+	visit = func(i int) {
+		state[i] = visiting
+		for _, j := range children[i] {
+			if state[j] == visiting {
+				continue
+			}
+			deps[j].Transitive = true
+			deps[i].Dependencies = append(deps[i].Dependencies, deps[j])
+			if state[j] == unvisited {
+				visit(j)
+			}
+		}
+		state[i] = visited
+	}
+
+	var roots []*Dependency
+	for i := range deps {
+		if !needed[i] {
+			roots = append(roots, deps[i])
+			visit(i)
+		}
+	}
+	// Dependencies only reachable through a cycle.
+	for i := range deps {
+		if state[i] == unvisited {
+			roots = append(roots, deps[i])
+			visit(i)
+		}
+	}
+	return roots
+}
+
+// packageID identifies a package independently of its version.
+func packageID(system, name string) string {
+	return strings.ToUpper(system) + "/" + strings.ToLower(name)
+}
+
+// lowest returns the n dependencies with the lowest scores.
+func lowest(deps []*Dependency, n int) []*Dependency {
+	var scored []*Dependency
+	for _, d := range deps {
+		if d.Score != nil {
+			scored = append(scored, d)
+		}
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].Score.Value < scored[j].Score.Value
+	})
+	if n >= 0 && len(scored) > n {
+		scored = scored[:n]
+	}
+	return scored
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/internal/packageclient"
+)
+
+var errNotFound = errors.New("not found")
+
+const packageLock = `{
+  "lockfileVersion": 3,
+  "packages": {
+    "node_modules/app-lib": {"version": "1.0.0"},
+    "node_modules/helper": {"version": "2.0.0"},
+    "node_modules/@scope/leaf": {"version": "3.0.0"},
+    "node_modules/orphan": {"version": "4.0.0"}
+  }
+}
+`
+
+const cycloneDX = `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "components": [
+    {"name": "vendor/lib", "version": "1.2.0", "purl": "pkg:composer/vendor/lib@1.2.0"},
+    {"name": "helper", "version": "2.0.0", "purl": "pkg:npm/helper@2.0.0"}
+  ]
+}
+`
+
+func newRepoClient(t *testing.T, files map[string]string) *mockrepo.MockRepoClient {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	c := mockrepo.NewMockRepoClient(ctrl)
+	c.EXPECT().URI().Return("github.com/example/app").AnyTimes()
+	c.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+		var ret []string
+		for name := range files {
+			if ok, err := predicate(name); err == nil && ok {
+				ret = append(ret, name)
+			}
+		}
+		return ret, nil
+	}).AnyTimes()
+	c.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(name string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(files[name])), nil
+	}).AnyTimes()
+	return c
+}
+
+func newVersionClient() *packageclient.LocalPackageVersionClient {
+	return packageclient.NewLocalPackageVersionClient(
+		packageclient.PackageVersion{
+			Key:        packageclient.PackageKey{System: "NPM", Name: "app-lib", Version: "1.0.0"},
+			SourceRepo: "github.com/example/app-lib",
+			Dependencies: []packageclient.PackageKey{
+				{System: "NPM", Name: "helper", Version: "2.0.0"},
+				{System: "NPM", Name: "not-declared", Version: "1.0.0"},
+			},
+		},
+		packageclient.PackageVersion{
+			Key:          packageclient.PackageKey{System: "NPM", Name: "helper"},
+			SourceRepo:   "github.com/example/helper",
+			Dependencies: []packageclient.PackageKey{{System: "NPM", Name: "@scope/leaf", Version: "3.0.0"}},
+		},
+		packageclient.PackageVersion{
+			Key:        packageclient.PackageKey{System: "NPM", Name: "@scope/leaf", Version: "3.0.0"},
+			SourceRepo: "https://github.com/example/leaf.git",
+			// Cycles must not make the tree infinite.
+			Dependencies: []packageclient.PackageKey{{System: "NPM", Name: "helper", Version: "2.0.0"}},
+		},
+	)
+}
+
+func staticScorer(scores map[string]float64) Scorer {
+	return ScorerFunc(func(_ context.Context, repo string) (Score, error) {
+		v, ok := scores[repo]
+		if !ok {
+			return Score{}, ErrNoScore
+		}
+		return Score{Source: "test", Value: v}, nil
+	})
+}
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+	c := newRepoClient(t, map[string]string{
+		"package-lock.json":                  packageLock,
+		"bom.cdx.json":                       cycloneDX,
+		"node_modules/x/package-lock.json":   packageLock,
+		"docs/not-at-the-root.cdx.json":      cycloneDX,
+		"vendor/github.com/foo/bar/go.mod":   "module github.com/foo/bar\n",
+		"third_party/ignored/Cargo.lock.txt": "",
+	})
+	resolver := func(_ context.Context, purl string) (string, error) {
+		if purl == "pkg:composer/vendor/lib@1.2.0" {
+			return "https://gitlab.com/vendor/lib", nil
+		}
+		return "", errNotFound
+	}
+	report, err := Analyze(context.Background(), c,
+		WithPackageVersionClient(newVersionClient()),
+		WithResolver(resolver),
+		WithScorer(staticScorer(map[string]float64{
+			"github.com/example/app-lib": 8,
+			"github.com/example/helper":  4.5,
+			"github.com/example/leaf":    2,
+			"gitlab.com/vendor/lib":      6,
+		})),
+		WithLowest(2),
+	)
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+
+	var roots []string
+	for _, d := range report.Dependencies {
+		roots = append(roots, d.PURL)
+	}
+	wantRoots := []string{"pkg:composer/vendor/lib@1.2.0", "pkg:npm/app-lib@1.0.0", "pkg:npm/orphan@4.0.0"}
+	if diff := cmp.Diff(wantRoots, roots); diff != "" {
+		t.Errorf("roots mismatch (-want +got):\n%s", diff)
+	}
+	appLib := report.Dependencies[1]
+	if len(appLib.Dependencies) != 1 || appLib.Dependencies[0].Name != "helper" {
+		t.Fatalf("app-lib dependencies = %v, want helper", appLib.Dependencies)
+	}
+	helper := appLib.Dependencies[0]
+	if !helper.Transitive || len(helper.Dependencies) != 1 || helper.Dependencies[0].Repo != "github.com/example/leaf" {
+		t.Errorf("unexpected helper dependency: %+v", helper)
+	}
+	if leaf := helper.Dependencies[0]; len(leaf.Dependencies) != 0 {
+		t.Errorf("cycle wasn't broken: %+v", leaf.Dependencies)
+	}
+	if orphan := report.Dependencies[2]; orphan.Score != nil || orphan.Error == "" {
+		t.Errorf("orphan should have no score and an error: %+v", orphan)
+	}
+
+	var lowest []string
+	for _, d := range report.Lowest {
+		lowest = append(lowest, d.Name)
+	}
+	if diff := cmp.Diff([]string{"@scope/leaf", "helper"}, lowest); diff != "" {
+		t.Errorf("lowest mismatch (-want +got):\n%s", diff)
+	}
+	if report.Total != 5 || report.Analyzed != 5 || report.Resolved != 4 || report.Scored != 4 {
+		t.Errorf("unexpected counts: %+v", report)
+	}
+	if report.Minimum != 2 || report.Average != 5.125 {
+		t.Errorf("unexpected summary: average %v, minimum %v", report.Average, report.Minimum)
+	}
+
+	var buf bytes.Buffer
+	if err := report.AsJSON(&buf); err != nil {
+		t.Fatalf("AsJSON: %v", err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	buf.Reset()
+	if err := report.AsString(&buf); err != nil {
+		t.Fatalf("AsString: %v", err)
+	}
+	if !strings.Contains(buf.String(), "    - pkg:npm/%40scope/leaf@3.0.0  github.com/example/leaf  2.0") {
+		t.Errorf("unexpected text report:\n%s", buf.String())
+	}
+}
+
+func TestAnalyze_MaxDependencies(t *testing.T) {
+	t.Parallel()
+	c := newRepoClient(t, map[string]string{"package-lock.json": packageLock})
+	report, err := Analyze(context.Background(), c,
+		WithPackageVersionClient(newVersionClient()),
+		WithScorer(staticScorer(nil)),
+		WithMaxDependencies(2),
+	)
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	if report.Total != 4 || report.Analyzed != 2 || report.Scored != 0 {
+		t.Errorf("unexpected counts: %+v", report)
+	}
+}
+
+func TestAnalyze_NoScorer(t *testing.T) {
+	t.Parallel()
+	c := newRepoClient(t, nil)
+	if _, err := Analyze(context.Background(), c); err == nil {
+		t.Error("expected an error without a scorer")
+	}
+}
+
+func TestPolicy_Violations(t *testing.T) {
+	t.Parallel()
+	low := &Dependency{Package: Package{Name: "low"}, Score: &Score{Value: 3}}
+	high := &Dependency{Package: Package{Name: "high"}, Score: &Score{Value: 9}, Dependencies: []*Dependency{low}}
+	unscored := &Dependency{Package: Package{Name: "unscored"}, Dependencies: []*Dependency{low}}
+	report := &Report{Dependencies: []*Dependency{high, unscored}}
+
+	tests := []struct {
+		name   string
+		policy Policy
+		want   []string
+	}{
+		{name: "threshold", policy: Policy{MinScore: 5}, want: []string{"low"}},
+		{name: "unscored", policy: Policy{MinScore: 5, FailUnscored: true}, want: []string{"low", "unscored"}},
+		{name: "no threshold", policy: Policy{}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, d := range tt.policy.Violations(report) {
+				got = append(got, d.Name)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("violations mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPurlPackage(t *testing.T) {
+	t.Parallel()
+	tests := map[string]Package{
+		"pkg:maven/org.apache/commons-lang3@3.0?type=jar": {
+			System: "MAVEN", Name: "org.apache:commons-lang3", Version: "3.0", PURL: "pkg:maven/org.apache/commons-lang3@3.0",
+		},
+		"pkg:npm/%40types/node@20.0.0": {
+			System: "NPM", Name: "@types/node", Version: "20.0.0", PURL: "pkg:npm/%40types/node@20.0.0",
+		},
+		"pkg:hex/phoenix@1.7.0": {Name: "phoenix", Version: "1.7.0", PURL: "pkg:hex/phoenix@1.7.0"},
+	}
+	for purl, want := range tests {
+		got, ok := purlPackage(purl)
+		if !ok {
+			t.Errorf("purlPackage(%q) failed", purl)
+			continue
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("purlPackage(%q) mismatch (-want +got):\n%s", purl, diff)
+		}
+	}
+	if _, ok := purlPackage("cpe:2.3:a:example:app:1.0:*:*:*:*:*:*:*"); ok {
+		t.Error("CPEs aren't package URLs")
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// Report is the dependency tree of a repository, with the scores of the
+// dependencies.
+type Report struct {
+	Repo string `json:"repo"`
+	// Dependencies are the roots of the dependency tree.
+	Dependencies []*Dependency `json:"dependencies"`
+	// Lowest are the lowest-scoring dependencies, direct or transitive.
+	Lowest []*Dependency `json:"lowest"`
+	// Total is the number of declared dependencies, Analyzed the number of
+	// those analyzed within the configured limit.
+	Total    int `json:"total"`
+	Analyzed int `json:"analyzed"`
+	Resolved int `json:"resolved"`
+	Scored   int `json:"scored"`
+	// Average and Minimum are computed over the scored dependencies.
+	Average float64 `json:"averageScore"`
+	Minimum float64 `json:"minimumScore"`
+}
+
+func (r *Report) summarize(deps []*Dependency) {
+	r.Analyzed = len(deps)
+	r.Minimum = math.NaN()
+	var sum float64
+	for _, d := range deps {
+		if d.Repo != "" {
+			r.Resolved++
+		}
+		if d.Score == nil {
+			continue
+		}
+		r.Scored++
+		sum += d.Score.Value
+		if math.IsNaN(r.Minimum) || d.Score.Value < r.Minimum {
+			r.Minimum = d.Score.Value
+		}
+	}
+	if r.Scored == 0 {
+		r.Minimum = 0
+		return
+	}
+	r.Average = sum / float64(r.Scored)
+}
+
+// Policy decides which dependencies are not acceptable.
+type Policy struct {
+	// MinScore is the lowest acceptable score.
+	MinScore float64
+	// FailUnscored rejects the dependencies which couldn't be scored.
+	FailUnscored bool
+}
+
+// Violations returns the dependencies of the report the policy rejects.
+func (p Policy) Violations(r *Report) []*Dependency {
+	var violations []*Dependency
+	walk(r.Dependencies, func(d *Dependency) {
+		if d.Score == nil {
+			if p.FailUnscored {
+				violations = append(violations, d)
+			}
+			return
+		}
+		if d.Score.Value < p.MinScore {
+			violations = append(violations, d)
+		}
+	})
+	return violations
+}
+
+// walk calls f once for each dependency of the tree.
+func walk(deps []*Dependency, f func(*Dependency)) {
+	seen := make(map[*Dependency]bool)
+	var visit func([]*Dependency)
+	visit = func(deps []*Dependency) {
+		for _, d := range deps {
+			if seen[d] {
+				continue
+			}
+			seen[d] = true
+			f(d)
+			visit(d.Dependencies)
+		}
+	}
+	visit(deps)
+}
+
+// AsJSON writes the report as JSON.
+func (r *Report) AsJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	if err := encoder.Encode(r); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("encoder.Encode: %v", err))
+	}
+	return nil
+}
+
+// AsString writes the report as a human-readable tree.
+func (r *Report) AsString(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Dependencies of %s\n", r.Repo)
+	fmt.Fprintf(&b, "%d declared, %d analyzed, %d resolved to a repository, %d scored",
+		r.Total, r.Analyzed, r.Resolved, r.Scored)
+	if r.Scored > 0 {
+		fmt.Fprintf(&b, " (average %.1f, minimum %.1f)", r.Average, r.Minimum)
+	}
+	b.WriteString("\n\n")
+
+	printed := make(map[*Dependency]bool)
+	var printTree func(deps []*Dependency, depth int)
+	printTree = func(deps []*Dependency, depth int) {
+		for _, d := range deps {
+			fmt.Fprintf(&b, "%s- %s\n", strings.Repeat("  ", depth), describe(d))
+			if printed[d] {
+				if len(d.Dependencies) > 0 {
+					fmt.Fprintf(&b, "%s  ...\n", strings.Repeat("  ", depth))
+				}
+				continue
+			}
+			printed[d] = true
+			printTree(d.Dependencies, depth+1)
+		}
+	}
+	printTree(r.Dependencies, 0)
+
+	if len(r.Lowest) > 0 {
+		b.WriteString("\nLowest-scoring dependencies:\n")
+		for _, d := range r.Lowest {
+			kind := "direct"
+			if d.Transitive {
+				kind = "transitive"
+			}
+			fmt.Fprintf(&b, "  %4.1f  %s (%s, %s)\n", d.Score.Value, d.PURL, d.Repo, kind)
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("io.WriteString: %v", err))
+	}
+	return nil
+}
+
+// describe returns a line describing a dependency.
+func describe(d *Dependency) string {
+	switch {
+	case d.Score != nil:
+		return fmt.Sprintf("%s  %s  %.1f", d.PURL, d.Repo, d.Score.Value)
+	case d.Repo != "":
+		return fmt.Sprintf("%s  %s  ?  (%s)", d.PURL, d.Repo, d.Error)
+	default:
+		return fmt.Sprintf("%s  ?  (%s)", d.PURL, d.Error)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// DefaultAPIURL is the API serving the results of the weekly Scorecard scans.
+const DefaultAPIURL = "https://api.scorecard.dev"
+
+var (
+	// ErrNoScore is returned by Scorers having no score for a repository.
+	ErrNoScore = errors.New("no score available")
+	errAPI     = errors.New("scorecard API")
+)
+
+// Score is the aggregate Scorecard score of a repository.
+type Score struct {
+	Date   time.Time `json:"date"`
+	Commit string    `json:"commit,omitempty"`
+	// Source is where the score comes from: api, cache or scan.
+	Source string  `json:"source"`
+	Value  float64 `json:"score"`
+}
+
+// Scorer returns the Scorecard score of a repository, e.g. github.com/owner/repo.
+type Scorer interface {
+	Score(ctx context.Context, repo string) (Score, error)
+}
+
+// ScorerFunc adapts a function to a Scorer.
+type ScorerFunc func(ctx context.Context, repo string) (Score, error)
+
+func (f ScorerFunc) Score(ctx context.Context, repo string) (Score, error) {
+	return f(ctx, repo)
+}
+
+// FirstOf returns a Scorer trying each scorer in turn, until one has a score.
+func FirstOf(scorers ...Scorer) Scorer {
+	return ScorerFunc(func(ctx context.Context, repo string) (Score, error) {
+		var errs []error
+		for _, s := range scorers {
+			score, err := s.Score(ctx, repo)
+			if err == nil {
+				return score, nil
+			}
+			errs = append(errs, err)
+		}
+		if len(errs) == 0 {
+			return Score{}, ErrNoScore
+		}
+		return Score{}, errors.Join(errs...)
+	})
+}
+
+// APIScorer looks up the results of the weekly Scorecard scans.
+type APIScorer struct {
+	Client *http.Client
+	// URL defaults to DefaultAPIURL.
+	URL string
+}
+
+func (s *APIScorer) Score(ctx context.Context, repo string) (Score, error) {
+	base := s.URL
+	if base == "" {
+		base = DefaultAPIURL
+	}
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base+"/projects/"+repo, nil)
+	if err != nil {
+		return Score{}, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Score{}, fmt.Errorf("%w: %w", errAPI, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return Score{}, fmt.Errorf("%w: %s is not scanned", ErrNoScore, repo)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Score{}, fmt.Errorf("%w: %s", errAPI, resp.Status)
+	}
+	return readScore(resp.Body, "api")
+}
+
+// CacheScorer reads and writes Scorecard JSON results in a directory, one
+// file per repository. Results older than MaxAge are ignored. When Next is
+// set, repositories missing from the cache are scored by it and cached.
+type CacheScorer struct {
+	Next   Scorer
+	Dir    string
+	MaxAge time.Duration
+}
+
+func (s *CacheScorer) Score(ctx context.Context, repo string) (Score, error) {
+	path := s.path(repo)
+	if f, err := os.Open(path); err == nil {
+		score, err := readScore(f, "cache")
+		f.Close()
+		if err == nil && (s.MaxAge <= 0 || time.Since(score.Date) <= s.MaxAge) {
+			return score, nil
+		}
+	}
+	if s.Next == nil {
+		return Score{}, fmt.Errorf("%w: %s is not cached", ErrNoScore, repo)
+	}
+	score, err := s.Next.Score(ctx, repo)
+	if err != nil {
+		return Score{}, err
+	}
+	if err := s.write(path, repo, score); err != nil {
+		return Score{}, err
+	}
+	return score, nil
+}
+
+// path returns the cache file of a repository.
+func (s *CacheScorer) path(repo string) string {
+	return filepath.Join(s.Dir, strings.NewReplacer("/", "_", ":", "_").Replace(repo)+".json")
+}
+
+// cachedResult is the subset of the Scorecard JSON results cached for a
+// repository: results written with `scorecard --format=json` can be cached too.
+type cachedResult struct {
+	Date string `json:"date"`
+	Repo struct {
+		Name   string `json:"name"`
+		Commit string `json:"commit"`
+	} `json:"repo"`
+	Score float64 `json:"score"`
+}
+
+func (s *CacheScorer) write(path, repo string, score Score) error {
+	var result cachedResult
+	result.Date = score.Date.Format(time.RFC3339)
+	result.Repo.Name = repo
+	result.Repo.Commit = score.Commit
+	result.Score = score.Value
+	content, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return fmt.Errorf("os.MkdirAll: %w", err)
+	}
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		return fmt.Errorf("os.WriteFile: %w", err)
+	}
+	return nil
+}
+
+func readScore(r io.Reader, source string) (Score, error) {
+	result, value, err := scorecard.ExperimentalFromJSON2(r)
+	if err != nil {
+		return Score{}, fmt.Errorf("reading result: %w", err)
+	}
+	return Score{
+		Date:   result.Date,
+		Commit: result.Repo.CommitSHA,
+		Source: source,
+		Value:  value,
+	}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCacheScorer(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	calls := 0
+	next := ScorerFunc(func(_ context.Context, repo string) (Score, error) {
+		calls++
+		return Score{Date: time.Now().UTC().Truncate(time.Second), Commit: "abc", Source: "scan", Value: 7.5}, nil
+	})
+	s := &CacheScorer{Dir: dir, Next: next, MaxAge: time.Hour}
+
+	for i := 0; i < 2; i++ {
+		score, err := s.Score(context.Background(), "github.com/example/lib")
+		if err != nil {
+			t.Fatalf("Score: %v", err)
+		}
+		if score.Value != 7.5 || score.Commit != "abc" {
+			t.Errorf("unexpected score: %+v", score)
+		}
+		if want := []string{"scan", "cache"}[i]; score.Source != want {
+			t.Errorf("score source = %q, want %q", score.Source, want)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("next scorer called %d times, want 1", calls)
+	}
+
+	// Results of `scorecard --format=json` can be dropped in the cache.
+	old := `{"date":"2020-01-01","repo":{"name":"github.com/example/old","commit":"def"},"score":3.0,"checks":[]}`
+	if err := os.WriteFile(filepath.Join(dir, "github.com_example_old.json"), []byte(old), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cacheOnly := &CacheScorer{Dir: dir}
+	score, err := cacheOnly.Score(context.Background(), "github.com/example/old")
+	if err != nil || score.Value != 3 {
+		t.Errorf("Score = %+v, %v, want 3", score, err)
+	}
+	// Expired results are scored again.
+	if _, err := s.Score(context.Background(), "github.com/example/old"); err != nil || calls != 2 {
+		t.Errorf("expired result wasn't scored again: %v, %d calls", err, calls)
+	}
+	if _, err := cacheOnly.Score(context.Background(), "github.com/example/missing"); !errors.Is(err, ErrNoScore) {
+		t.Errorf("expected ErrNoScore, got %v", err)
+	}
+}
+
+func TestAPIScorer(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/github.com/example/lib" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"date":"2026-01-05T00:00:00Z","repo":{"name":"github.com/example/lib","commit":"abc"},"score":6.4,"checks":[]}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	s := &APIScorer{URL: server.URL, Client: server.Client()}
+	score, err := s.Score(context.Background(), "github.com/example/lib")
+	if err != nil {
+		t.Fatalf("Score: %v", err)
+	}
+	if score.Value != 6.4 || score.Source != "api" || score.Commit != "abc" {
+		t.Errorf("unexpected score: %+v", score)
+	}
+	if _, err := s.Score(context.Background(), "github.com/example/unknown"); !errors.Is(err, ErrNoScore) {
+		t.Errorf("expected ErrNoScore, got %v", err)
+	}
+
+	fallback := FirstOf(s, staticScorer(map[string]float64{"github.com/example/unknown": 1}))
+	if score, err := fallback.Score(context.Background(), "github.com/example/unknown"); err != nil || score.Value != 1 {
+		t.Errorf("FirstOf = %+v, %v, want 1", score, err)
+	}
+}
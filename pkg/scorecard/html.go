@@ -0,0 +1,304 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/log"
+)
+
+// AsHTMLResultOption provides configuration options for HTML Scorecard results.
+type AsHTMLResultOption struct {
+	LogLevel    log.Level
+	Details     bool
+	Annotations bool
+}
+
+type htmlFinding struct {
+	Probe       string
+	Outcome     string
+	Message     string
+	Location    string
+	Link        string
+	Remediation string
+}
+
+type htmlCheck struct {
+	Name        string
+	Score       string
+	Reason      string
+	DocURL      string
+	Short       string
+	Risk        string
+	Details     []string
+	Findings    []htmlFinding
+	Annotations []string
+	Error       string
+}
+
+type htmlReport struct {
+	Repo             string
+	RepoCommit       string
+	ScorecardVersion string
+	ScorecardCommit  string
+	Date             string
+	AggregateScore   string
+	MaxScore         int
+	Checks           []htmlCheck
+	Metadata         []string
+}
+
+// forgeFileURL returns a link to a file at the analyzed commit, or an empty
+// string if the forge is unknown or the analysis was not of a remote repo.
+func forgeFileURL(metadata map[string]string, loc *finding.Location) string {
+	if loc == nil || loc.Path == "" || loc.Type == finding.FileTypeURL {
+		return ""
+	}
+	uri := metadata["repository.uri"]
+	sha := metadata["repository.sha1"]
+	if uri == "" || sha == "" || sha == "unknown" {
+		return ""
+	}
+
+	path := strings.TrimPrefix(loc.Path, "/")
+	host, _, _ := strings.Cut(uri, "/")
+	switch {
+	case host == "dev.azure.com" || strings.HasSuffix(host, ".visualstudio.com"):
+		link := fmt.Sprintf("https://%s?path=/%s&version=GC%s", uri, path, sha)
+		if loc.LineStart != nil {
+			link += fmt.Sprintf("&line=%d", *loc.LineStart)
+		}
+		return link
+	case strings.Contains(host, "gitlab"):
+		return withLineAnchor(fmt.Sprintf("https://%s/-/blob/%s/%s", uri, sha, path), loc)
+	case strings.Contains(host, "github"):
+		return withLineAnchor(fmt.Sprintf("https://%s/blob/%s/%s", uri, sha, path), loc)
+	default:
+		return ""
+	}
+}
+
+func withLineAnchor(link string, loc *finding.Location) string {
+	if loc.LineStart != nil {
+		link += fmt.Sprintf("#L%d", *loc.LineStart)
+		if loc.LineEnd != nil && *loc.LineEnd > *loc.LineStart {
+			link += fmt.Sprintf("-L%d", *loc.LineEnd)
+		}
+	}
+	return link
+}
+
+func locationToString(loc *finding.Location) string {
+	if loc == nil || loc.Path == "" {
+		return ""
+	}
+	if loc.LineStart != nil {
+		return fmt.Sprintf("%s:%d", loc.Path, *loc.LineStart)
+	}
+	return loc.Path
+}
+
+func findingsToHTML(findings []finding.Finding, metadata map[string]string) []htmlFinding {
+	ret := make([]htmlFinding, 0, len(findings))
+	for i := range findings {
+		f := &findings[i]
+		hf := htmlFinding{
+			Probe:    f.Probe,
+			Outcome:  string(f.Outcome),
+			Message:  f.Message,
+			Location: locationToString(f.Location),
+			Link:     forgeFileURL(metadata, f.Location),
+		}
+		if f.Remediation != nil {
+			hf.Remediation = f.Remediation.Text
+		}
+		ret = append(ret, hf)
+	}
+	return ret
+}
+
+func (r *Result) resultsToHTML(checkDocs docs.Doc, opt *AsHTMLResultOption) (htmlReport, error) {
+	score, err := r.GetAggregateScore(checkDocs)
+	if err != nil {
+		return htmlReport{}, err
+	}
+
+	out := htmlReport{
+		Repo:             r.Repo.Name,
+		RepoCommit:       r.Repo.CommitSHA,
+		ScorecardVersion: r.Scorecard.Version,
+		ScorecardCommit:  r.Scorecard.CommitSHA,
+		Date:             r.Date.Format(time.RFC3339),
+		AggregateScore:   scoreToString(score),
+		MaxScore:         checker.MaxResultScore,
+		Metadata:         r.Metadata,
+	}
+
+	for i := range r.Checks {
+		check := &r.Checks[i]
+		doc, e := checkDocs.GetCheck(check.Name)
+		if e != nil {
+			return out, fmt.Errorf("GetCheck: %s: %w", check.Name, e)
+		}
+		if doc == nil {
+			return out, fmt.Errorf("GetCheck: %s: %w", check.Name, errNoDoc)
+		}
+
+		hc := htmlCheck{
+			Name:     check.Name,
+			Score:    "?",
+			Reason:   check.Reason,
+			DocURL:   doc.GetDocumentationURL(r.Scorecard.CommitSHA),
+			Short:    doc.GetShort(),
+			Risk:     doc.GetRisk(),
+			Findings: findingsToHTML(check.Findings, r.RawResults.Metadata.Metadata),
+		}
+		if check.Score != checker.InconclusiveResultScore {
+			hc.Score = fmt.Sprintf("%d", check.Score)
+		}
+		if check.Error != nil {
+			hc.Error = check.Error.Error()
+		}
+		if opt.Details {
+			for j := range check.Details {
+				if m := DetailToString(&check.Details[j], opt.LogLevel); m != "" {
+					hc.Details = append(hc.Details, m)
+				}
+			}
+		}
+		if opt.Annotations {
+			hc.Annotations = check.Annotations(r.Config)
+		}
+		out.Checks = append(out.Checks, hc)
+	}
+	return out, nil
+}
+
+// AsHTML writes results as a self-contained HTML report.
+func (r *Result) AsHTML(writer io.Writer, checkDocs docs.Doc, opt *AsHTMLResultOption) error {
+	if opt == nil {
+		opt = &AsHTMLResultOption{
+			LogLevel:    log.DefaultLevel,
+			Details:     false,
+			Annotations: false,
+		}
+	}
+
+	report, err := r.resultsToHTML(checkDocs, opt)
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+
+	t, err := template.New("report").Parse(htmlReportTemplate)
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("template.Parse: %v", err))
+	}
+	if err := t.Execute(writer, report); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("template.Execute: %v", err))
+	}
+	return nil
+}
+
+// The report must not reference external resources so it can be attached
+// to audits and viewed offline.
+const htmlReportTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Scorecard report for {{.Repo}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #1f2328; }
+header { border-bottom: 1px solid #d0d7de; margin-bottom: 1.5em; }
+.aggregate { font-size: 2.5em; font-weight: bold; }
+.card { border: 1px solid #d0d7de; border-radius: 6px; padding: 1em; margin-bottom: 1em; }
+.card h2 { margin: 0 0 .25em 0; font-size: 1.25em; }
+.score { float: right; font-size: 1.25em; font-weight: bold; }
+.risk { color: #57606a; font-size: .9em; }
+.error { color: #cf222e; }
+table { border-collapse: collapse; width: 100%; margin-top: .5em; }
+th, td { border: 1px solid #d0d7de; padding: .25em .5em; text-align: left; vertical-align: top; font-size: .9em; }
+.outcome-True { color: #1a7f37; }
+.outcome-False { color: #cf222e; }
+pre { white-space: pre-wrap; background: #f6f8fa; padding: .5em; margin: .25em 0; }
+</style>
+</head>
+<body>
+<header>
+<h1>Scorecard report for {{.Repo}}</h1>
+<p>Commit: <code>{{.RepoCommit}}</code><br>
+Date: {{.Date}}<br>
+Scorecard: {{.ScorecardVersion}} ({{.ScorecardCommit}})</p>
+<p>Aggregate score: <span class="aggregate">{{.AggregateScore}}</span> / {{.MaxScore}}</p>
+{{- if .Metadata}}
+<p>Metadata: {{range .Metadata}}<code>{{.}}</code> {{end}}</p>
+{{- end}}
+</header>
+<main>
+{{- range .Checks}}
+<section class="card" id="{{.Name}}">
+<span class="score">{{.Score}} / {{$.MaxScore}}</span>
+<h2><a href="{{.DocURL}}">{{.Name}}</a></h2>
+<div class="risk">{{.Risk}} risk &mdash; {{.Short}}</div>
+<p>{{.Reason}}</p>
+{{- if .Error}}
+<p class="error">{{.Error}}</p>
+{{- end}}
+{{- if .Annotations}}
+<h3>Maintainer annotations</h3>
+<ul>
+{{- range .Annotations}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- if .Findings}}
+<h3>Findings</h3>
+<table>
+<tr><th>Probe</th><th>Outcome</th><th>Message</th><th>Location</th><th>Remediation</th></tr>
+{{- range .Findings}}
+<tr>
+<td>{{.Probe}}</td>
+<td class="outcome-{{.Outcome}}">{{.Outcome}}</td>
+<td>{{.Message}}</td>
+<td>{{if .Link}}<a href="{{.Link}}">{{.Location}}</a>{{else}}{{.Location}}{{end}}</td>
+<td>{{if .Remediation}}<pre>{{.Remediation}}</pre>{{end}}</td>
+</tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .Details}}
+<details>
+<summary>Details</summary>
+{{- range .Details}}
+<pre>{{.}}</pre>
+{{- end}}
+</details>
+{{- end}}
+</section>
+{{- end}}
+</main>
+</body>
+</html>
+`
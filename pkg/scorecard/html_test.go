@@ -0,0 +1,177 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/config"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/log"
+)
+
+func TestAsHTML(t *testing.T) {
+	t.Parallel()
+
+	line := uint(12)
+	result := Result{
+		Repo: RepoInfo{
+			Name:      "github.com/org/name",
+			CommitSHA: "68bc59901773ab4c051dfcea0cc4201a1567ab32",
+		},
+		Scorecard: ScorecardInfo{
+			Version:   "1.2.3",
+			CommitSHA: "ccbc59901773ab4c051dfcea0cc4201a1567abdd",
+		},
+		Date: time.Date(2024, time.February, 1, 13, 48, 0, 0, time.UTC),
+		RawResults: checker.RawResults{
+			Metadata: checker.MetadataData{
+				Metadata: map[string]string{
+					"repository.uri":  "github.com/org/name",
+					"repository.sha1": "68bc59901773ab4c051dfcea0cc4201a1567ab32",
+				},
+			},
+		},
+		Checks: []checker.CheckResult{
+			{
+				Name:   "Check-Name",
+				Score:  5,
+				Reason: "half of <things> were done",
+				Details: []checker.CheckDetail{
+					{
+						Type: checker.DetailWarn,
+						Msg: checker.LogMessage{
+							Text: "some warning",
+							Path: "src/file1.cpp",
+							Type: finding.FileTypeSource,
+						},
+					},
+				},
+				Findings: []finding.Finding{
+					{
+						Probe:   "someProbe",
+						Outcome: finding.OutcomeFalse,
+						Message: "pattern found",
+						Location: &finding.Location{
+							Path:      ".github/workflows/ci.yml",
+							Type:      finding.FileTypeText,
+							LineStart: &line,
+						},
+						Remediation: &finding.Remediation{
+							Text: "pin the action",
+						},
+					},
+				},
+			},
+			{
+				Name:   "Check-Name2",
+				Score:  checker.InconclusiveResultScore,
+				Reason: "could not determine",
+			},
+		},
+		Config: config.Config{
+			Annotations: []config.Annotation{
+				{
+					Checks:  []string{"check-name"},
+					Reasons: []config.ReasonGroup{{Reason: config.TestData}},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	opt := &AsHTMLResultOption{
+		LogLevel:    log.DebugLevel,
+		Details:     true,
+		Annotations: true,
+	}
+	if err := result.AsHTML(&buf, jsonMockDocRead(), opt); err != nil {
+		t.Fatalf("AsHTML: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"<title>Scorecard report for github.com/org/name</title>",
+		`<span class="aggregate">5.0</span>`,
+		"half of &lt;things&gt; were done",
+		"? / 10",
+		"someProbe",
+		`href="https://github.com/org/name/blob/68bc59901773ab4c051dfcea0cc4201a1567ab32/.github/workflows/ci.yml#L12"`,
+		"pin the action",
+		"some warning",
+		"The files or code snippets are only used for test or example purposes.",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q", want)
+		}
+	}
+	if strings.Contains(out, "<script") || strings.Contains(out, "<link") {
+		t.Errorf("report must be self-contained")
+	}
+}
+
+func TestForgeFileURL(t *testing.T) {
+	t.Parallel()
+	start, end := uint(3), uint(5)
+	loc := &finding.Location{Path: "a/b.go", LineStart: &start, LineEnd: &end}
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		loc      *finding.Location
+		want     string
+	}{
+		{
+			name:     "github",
+			metadata: map[string]string{"repository.uri": "github.com/o/r", "repository.sha1": "abc"},
+			loc:      loc,
+			want:     "https://github.com/o/r/blob/abc/a/b.go#L3-L5",
+		},
+		{
+			name:     "gitlab",
+			metadata: map[string]string{"repository.uri": "gitlab.com/o/r", "repository.sha1": "abc"},
+			loc:      &finding.Location{Path: "a/b.go"},
+			want:     "https://gitlab.com/o/r/-/blob/abc/a/b.go",
+		},
+		{
+			name:     "azure devops",
+			metadata: map[string]string{"repository.uri": "dev.azure.com/o/p/_git/r", "repository.sha1": "abc"},
+			loc:      loc,
+			want:     "https://dev.azure.com/o/p/_git/r?path=/a/b.go&version=GCabc&line=3",
+		},
+		{
+			name:     "local",
+			metadata: map[string]string{"repository.uri": "file:///tmp/r", "repository.sha1": "unknown"},
+			loc:      loc,
+			want:     "",
+		},
+		{
+			name:     "no location",
+			metadata: map[string]string{"repository.uri": "github.com/o/r", "repository.sha1": "abc"},
+			want:     "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := forgeFileURL(tt.metadata, tt.loc); got != tt.want {
+				t.Errorf("forgeFileURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -55,8 +55,13 @@ type jsonCheckResultV2 struct {
 	Name        string                   `json:"name"`
 	Doc         jsonCheckDocumentationV2 `json:"documentation"`
 	Annotations []string                 `json:"annotations,omitempty"`
+	// Status is only set for checks which didn't complete.
+	Status string `json:"status,omitempty"`
 }
 
+// jsonStatusTimedOut is the status of a check which did not complete within its timeout.
+const jsonStatusTimedOut = "timedOut"
+
 type jsonRepoV2 struct {
 	Name   string `json:"name"`
 	Commit string `json:"commit"`
@@ -174,6 +179,9 @@ func (r *Result) resultsToJSON2(checkDocs docs.Doc, opt *AsJSON2ResultOption) (J
 			Reason: checkResult.Reason,
 			Score:  checkResult.Score,
 		}
+		if checkResult.TimedOut() {
+			tmpResult.Status = jsonStatusTimedOut
+		}
 		if opt.Details {
 			for i := range checkResult.Details {
 				d := checkResult.Details[i]
@@ -245,6 +253,9 @@ func ExperimentalFromJSON2(r io.Reader) (result Result, score float64, err error
 			Score:  check.Score,
 			Reason: check.Reason,
 		}
+		if check.Status == jsonStatusTimedOut {
+			cr.Error = sce.WithMessage(sce.ErrCheckTimeout, check.Reason)
+		}
 		cr.Details = make([]checker.CheckDetail, 0, len(check.Details))
 		for _, detail := range check.Details {
 			cr.Details = append(cr.Details, stringToDetail(detail))
@@ -56,16 +56,34 @@ type jsonTool struct {
 }
 
 type jsonBranchProtectionSettings struct {
-	RequiredApprovingReviewCount        *int32   `json:"requiredReviewerCount"`
-	AllowsDeletions                     *bool    `json:"allowsDeletions"`
-	AllowsForcePushes                   *bool    `json:"allowsForcePushes"`
-	RequiresCodeOwnerReviews            *bool    `json:"requiresCodeOwnerReview"`
-	RequiresLinearHistory               *bool    `json:"requiredLinearHistory"`
-	DismissesStaleReviews               *bool    `json:"dismissesStaleReviews"`
-	EnforcesAdmins                      *bool    `json:"enforcesAdmin"`
-	RequiresStatusChecks                *bool    `json:"requiresStatuChecks"`
-	RequiresUpToDateBranchBeforeMerging *bool    `json:"requiresUpdatedBranchesToMerge"`
-	StatusCheckContexts                 []string `json:"statusChecksContexts"`
+	RequiredApprovingReviewCount        *int32            `json:"requiredReviewerCount"`
+	AllowsDeletions                     *bool             `json:"allowsDeletions"`
+	AllowsForcePushes                   *bool             `json:"allowsForcePushes"`
+	RequiresCodeOwnerReviews            *bool             `json:"requiresCodeOwnerReview"`
+	RequiresLinearHistory               *bool             `json:"requiredLinearHistory"`
+	DismissesStaleReviews               *bool             `json:"dismissesStaleReviews"`
+	EnforcesAdmins                      *bool             `json:"enforcesAdmin"`
+	RequiresStatusChecks                *bool             `json:"requiresStatuChecks"`
+	RequiresUpToDateBranchBeforeMerging *bool             `json:"requiresUpdatedBranchesToMerge"`
+	StatusCheckContexts                 []string          `json:"statusChecksContexts"`
+	RequiresSignedCommits               *bool             `json:"requiresSignedCommits,omitempty"`
+	RequiresMergeQueue                  *bool             `json:"requiresMergeQueue,omitempty"`
+	RequiredDeployments                 []string          `json:"requiredDeployments,omitempty"`
+	BypassActors                        []jsonBypassActor `json:"bypassActors,omitempty"`
+	RuleSources                         []jsonRuleSource  `json:"ruleSources,omitempty"`
+}
+
+type jsonBypassActor struct {
+	Name            string `json:"name,omitempty"`
+	Type            string `json:"type"`
+	Source          string `json:"source,omitempty"`
+	PullRequestOnly bool   `json:"pullRequestOnly,omitempty"`
+}
+
+type jsonRuleSource struct {
+	Name     string `json:"name,omitempty"`
+	Type     string `json:"type"`
+	Evaluate bool   `json:"evaluate,omitempty"`
 }
 
 type jsonBranchProtection struct {
@@ -95,8 +113,28 @@ type jsonUser struct {
 }
 
 type jsonContributors struct {
-	Users []jsonUser `json:"users"`
-	// TODO: high-level statistics, etc
+	Activity *jsonContributorActivity `json:"activity,omitempty"`
+	Users    []jsonUser               `json:"users"`
+}
+
+type jsonAuthorActivity struct {
+	FirstChange  time.Time `json:"firstChange"`
+	LastChange   time.Time `json:"lastChange"`
+	Login        string    `json:"login"`
+	Organization string    `json:"organization,omitempty"`
+	Changes      int       `json:"changes"`
+}
+
+type jsonContributorActivity struct {
+	Since                time.Time            `json:"since"`
+	Until                time.Time            `json:"until"`
+	Authors              []jsonAuthorActivity `json:"authors"`
+	Reviewers            []string             `json:"reviewers"`
+	Mergers              []string             `json:"mergers"`
+	DepartedContributors []jsonAuthorActivity `json:"departedContributors"`
+	Changes              int                  `json:"changes"`
+	BusFactor50          int                  `json:"busFactor50"`
+	BusFactor80          int                  `json:"busFactor80"`
 }
 
 type jsonOrganization struct {
@@ -154,13 +192,24 @@ type jsonIssue struct {
 	Author    *jsonUser     `json:"author"`
 	URL       string        `json:"URL"`
 	Comments  []jsonComment `json:"comments"`
+	Labels    []string      `json:"labels,omitempty"`
 	// TODO: add fields, e.g., state=[opened|closed]
 }
 
+type jsonPullRequest struct {
+	CreatedAt time.Time     `json:"createdAt"`
+	ClosedAt  *time.Time    `json:"closedAt,omitempty"`
+	Author    *jsonUser     `json:"author"`
+	URL       string        `json:"URL"`
+	State     string        `json:"state"`
+	Comments  []jsonComment `json:"comments"`
+}
+
 type jsonRelease struct {
 	Tag    string             `json:"tag"`
 	URL    string             `json:"url"`
 	Assets []jsonReleaseAsset `json:"assets"`
+	Signed bool               `json:"signedTag,omitempty"`
 	// TODO: add needed fields, e.g. Path.
 }
 
@@ -185,6 +234,26 @@ type jsonLicense struct {
 	License jsonLicenseInfo `json:"file"`
 }
 
+type jsonSourceLicense struct {
+	File        jsonFile `json:"file"`
+	Declaration string   `json:"declaration"`
+	Expression  string   `json:"expression"`
+	Source      string   `json:"source"`
+}
+
+type jsonLicenseConflict struct {
+	License        jsonSourceLicense `json:"license"`
+	ProjectLicense string            `json:"projectLicense"`
+}
+
+type jsonSourceLicenses struct {
+	Licenses        []jsonSourceLicense   `json:"licenses"`
+	UnlicensedFiles []jsonFile            `json:"unlicensedFiles"`
+	Conflicts       []jsonLicenseConflict `json:"conflicts"`
+	ScannedFiles    int                   `json:"scannedFiles"`
+	REUSE           bool                  `json:"reuse"`
+}
+
 type jsonWorkflow struct {
 	Job  *jsonWorkflowJob `json:"job"`
 	File *jsonFile        `json:"file"`
@@ -256,8 +325,12 @@ type jsonRawResults struct {
 	Permissions jsonPermissionsData `json:"permissions"`
 	// License.
 	Licenses []jsonLicense `json:"licenses"`
+	// Licenses declared by the source files, if the deep license scan is enabled.
+	SourceLicenses *jsonSourceLicenses `json:"sourceLicenses,omitempty"`
 	// List of recent issues.
 	RecentIssues []jsonIssue `json:"issues"`
+	// List of recent pull requests.
+	RecentPullRequests []jsonPullRequest `json:"pullRequests,omitempty"`
 	// OSSF best practices badge.
 	OssfBestPractices jsonOssfBestPractices `json:"openssfBestPracticesBadge"`
 	// Vulnerabilities.
@@ -463,17 +536,46 @@ func (r *jsonScorecardRawResult) addContributorsRawResults(cr *checker.Contribut
 		r.Results.Contributors.Users = append(r.Results.Contributors.Users, u)
 	}
 
+	if a := cr.Activity; a != nil {
+		r.Results.Contributors.Activity = &jsonContributorActivity{
+			Since:                a.Since,
+			Until:                a.Until,
+			Authors:              jsonAuthorActivities(a.Authors),
+			Reviewers:            a.Reviewers,
+			Mergers:              a.Mergers,
+			DepartedContributors: jsonAuthorActivities(a.DepartedContributors),
+			Changes:              a.Changes,
+			BusFactor50:          a.BusFactor50,
+			BusFactor80:          a.BusFactor80,
+		}
+	}
+
 	return nil
 }
 
+func jsonAuthorActivities(authors []checker.AuthorActivity) []jsonAuthorActivity {
+	ret := make([]jsonAuthorActivity, 0, len(authors))
+	for _, a := range authors {
+		ret = append(ret, jsonAuthorActivity{
+			FirstChange:  a.FirstChange,
+			LastChange:   a.LastChange,
+			Login:        a.Login,
+			Organization: a.Organization,
+			Changes:      a.Changes,
+		})
+	}
+	return ret
+}
+
 //nolint:unparam
 func (r *jsonScorecardRawResult) addSignedReleasesRawResults(sr *checker.SignedReleasesData) error {
 	r.Results.Releases = []jsonRelease{}
 	for i, release := range sr.Releases {
 		r.Results.Releases = append(r.Results.Releases,
 			jsonRelease{
-				Tag: release.TagName,
-				URL: release.URL,
+				Tag:    release.TagName,
+				URL:    release.URL,
+				Signed: release.TagSignature != "",
 			})
 		for _, asset := range release.Assets {
 			r.Results.Releases[i].Assets = append(r.Results.Releases[i].Assets,
@@ -523,9 +625,44 @@ func (r *jsonScorecardRawResult) addMaintainedRawResults(mr *checker.MaintainedD
 			issue.Comments = append(issue.Comments, comment)
 		}
 
+		for _, label := range mr.Issues[i].Labels {
+			issue.Labels = append(issue.Labels, label.Name)
+		}
+
 		r.Results.RecentIssues = append(r.Results.RecentIssues, issue)
 	}
 
+	// Pull requests.
+	for i := range mr.PullRequests {
+		pr := &mr.PullRequests[i]
+		jpr := jsonPullRequest{
+			CreatedAt: pr.CreatedAt,
+			URL:       pr.URI,
+			State:     pr.State,
+			Author:    &jsonUser{Login: pr.Author.Login},
+		}
+		if pr.AuthorAssociation != nil {
+			jpr.Author.RepoAssociation = getStrPtr(pr.AuthorAssociation.String())
+		}
+		if !pr.ClosedAt.IsZero() {
+			closedAt := pr.ClosedAt
+			jpr.ClosedAt = &closedAt
+		}
+		for j := range pr.Comments {
+			comment := jsonComment{
+				CreatedAt: pr.Comments[j].CreatedAt,
+			}
+			if pr.Comments[j].Author != nil {
+				comment.Author = &jsonUser{Login: pr.Comments[j].Author.Login}
+				if pr.Comments[j].AuthorAssociation != nil {
+					comment.Author.RepoAssociation = getStrPtr(pr.Comments[j].AuthorAssociation.String())
+				}
+			}
+			jpr.Comments = append(jpr.Comments, comment)
+		}
+		r.Results.RecentPullRequests = append(r.Results.RecentPullRequests, jpr)
+	}
+
 	return nil
 }
 
@@ -610,9 +747,47 @@ func (r *jsonScorecardRawResult) addLicenseRawResults(ld *checker.LicenseData) e
 			},
 		)
 	}
+	if ld.SourceLicenses == nil {
+		return nil
+	}
+	sl := ld.SourceLicenses
+	r.Results.SourceLicenses = &jsonSourceLicenses{
+		Licenses:        []jsonSourceLicense{},
+		UnlicensedFiles: []jsonFile{},
+		Conflicts:       []jsonLicenseConflict{},
+		ScannedFiles:    sl.ScannedFiles,
+		REUSE:           sl.REUSE,
+	}
+	for i := range sl.Licenses {
+		r.Results.SourceLicenses.Licenses = append(r.Results.SourceLicenses.Licenses,
+			newJSONSourceLicense(&sl.Licenses[i]))
+	}
+	for i := range sl.UnlicensedFiles {
+		r.Results.SourceLicenses.UnlicensedFiles = append(r.Results.SourceLicenses.UnlicensedFiles,
+			jsonFile{Path: sl.UnlicensedFiles[i].Path})
+	}
+	for i := range sl.Conflicts {
+		r.Results.SourceLicenses.Conflicts = append(r.Results.SourceLicenses.Conflicts,
+			jsonLicenseConflict{
+				License:        newJSONSourceLicense(&sl.Conflicts[i].SourceLicense),
+				ProjectLicense: sl.Conflicts[i].ProjectLicense,
+			})
+	}
 	return nil
 }
 
+func newJSONSourceLicense(l *checker.SourceLicense) jsonSourceLicense {
+	return jsonSourceLicense{
+		File: jsonFile{
+			Path:   l.File.Path,
+			Offset: l.File.Offset,
+		},
+		Declaration: l.Declaration,
+		Expression:  l.Expression,
+		Source:      string(l.Source),
+	}
+}
+
 //nolint:unparam
 func (r *jsonScorecardRawResult) addVulnerabilitiesRawResults(vd *checker.VulnerabilitiesData) error {
 	r.Results.DatabaseVulnerabilities = []jsonDatabaseVulnerability{}
@@ -720,6 +895,24 @@ func (r *jsonScorecardRawResult) addBranchProtectionRawResults(bp *checker.Branc
 				RequiresUpToDateBranchBeforeMerging: v.BranchProtectionRule.CheckRules.UpToDateBeforeMerge,
 				RequiredApprovingReviewCount:        v.BranchProtectionRule.PullRequestRule.RequiredApprovingReviewCount,
 				StatusCheckContexts:                 v.BranchProtectionRule.CheckRules.Contexts,
+				RequiresSignedCommits:               v.BranchProtectionRule.RequireSignedCommits,
+				RequiresMergeQueue:                  v.BranchProtectionRule.RequireMergeQueue,
+				RequiredDeployments:                 v.BranchProtectionRule.RequiredDeployments,
+			}
+			for _, actor := range v.BranchProtectionRule.BypassActors {
+				bp.BypassActors = append(bp.BypassActors, jsonBypassActor{
+					Name:            actor.Name,
+					Type:            string(actor.Type),
+					Source:          actor.Source,
+					PullRequestOnly: actor.PullRequestOnly,
+				})
+			}
+			for _, source := range v.BranchProtectionRule.Sources {
+				bp.RuleSources = append(bp.RuleSources, jsonRuleSource{
+					Name:     source.Name,
+					Type:     string(source.Type),
+					Evaluate: source.Evaluate,
+				})
 			}
 		}
 		branches = append(branches, jsonBranchProtection{
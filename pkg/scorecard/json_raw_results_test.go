@@ -785,6 +785,98 @@ func TestAddLicenseRawResults(t *testing.T) {
 	}
 }
 
+func TestAddLicenseRawResults_SourceLicenses(t *testing.T) {
+	t.Parallel()
+	r := &jsonScorecardRawResult{}
+	gpl := checker.SourceLicense{
+		File:        checker.File{Path: "gpl.c", Offset: 2},
+		Declaration: "gpl.c",
+		Expression:  "GPL-3.0-only",
+		Source:      checker.SourceLicenseSourceHeader,
+	}
+	ld := &checker.LicenseData{
+		SourceLicenses: &checker.SourceLicenseData{
+			Licenses:        []checker.SourceLicense{gpl},
+			UnlicensedFiles: []checker.File{{Path: "main.go"}},
+			Conflicts:       []checker.LicenseConflict{{SourceLicense: gpl, ProjectLicense: "MIT"}},
+			ScannedFiles:    2,
+			REUSE:           true,
+		},
+	}
+	if err := r.addLicenseRawResults(ld); err != nil {
+		t.Fatalf("addLicenseRawResults returned an error: %v", err)
+	}
+
+	jsonGPL := jsonSourceLicense{
+		File:        jsonFile{Path: "gpl.c", Offset: 2},
+		Declaration: "gpl.c",
+		Expression:  "GPL-3.0-only",
+		Source:      "header",
+	}
+	want := &jsonSourceLicenses{
+		Licenses:        []jsonSourceLicense{jsonGPL},
+		UnlicensedFiles: []jsonFile{{Path: "main.go"}},
+		Conflicts:       []jsonLicenseConflict{{License: jsonGPL, ProjectLicense: "MIT"}},
+		ScannedFiles:    2,
+		REUSE:           true,
+	}
+	if diff := cmp.Diff(want, r.Results.SourceLicenses); diff != "" {
+		t.Errorf("source licenses mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAddContributorsRawResults_Activity(t *testing.T) {
+	t.Parallel()
+	r := &jsonScorecardRawResult{}
+	until := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	since := until.AddDate(0, -6, 0)
+	alice := checker.AuthorActivity{
+		Login:        "alice@example.com",
+		Organization: "example.com",
+		Changes:      3,
+		FirstChange:  since,
+		LastChange:   since,
+	}
+	cr := &checker.ContributorsData{
+		Activity: &checker.ContributorActivity{
+			Since:                since,
+			Until:                until,
+			Authors:              []checker.AuthorActivity{alice},
+			Reviewers:            []string{"bob"},
+			Mergers:              []string{"bob"},
+			DepartedContributors: []checker.AuthorActivity{alice},
+			Changes:              3,
+			BusFactor50:          1,
+			BusFactor80:          1,
+		},
+	}
+	if err := r.addContributorsRawResults(cr); err != nil {
+		t.Fatalf("addContributorsRawResults returned an error: %v", err)
+	}
+
+	jsonAlice := jsonAuthorActivity{
+		Login:        "alice@example.com",
+		Organization: "example.com",
+		Changes:      3,
+		FirstChange:  since,
+		LastChange:   since,
+	}
+	want := &jsonContributorActivity{
+		Since:                since,
+		Until:                until,
+		Authors:              []jsonAuthorActivity{jsonAlice},
+		Reviewers:            []string{"bob"},
+		Mergers:              []string{"bob"},
+		DepartedContributors: []jsonAuthorActivity{jsonAlice},
+		Changes:              3,
+		BusFactor50:          1,
+		BusFactor80:          1,
+	}
+	if diff := cmp.Diff(want, r.Results.Contributors.Activity); diff != "" {
+		t.Errorf("contributor activity mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestAddBinaryArtifactRawResults(t *testing.T) {
 	t.Parallel()
 	r := &jsonScorecardRawResult{}
@@ -466,6 +466,32 @@ func TestJSONOutput(t *testing.T) {
 				Metadata: []string{},
 			},
 		},
+		{
+			name:        "check-7 timed out",
+			showDetails: true,
+			expected:    "./testdata/check7.json",
+			logLevel:    log.WarnLevel,
+			result: Result{
+				Repo: RepoInfo{
+					Name:      repoName,
+					CommitSHA: repoCommit,
+				},
+				Scorecard: ScorecardInfo{
+					Version:   scorecardVersion,
+					CommitSHA: scorecardCommit,
+				},
+				Date: date,
+				Checks: []checker.CheckResult{
+					{
+						Score:  6,
+						Reason: "six score reason",
+						Name:   "Check-Name",
+					},
+					checker.CreateTimeoutResult("Check-Name2", 10*time.Minute),
+				},
+				Metadata: []string{},
+			},
+		},
 	}
 
 	// Load the JSON schema.
@@ -586,3 +612,25 @@ func TestExperimentalFromJSON2_time(t *testing.T) {
 		})
 	}
 }
+
+func TestExperimentalFromJSON2_timedOut(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("./testdata/check7.json")
+	if err != nil {
+		t.Fatalf("os.Open: %v", err)
+	}
+	defer f.Close()
+	got, _, err := ExperimentalFromJSON2(f)
+	if err != nil {
+		t.Fatalf("ExperimentalFromJSON2: %v", err)
+	}
+	if len(got.Checks) != 2 {
+		t.Fatalf("got %d checks, want 2", len(got.Checks))
+	}
+	if got.Checks[0].TimedOut() {
+		t.Errorf("%s: TimedOut() = true, want false", got.Checks[0].Name)
+	}
+	if !got.Checks[1].TimedOut() {
+		t.Errorf("%s: TimedOut() = false, want true", got.Checks[1].Name)
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/log"
+	spol "github.com/ossf/scorecard/v5/policy"
+)
+
+// AsJUnitResultOption provides configuration options for JUnit Scorecard results.
+type AsJUnitResultOption struct {
+	// Policy provides the minimum score for each check. Checks without
+	// an enforced policy only fail on runtime errors.
+	Policy   *spol.ScorecardPolicy
+	LogLevel log.Level
+	Details  bool
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Timestamp  string          `xml:"timestamp,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Cases      []junitTestCase `xml:"testcase"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+func (s *junitTestSuite) add(tc junitTestCase) {
+	s.Tests++
+	switch {
+	case tc.Failure != nil:
+		s.Failures++
+	case tc.Error != nil:
+		s.Errors++
+	case tc.Skipped != nil:
+		s.Skipped++
+	}
+	s.Cases = append(s.Cases, tc)
+}
+
+// checkMinScore returns the minimum score a check must reach according to the
+// policy, and whether the check is enforced at all.
+func checkMinScore(policy *spol.ScorecardPolicy, name string) (int, bool) {
+	if policy == nil {
+		return 0, false
+	}
+	cp, exists := policy.GetPolicies()[name]
+	if !exists || cp.GetMode() != spol.CheckPolicy_ENFORCED {
+		return 0, false
+	}
+	return int(cp.GetScore()), true
+}
+
+func (r *Result) checksToJUnit(checkDocs docs.Doc, opt *AsJUnitResultOption) (junitTestSuite, error) {
+	suite := junitTestSuite{
+		Name:      r.Repo.Name,
+		Timestamp: r.Date.Format(time.RFC3339),
+	}
+	for i := range r.Checks {
+		check := &r.Checks[i]
+		doc, err := checkDocs.GetCheck(check.Name)
+		if err != nil {
+			return suite, fmt.Errorf("GetCheck: %s: %w", check.Name, err)
+		}
+		tc := junitTestCase{
+			Name:      check.Name,
+			ClassName: "scorecard.checks",
+		}
+		if opt.Details {
+			details, _ := detailsToString(check.Details, opt.LogLevel)
+			tc.SystemOut = details
+		}
+
+		minScore, enforced := checkMinScore(opt.Policy, check.Name)
+		switch {
+		case check.Error != nil:
+			tc.Error = &junitMessage{
+				Message: check.Error.Error(),
+				Type:    sce.GetName(check.Error),
+			}
+		case check.Score == checker.InconclusiveResultScore:
+			tc.Skipped = &junitMessage{Message: check.Reason}
+		case enforced && check.Score < minScore:
+			tc.Failure = &junitMessage{
+				Message: fmt.Sprintf("score %d is below the policy minimum of %d: %s",
+					check.Score, minScore, check.Reason),
+				Type: doc.GetRisk(),
+				Text: fmt.Sprintf("%s\n\nRemediation:\n%s\n\nDocumentation: %s",
+					doc.GetShort(),
+					strings.Join(doc.GetRemediation(), "\n"),
+					doc.GetDocumentationURL(r.Scorecard.CommitSHA)),
+			}
+		}
+		suite.add(tc)
+	}
+	return suite, nil
+}
+
+func (r *Result) findingsToJUnit() junitTestSuite {
+	suite := junitTestSuite{
+		Name:      r.Repo.Name,
+		Timestamp: r.Date.Format(time.RFC3339),
+	}
+	for i := range r.Findings {
+		f := &r.Findings[i]
+		tc := junitTestCase{
+			Name:      f.Probe,
+			ClassName: "scorecard.probes",
+		}
+		if f.Location != nil {
+			tc.File = f.Location.Path
+			if f.Location.LineStart != nil {
+				tc.Name = fmt.Sprintf("%s %s:%d", f.Probe, f.Location.Path, *f.Location.LineStart)
+			} else {
+				tc.Name = fmt.Sprintf("%s %s", f.Probe, f.Location.Path)
+			}
+		}
+		switch {
+		case f.Outcome == finding.OutcomeError:
+			tc.Error = &junitMessage{Message: f.Message, Type: string(f.Outcome)}
+		case f.Remediation != nil:
+			// Findings only keep their remediation when the outcome is the
+			// one the probe considers bad.
+			tc.Failure = &junitMessage{
+				Message: f.Message,
+				Type:    string(f.Outcome),
+				Text:    f.Remediation.Text,
+			}
+		case f.Outcome == finding.OutcomeNotApplicable,
+			f.Outcome == finding.OutcomeNotAvailable,
+			f.Outcome == finding.OutcomeNotSupported:
+			tc.Skipped = &junitMessage{Message: f.Message}
+		default:
+			tc.SystemOut = f.Message
+		}
+		suite.add(tc)
+	}
+	return suite
+}
+
+// AsJUnit writes results as JUnit XML. Each check is reported as a test
+// case. If the result only contains probe findings, each finding is
+// reported as a test case instead.
+func (r *Result) AsJUnit(writer io.Writer, checkDocs docs.Doc, opt *AsJUnitResultOption) error {
+	if opt == nil {
+		opt = &AsJUnitResultOption{
+			LogLevel: log.DefaultLevel,
+		}
+	}
+
+	var suite junitTestSuite
+	if len(r.Checks) == 0 && len(r.Findings) > 0 {
+		suite = r.findingsToJUnit()
+	} else {
+		var err error
+		suite, err = r.checksToJUnit(checkDocs, opt)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+	}
+	suite.Properties = []junitProperty{
+		{Name: "repository.commit", Value: r.Repo.CommitSHA},
+		{Name: "scorecard.version", Value: r.Scorecard.Version},
+		{Name: "scorecard.commit", Value: r.Scorecard.CommitSHA},
+	}
+
+	out := junitTestSuites{
+		Name:     "OpenSSF Scorecard",
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Errors:   suite.Errors,
+		Skipped:  suite.Skipped,
+		Suites:   []junitTestSuite{suite},
+	}
+
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("io.WriteString: %v", err))
+	}
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(out); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("encoder.Encode: %v", err))
+	}
+	if _, err := io.WriteString(writer, "\n"); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("io.WriteString: %v", err))
+	}
+	return nil
+}
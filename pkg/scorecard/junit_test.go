@@ -0,0 +1,129 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/log"
+	spol "github.com/ossf/scorecard/v5/policy"
+)
+
+func TestAsJUnit(t *testing.T) {
+	t.Parallel()
+	date := time.Date(2024, time.February, 1, 13, 48, 0, 0, time.UTC)
+	line := uint(4)
+
+	tests := []struct {
+		name         string
+		result       Result
+		policy       *spol.ScorecardPolicy
+		wantTests    int
+		wantFailures int
+		wantErrors   int
+		wantSkipped  int
+	}{
+		{
+			name: "checks without policy only fail on errors",
+			result: Result{
+				Repo: RepoInfo{Name: "github.com/org/name"},
+				Date: date,
+				Checks: []checker.CheckResult{
+					{Name: "Check-Name", Score: 2, Reason: "low"},
+					{Name: "Check-Name2", Score: checker.InconclusiveResultScore, Reason: "unknown"},
+					{
+						Name:  "Check-Name3",
+						Score: checker.InconclusiveResultScore,
+						Error: sce.WithMessage(sce.ErrScorecardInternal, "boom"),
+					},
+				},
+			},
+			wantTests:   3,
+			wantErrors:  1,
+			wantSkipped: 1,
+		},
+		{
+			name: "checks below the policy threshold fail",
+			result: Result{
+				Repo: RepoInfo{Name: "github.com/org/name"},
+				Date: date,
+				Checks: []checker.CheckResult{
+					{Name: "Check-Name", Score: 5, Reason: "half"},
+					{Name: "Check-Name2", Score: 5, Reason: "half"},
+					{Name: "Check-Name3", Score: 9, Reason: "almost"},
+				},
+			},
+			policy: &spol.ScorecardPolicy{
+				Version: 1,
+				Policies: map[string]*spol.CheckPolicy{
+					"Check-Name":  {Score: 6, Mode: spol.CheckPolicy_ENFORCED},
+					"Check-Name2": {Score: 6, Mode: spol.CheckPolicy_DISABLED},
+					"Check-Name3": {Score: 9, Mode: spol.CheckPolicy_ENFORCED},
+				},
+			},
+			wantTests:    3,
+			wantFailures: 1,
+		},
+		{
+			name: "probe findings are reported individually",
+			result: Result{
+				Repo: RepoInfo{Name: "github.com/org/name"},
+				Date: date,
+				Findings: []finding.Finding{
+					{Probe: "probeA", Outcome: finding.OutcomeTrue, Message: "ok"},
+					{
+						Probe:       "probeA",
+						Outcome:     finding.OutcomeFalse,
+						Message:     "not ok",
+						Remediation: &finding.Remediation{Text: "fix it"},
+						Location:    &finding.Location{Path: "a.yml", LineStart: &line},
+					},
+					{Probe: "probeB", Outcome: finding.OutcomeNotApplicable, Message: "n/a"},
+					{Probe: "probeC", Outcome: finding.OutcomeError, Message: "err"},
+				},
+			},
+			wantTests:    4,
+			wantFailures: 1,
+			wantErrors:   1,
+			wantSkipped:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			opt := &AsJUnitResultOption{Policy: tt.policy, LogLevel: log.DefaultLevel}
+			if err := tt.result.AsJUnit(&buf, jsonMockDocRead(), opt); err != nil {
+				t.Fatalf("AsJUnit: %v", err)
+			}
+			var got junitTestSuites
+			if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("xml.Unmarshal: %v", err)
+			}
+			if got.Tests != tt.wantTests || got.Failures != tt.wantFailures ||
+				got.Errors != tt.wantErrors || got.Skipped != tt.wantSkipped {
+				t.Errorf("got tests=%d failures=%d errors=%d skipped=%d, want %d/%d/%d/%d",
+					got.Tests, got.Failures, got.Errors, got.Skipped,
+					tt.wantTests, tt.wantFailures, tt.wantErrors, tt.wantSkipped)
+			}
+		})
+	}
+}
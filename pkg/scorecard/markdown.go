@@ -0,0 +1,144 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/log"
+)
+
+// AsMarkdownResultOption provides configuration options for Markdown Scorecard results.
+type AsMarkdownResultOption struct {
+	LogLevel    log.Level
+	Details     bool
+	Annotations bool
+}
+
+// markdownCell escapes a string so it can be used in a single table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// checkRemediation returns the remediation for a check, preferring the
+// remediation attached to its findings over the generic check documentation.
+func checkRemediation(check *checker.CheckResult, doc docs.CheckDoc) []string {
+	var ret []string
+	seen := map[string]bool{}
+	for i := range check.Findings {
+		rem := check.Findings[i].Remediation
+		if rem == nil || rem.Markdown == "" || seen[rem.Markdown] {
+			continue
+		}
+		seen[rem.Markdown] = true
+		ret = append(ret, rem.Markdown)
+	}
+	if len(ret) > 0 {
+		return ret
+	}
+	return doc.GetRemediation()
+}
+
+// AsMarkdown writes results as a Markdown summary suitable for CI job
+// summaries: a table of scores followed by collapsible per-check details.
+func (r *Result) AsMarkdown(writer io.Writer, checkDocs docs.Doc, opt *AsMarkdownResultOption) error {
+	if opt == nil {
+		opt = &AsMarkdownResultOption{
+			LogLevel:    log.DefaultLevel,
+			Details:     false,
+			Annotations: false,
+		}
+	}
+
+	score, err := r.GetAggregateScore(checkDocs)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# OpenSSF Scorecard for %s\n\n", r.Repo.Name)
+	fmt.Fprintf(&sb, "**Aggregate score: %s / %d**\n\n", scoreToString(score), checker.MaxResultScore)
+	fmt.Fprintf(&sb, "Commit `%s` analyzed on %s with Scorecard %s.\n\n",
+		r.Repo.CommitSHA, r.Date.Format("2006-01-02"), r.Scorecard.Version)
+
+	sb.WriteString("| Score | Check | Reason |\n")
+	sb.WriteString("| ---: | --- | --- |\n")
+	for i := range r.Checks {
+		check := &r.Checks[i]
+		doc, e := checkDocs.GetCheck(check.Name)
+		if e != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("GetCheck: %s: %v", check.Name, e))
+		}
+		s := "?"
+		if check.Score != checker.InconclusiveResultScore {
+			s = fmt.Sprintf("%d / %d", check.Score, checker.MaxResultScore)
+		}
+		fmt.Fprintf(&sb, "| %s | [%s](%s) | %s |\n", s, check.Name,
+			doc.GetDocumentationURL(r.Scorecard.CommitSHA), markdownCell(check.Reason))
+	}
+	sb.WriteString("\n")
+
+	for i := range r.Checks {
+		check := &r.Checks[i]
+		doc, e := checkDocs.GetCheck(check.Name)
+		if e != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("GetCheck: %s: %v", check.Name, e))
+		}
+		details, hasDetails := detailsToString(check.Details, opt.LogLevel)
+		hasDetails = hasDetails && opt.Details
+		var annotations []string
+		if opt.Annotations {
+			annotations = check.Annotations(r.Config)
+		}
+		remediation := checkRemediation(check, doc)
+		if check.Score == checker.MaxResultScore {
+			remediation = nil
+		}
+		if !hasDetails && len(annotations) == 0 && len(remediation) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "<details>\n<summary>%s</summary>\n\n", check.Name)
+		if hasDetails {
+			fmt.Fprintf(&sb, "**Details**\n\n```\n%s\n```\n\n", details)
+		}
+		if len(annotations) > 0 {
+			sb.WriteString("**Maintainer annotations**\n\n")
+			for _, a := range annotations {
+				fmt.Fprintf(&sb, "- %s\n", a)
+			}
+			sb.WriteString("\n")
+		}
+		if len(remediation) > 0 {
+			sb.WriteString("**Remediation**\n\n")
+			for _, rem := range remediation {
+				fmt.Fprintf(&sb, "- %s\n", rem)
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("</details>\n\n")
+	}
+
+	if _, err := io.WriteString(writer, sb.String()); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("io.WriteString: %v", err))
+	}
+	return nil
+}
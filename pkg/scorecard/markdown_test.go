@@ -0,0 +1,88 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/log"
+)
+
+func TestAsMarkdown(t *testing.T) {
+	t.Parallel()
+	result := Result{
+		Repo: RepoInfo{Name: "github.com/org/name", CommitSHA: "abc"},
+		Date: time.Date(2024, time.February, 1, 13, 48, 0, 0, time.UTC),
+		Checks: []checker.CheckResult{
+			{
+				Name:   "Check-Name",
+				Score:  10,
+				Reason: "all good",
+			},
+			{
+				Name:   "Check-Name2",
+				Score:  3,
+				Reason: "a | b\nc",
+				Details: []checker.CheckDetail{
+					{Type: checker.DetailWarn, Msg: checker.LogMessage{Text: "warn text"}},
+				},
+				Findings: []finding.Finding{
+					{
+						Probe:       "p",
+						Outcome:     finding.OutcomeFalse,
+						Remediation: &finding.Remediation{Markdown: "do **this**"},
+					},
+				},
+			},
+			{
+				Name:   "Check-Name3",
+				Score:  checker.InconclusiveResultScore,
+				Reason: "unknown",
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	opt := &AsMarkdownResultOption{LogLevel: log.DefaultLevel, Details: true}
+	if err := result.AsMarkdown(&buf, jsonMockDocRead(), opt); err != nil {
+		t.Fatalf("AsMarkdown: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"# OpenSSF Scorecard for github.com/org/name",
+		"| 10 / 10 | [Check-Name](https://github.com/ossf/scorecard/blob/main/docs/checks.md#check-name) | all good |",
+		`| 3 / 10 | [Check-Name2]`,
+		`a \| b<br>c`,
+		"| ? | [Check-Name3]",
+		"<summary>Check-Name2</summary>",
+		"warn text",
+		"- do **this**",
+		// Check-Name3 has no findings, so it falls back to the check documentation.
+		"- not-used1",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "<summary>Check-Name</summary>") {
+		t.Errorf("checks with a perfect score should not have a details section")
+	}
+}
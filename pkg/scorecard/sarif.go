@@ -141,6 +141,24 @@ type automationDetails struct {
 	ID string `json:"id"`
 }
 
+type reportingDescriptorReference struct {
+	ID    string `json:"id"`
+	Index int    `json:"index"`
+}
+
+// notification reports a problem running a check.
+// See https://docs.oasis-open.org/sarif/sarif/v2.1.0/cs01/sarif-v2.1.0-cs01.html#_Toc16012894.
+type notification struct {
+	Message        text                         `json:"message"`
+	Level          string                       `json:"level"`
+	AssociatedRule reportingDescriptorReference `json:"associatedRule"`
+}
+
+type invocation struct {
+	ToolExecutionNotifications []notification `json:"toolExecutionNotifications"`
+	ExecutionSuccessful        bool           `json:"executionSuccessful"`
+}
+
 type run struct {
 	AutomationDetails automationDetails `json:"automationDetails"`
 	Tool              tool              `json:"tool"`
@@ -149,6 +167,8 @@ type run struct {
 	Artifacts string `json:"artifacts,omitempty"`
 	// This MUST never be omitted or set as `nil`.
 	Results []result `json:"results"`
+	// Only set when some checks did not complete.
+	Invocations []invocation `json:"invocations,omitempty"`
 }
 
 type sarif210 struct {
@@ -438,6 +458,22 @@ func createSARIFRun(uri, toolName, version, commit string, t time.Time,
 	}
 }
 
+func addTimeoutNotification(r *run, ruleID string, ruleIndex int, msg string) {
+	if len(r.Invocations) == 0 {
+		r.Invocations = []invocation{{ExecutionSuccessful: true}}
+	}
+	inv := &r.Invocations[0]
+	inv.ExecutionSuccessful = false
+	inv.ToolExecutionNotifications = append(inv.ToolExecutionNotifications, notification{
+		Message: text{Text: msg},
+		Level:   "error",
+		AssociatedRule: reportingDescriptorReference{
+			ID:    ruleID,
+			Index: ruleIndex,
+		},
+	})
+}
+
 func getOrCreateSARIFRun(runs map[string]*run, runName string,
 	uri, toolName, version, commit string, t time.Time,
 	category string,
@@ -665,6 +701,12 @@ func (r *Result) AsSARIF(showDetails bool, logLevel log.Level,
 			continue
 		}
 
+		// Timed out checks have no score: report them as a failed
+		// execution of the tool rather than as results.
+		if check.TimedOut() {
+			addTimeoutNotification(run, sarifCheckID, len(run.Tool.Driver.Rules)-1, check.Reason)
+		}
+
 		// Skip check that do not violate the policy.
 		if check.Score >= minScore || check.Score == checker.InconclusiveResultScore {
 			continue
@@ -877,6 +877,36 @@ func TestSARIFOutput(t *testing.T) {
 				Metadata: []string{},
 			},
 		},
+		{
+			name:        "check-9 timed out",
+			showDetails: true,
+			expected:    "./testdata/check9.sarif",
+			logLevel:    log.DebugLevel,
+			policy: spol.ScorecardPolicy{
+				Version: 1,
+				Policies: map[string]*spol.CheckPolicy{
+					"Check-Name": {
+						Score: checker.MaxResultScore,
+						Mode:  spol.CheckPolicy_ENFORCED,
+					},
+				},
+			},
+			result: Result{
+				Repo: RepoInfo{
+					Name:      repoName,
+					CommitSHA: repoCommit,
+				},
+				Scorecard: ScorecardInfo{
+					Version:   scorecardVersion,
+					CommitSHA: scorecardCommit,
+				},
+				Date: date,
+				Checks: []checker.CheckResult{
+					checker.CreateTimeoutResult("Check-Name", 10*time.Minute),
+				},
+				Metadata: []string{},
+			},
+		},
 	}
 	for i := range tests {
 		tt := &tests[i] // Re-initializing variable so it is not changed while executing the closure below
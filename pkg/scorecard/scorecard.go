@@ -20,15 +20,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"sigs.k8s.io/release-utils/version"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/git"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
 	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
 	"github.com/ossf/scorecard/v5/clients/localdir"
@@ -40,27 +43,64 @@ import (
 	proberegistration "github.com/ossf/scorecard/v5/internal/probes"
 	sclog "github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/policy"
+	"github.com/ossf/scorecard/v5/probes/hasLeakedSecrets"
+	"github.com/ossf/scorecard/v5/scoring"
+	"github.com/ossf/scorecard/v5/stats"
 )
 
-// errEmptyRepository indicates the repository is empty.
-var errEmptyRepository = errors.New("repository empty")
+var (
+	// errEmptyRepository indicates the repository is empty.
+	errEmptyRepository = errors.New("repository empty")
+
+	errInvalidTimeout     = errors.New("invalid check timeout")
+	errInvalidParallelism = errors.New("invalid number of parallel checks")
+	errInvalidLookBack    = errors.New("invalid look-back window")
+)
+
+// checkLimits bounds the resources used to run checks.
+type checkLimits struct {
+	// timeouts overrides timeout for individual checks.
+	timeouts map[string]time.Duration
+	timeout  time.Duration
+	// maxParallel is the maximum number of checks run concurrently.
+	// Zero means no limit.
+	maxParallel int
+}
+
+// timeoutFor returns the timeout of the given check.
+func (l *checkLimits) timeoutFor(check string) time.Duration {
+	if timeout, ok := l.timeouts[check]; ok {
+		return timeout
+	}
+	return l.timeout
+}
 
 func runEnabledChecks(ctx context.Context,
 	repo clients.Repo,
 	request *checker.CheckRequest,
 	checksToRun checker.CheckNameToFnMap,
+	limits checkLimits,
 	resultsCh chan<- checker.CheckResult,
 ) {
+	var sem chan struct{}
+	if limits.maxParallel > 0 {
+		sem = make(chan struct{}, limits.maxParallel)
+	}
 	wg := sync.WaitGroup{}
 	for checkName, checkFn := range checksToRun {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
+			if sem != nil {
+				sem <- struct{}{}
+				defer func() { <-sem }()
+			}
 			runner := checker.NewRunner(
 				checkName,
 				repo.URI(),
 				request,
 			)
+			runner.SetTimeout(limits.timeoutFor(checkName))
 
 			resultsCh <- runner.Run(ctx, checkFn)
 		}()
@@ -87,17 +127,11 @@ func getRepoCommitHash(r clients.RepoClient) (string, error) {
 
 func runScorecard(ctx context.Context,
 	repo clients.Repo,
-	commitSHA string,
-	commitDepth int,
 	checksToRun checker.CheckNameToFnMap,
-	probesToRun []string,
-	repoClient clients.RepoClient,
-	ossFuzzRepoClient clients.RepoClient,
-	ciiClient clients.CIIBestPracticesClient,
-	vulnsClient clients.VulnerabilitiesClient,
-	projectClient packageclient.ProjectPackageClient,
+	c *runConfig,
 ) (Result, error) {
-	if err := repoClient.InitRepo(repo, commitSHA, commitDepth); err != nil {
+	repoClient := c.client
+	if err := repoClient.InitRepo(repo, c.commit, c.commitDepth); err != nil {
 		// No need to call sce.WithMessage() since InitRepo will do that for us.
 		//nolint:wrapcheck
 		return Result{}, err
@@ -108,7 +142,7 @@ func runScorecard(ctx context.Context,
 	ret := Result{
 		Repo: RepoInfo{
 			Name:      repo.URI(),
-			CommitSHA: commitSHA,
+			CommitSHA: c.commit,
 		},
 		Scorecard: ScorecardInfo{
 			Version:   versionInfo.GitVersion,
@@ -152,30 +186,36 @@ func runScorecard(ctx context.Context,
 		"repository.defaultBranch": defaultBranch,
 		"localPath":                localPath,
 	}
+	trace.SpanFromContext(ctx).SetAttributes(
+		stats.AttrRepo.String(repo.URI()),
+		stats.AttrRepoHost.String(repo.Host()),
+	)
 
 	request := &checker.CheckRequest{
-		Ctx:                   ctx,
-		RepoClient:            repoClient,
-		OssFuzzRepo:           ossFuzzRepoClient,
-		CIIClient:             ciiClient,
-		VulnerabilitiesClient: vulnsClient,
-		ProjectClient:         projectClient,
-		Repo:                  repo,
-		RawResults:            &ret.RawResults,
-	}
-
-	// If the user runs probes
-	if len(probesToRun) > 0 {
-		err = runEnabledProbes(request, probesToRun, &ret)
-		if err != nil {
-			return Result{}, err
+		Ctx:                    ctx,
+		RepoClient:             repoClient,
+		OssFuzzRepo:            c.ossfuzzClient,
+		CIIClient:              c.ciiClient,
+		VulnerabilitiesClient:  c.vulnClient,
+		ProjectClient:          c.projectClient,
+		Repo:                   repo,
+		RawResults:             &ret.RawResults,
+		LicenseDeepScan:        c.licenseDeepScan,
+		MaintainedLookBackDays: c.lookBackDays,
+	}
+	// Cloning the history is only worth it if the probe scanning it runs.
+	if c.secretsHistory && c.runsProbe(hasLeakedSecrets.Probe) {
+		historyClient, ok := clients.UnwrapRepoClient(repoClient).(*git.Client)
+		if !ok {
+			historyClient = &git.Client{}
+			if err := historyClient.InitRepo(repo, c.commit, c.commitDepth); err != nil {
+				return Result{}, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("git.InitRepo: %v", err))
+			}
+			defer historyClient.Close()
 		}
-		return ret, nil
+		request.HistoryClient = historyClient
 	}
 
-	// If the user runs checks
-	go runEnabledChecks(ctx, repo, request, checksToRun, resultsCh)
-
 	// get the repository's config file to read annotations
 	r, path := findConfigFile(repoClient)
 	logger := sclog.NewLogger(sclog.DefaultLevel)
@@ -189,11 +229,28 @@ func runScorecard(ctx context.Context,
 		}
 		ret.Config = c
 	}
+	request.Config = &ret.Config
+
+	// If the user runs probes
+	if len(c.probes) > 0 {
+		err = runEnabledProbes(request, c.probes, &ret)
+		if err != nil {
+			return Result{}, err
+		}
+		return ret, nil
+	}
+
+	// If the user runs checks
+	go runEnabledChecks(ctx, repo, request, checksToRun, c.limits, resultsCh)
 
 	for result := range resultsCh {
 		ret.Checks = append(ret.Checks, result)
 		ret.Findings = append(ret.Findings, result.Findings...)
 	}
+
+	if c.scoring != nil && len(c.scoring.Checks) > 0 {
+		runCustomChecks(request, c.scoring, &ret)
+	}
 	return ret, nil
 }
 
@@ -223,17 +280,78 @@ func runEnabledProbes(request *checker.CheckRequest,
 	probesToRun []string,
 	ret *Result,
 ) error {
+	probeFindings, err := runProbes(request, probesToRun, ret)
+	if err != nil {
+		return err
+	}
+	ret.Findings = probeFindings
+	return nil
+}
+
+// runCustomChecks evaluates the custom checks of the scoring configuration,
+// running the probes whose findings weren't produced by the built-in checks.
+func runCustomChecks(request *checker.CheckRequest, scoringConfig *scoring.Config, ret *Result) {
+	ran := map[string]bool{}
+	for i := range ret.Findings {
+		ran[ret.Findings[i].Probe] = true
+	}
+	var missing []string
+	for _, probe := range scoringConfig.ProbesToRun() {
+		if !ran[probe] {
+			missing = append(missing, probe)
+		}
+	}
+	var err error
+	if len(missing) > 0 {
+		var findings []finding.Finding
+		findings, err = runProbes(request, missing, ret)
+		ret.Findings = append(ret.Findings, findings...)
+	}
+
+	names := make([]string, 0, len(scoringConfig.Checks))
+	for name := range scoringConfig.Checks {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if err != nil {
+			e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+			ret.Checks = append(ret.Checks, checker.CreateRuntimeErrorResult(name, e))
+			continue
+		}
+		check := scoringConfig.Checks[name]
+		dl := checker.NewLogger()
+		result := scoring.Evaluate(name, &check, ret.Findings, dl)
+		result.Details = dl.Flush()
+		probes := map[string]bool{}
+		for _, p := range check.Probes {
+			probes[p.Name] = true
+		}
+		for i := range ret.Findings {
+			if probes[ret.Findings[i].Probe] {
+				result.Findings = append(result.Findings, ret.Findings[i])
+			}
+		}
+		ret.Checks = append(ret.Checks, result)
+	}
+}
+
+// runProbes runs the given probes and returns their findings.
+func runProbes(request *checker.CheckRequest,
+	probesToRun []string,
+	ret *Result,
+) ([]finding.Finding, error) {
 	// Add RawResults to request
 	err := populateRawResults(request, probesToRun, ret)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	probeFindings := make([]finding.Finding, 0)
 	for _, probeName := range probesToRun {
 		probe, err := proberegistration.Get(probeName)
 		if err != nil {
-			return fmt.Errorf("getting probe %q: %w", probeName, err)
+			return nil, fmt.Errorf("getting probe %q: %w", probeName, err)
 		}
 		// Run probe
 		var findings []finding.Finding
@@ -243,26 +361,38 @@ func runEnabledProbes(request *checker.CheckRequest,
 			findings, _, err = probe.Implementation(&ret.RawResults)
 		}
 		if err != nil {
-			return sce.WithMessage(sce.ErrScorecardInternal, "ending run")
+			return nil, sce.WithMessage(sce.ErrScorecardInternal, "ending run")
 		}
 		probeFindings = append(probeFindings, findings...)
 	}
-	ret.Findings = probeFindings
-	return nil
+	return probeFindings, nil
 }
 
 type runConfig struct {
-	client        clients.RepoClient
-	vulnClient    clients.VulnerabilitiesClient
-	ciiClient     clients.CIIBestPracticesClient
-	projectClient packageclient.ProjectPackageClient
-	ossfuzzClient clients.RepoClient
-	commit        string
-	logLevel      sclog.Level
-	checks        []string
-	probes        []string
-	commitDepth   int
-	gitMode       bool
+	client          clients.RepoClient
+	vulnClient      clients.VulnerabilitiesClient
+	ciiClient       clients.CIIBestPracticesClient
+	projectClient   packageclient.ProjectPackageClient
+	ossfuzzClient   clients.RepoClient
+	commit          string
+	logLevel        sclog.Level
+	checks          []string
+	probes          []string
+	commitDepth     int
+	gitMode         bool
+	licenseDeepScan bool
+	secretsHistory  bool
+	lookBackDays    int
+	limits          checkLimits
+	scoring         *scoring.Config
+}
+
+// runsProbe reports whether the probe runs, on its own or for a custom check.
+func (c *runConfig) runsProbe(probe string) bool {
+	if len(c.probes) > 0 {
+		return slices.Contains(c.probes, probe)
+	}
+	return slices.Contains(c.scoring.ProbesToRun(), probe)
 }
 
 type Option func(*runConfig) error
@@ -346,6 +476,15 @@ func WithOpenSSFBestPraticesClient(client clients.CIIBestPracticesClient) Option
 	}
 }
 
+// WithProjectPackageClient will set the client used to query package
+// registries for the packages published from a project.
+func WithProjectPackageClient(client packageclient.ProjectPackageClient) Option {
+	return func(c *runConfig) error {
+		c.projectClient = client
+		return nil
+	}
+}
+
 // WithFileModeGit will configure supporting repository clients to download files
 // using git. This is useful for repositories which "export-ignore" files in its
 // .gitattributes file.
@@ -358,6 +497,92 @@ func WithFileModeGit() Option {
 	}
 }
 
+// WithLicenseDeepScan configures the License check to scan the source files
+// for SPDX-License-Identifier headers and REUSE metadata.
+//
+// Repository analysis may be slower.
+func WithLicenseDeepScan() Option {
+	return func(c *runConfig) error {
+		c.licenseDeepScan = true
+		return nil
+	}
+}
+
+// WithSecretsHistoryScan configures the hasLeakedSecrets probe to scan the
+// lines added by the recent commits of the repository. Unless the repo client
+// is a git client, the repository is cloned with git for the scan, if the
+// probe runs.
+//
+// Repository analysis may be slower.
+func WithSecretsHistoryScan() Option {
+	return func(c *runConfig) error {
+		c.secretsHistory = true
+		return nil
+	}
+}
+
+// WithMaintainedLookBackDays configures the number of days of activity
+// considered by the Maintained check. Zero means the default window of
+// [checker.DefaultMaintainedLookBackDays] days.
+func WithMaintainedLookBackDays(days int) Option {
+	return func(c *runConfig) error {
+		if days < 0 {
+			return fmt.Errorf("%w: %d days", errInvalidLookBack, days)
+		}
+		c.lookBackDays = days
+		return nil
+	}
+}
+
+// WithScoring configures custom checks to evaluate from the probe findings,
+// alongside the built-in checks. Probes of custom checks whose findings aren't
+// produced by the checks being run are run separately.
+func WithScoring(config *scoring.Config) Option {
+	return func(c *runConfig) error {
+		c.scoring = config
+		return nil
+	}
+}
+
+// WithCheckTimeout configures the maximum run time of each check. A check
+// which doesn't complete in time is reported with an [sce.ErrCheckTimeout]
+// error. A zero timeout, the default, means no limit.
+func WithCheckTimeout(timeout time.Duration) Option {
+	return func(c *runConfig) error {
+		if timeout < 0 {
+			return fmt.Errorf("%w: %s", errInvalidTimeout, timeout)
+		}
+		c.limits.timeout = timeout
+		return nil
+	}
+}
+
+// WithCheckTimeouts configures the maximum run time of individual checks,
+// overriding the timeout set with [WithCheckTimeout].
+func WithCheckTimeouts(timeouts map[string]time.Duration) Option {
+	return func(c *runConfig) error {
+		for check, timeout := range timeouts {
+			if timeout < 0 {
+				return fmt.Errorf("%w: %s: %s", errInvalidTimeout, check, timeout)
+			}
+		}
+		c.limits.timeouts = timeouts
+		return nil
+	}
+}
+
+// WithMaxParallelChecks configures the maximum number of checks run
+// concurrently. Zero, the default, means no limit.
+func WithMaxParallelChecks(n int) Option {
+	return func(c *runConfig) error {
+		if n < 0 {
+			return fmt.Errorf("%w: %d", errInvalidParallelism, n)
+		}
+		c.limits.maxParallel = n
+		return nil
+	}
+}
+
 // Run analyzes a given repository and returns the result. You can modify the
 // run behavior by passing in [Option] arguments. In the absence of a particular
 // option a default is used. Refer to the various Options for details.
@@ -371,6 +596,9 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 			return Result{}, err
 		}
 	}
+	ctx, span := stats.Tracer().Start(ctx, "scorecard.Run")
+	defer span.End()
+
 	logger := sclog.NewLogger(c.logLevel)
 	if c.ciiClient == nil {
 		c.ciiClient = clients.DefaultCIIBestPracticesClient()
@@ -430,6 +658,5 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 		return Result{}, fmt.Errorf("getting enabled checks: %w", err)
 	}
 
-	return runScorecard(ctx, repo, c.commit, c.commitDepth, checksToRun, c.probes,
-		c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient)
+	return runScorecard(ctx, repo, checksToRun, &c)
 }
@@ -26,8 +26,11 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks"
 	"github.com/ossf/scorecard/v5/checks/raw"
+	"github.com/ossf/scorecard/v5/checks/raw/azuredevops"
 	"github.com/ossf/scorecard/v5/checks/raw/github"
 	"github.com/ossf/scorecard/v5/checks/raw/gitlab"
+	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/bundle"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
 	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
 	"github.com/ossf/scorecard/v5/config"
@@ -100,6 +103,10 @@ func (r *Result) GetAggregateScore(checkDocs docChecks.Doc) (float64, error) {
 			return checker.InconclusiveResultScore,
 				sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("Invalid risk for %s: '%s'", check.Name, risk))
 		}
+		// The weight may be configured explicitly.
+		if wd, ok := doc.(docChecks.WeightedCheckDoc); ok {
+			rs = wd.GetWeight()
+		}
 
 		// This indicates an inconclusive score.
 		if check.Score < checker.MinResultScore {
@@ -165,6 +172,27 @@ func FormatResults(
 			},
 		}
 		err = results.AsInToto(output, doc, o)
+	case options.FormatHTML:
+		o := &AsHTMLResultOption{
+			Details:     opts.ShowDetails,
+			Annotations: opts.ShowAnnotations,
+			LogLevel:    log.ParseLevel(opts.LogLevel),
+		}
+		err = results.AsHTML(output, doc, o)
+	case options.FormatJUnit:
+		o := &AsJUnitResultOption{
+			Policy:   policy,
+			Details:  opts.ShowDetails,
+			LogLevel: log.ParseLevel(opts.LogLevel),
+		}
+		err = results.AsJUnit(output, doc, o)
+	case options.FormatMarkdown:
+		o := &AsMarkdownResultOption{
+			Details:     opts.ShowDetails,
+			Annotations: opts.ShowAnnotations,
+			LogLevel:    log.ParseLevel(opts.LogLevel),
+		}
+		err = results.AsMarkdown(output, doc, o)
 	case options.FormatProbe:
 		var opts *ProbeResultOption
 		err = results.AsProbe(output, opts)
@@ -285,7 +313,7 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Re
 		}
 		ret.RawResults.CIIBestPracticesResults = rawData
 	case checks.CheckCITests:
-		rawData, err := raw.CITests(request.RepoClient)
+		rawData, err := raw.CITests(request)
 		if err != nil {
 			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		}
@@ -333,7 +361,7 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Re
 		}
 		ret.RawResults.MaintainedResults = rawData
 	case checks.CheckPackaging:
-		switch request.RepoClient.(type) {
+		switch v := request.RepoClient.(type) {
 		case *githubrepo.Client:
 			rawData, err := github.Packaging(request)
 			if err != nil {
@@ -346,8 +374,31 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Re
 				return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 			}
 			ret.RawResults.PackagingResults = rawData
+		case *azuredevopsrepo.Client:
+			rawData, err := azuredevops.Packaging(request)
+			if err != nil {
+				return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+			}
+			ret.RawResults.PackagingResults = rawData
+		case *bundle.Client:
+			var rawData checker.PackagingData
+			var err error
+			switch v.Forge() {
+			case bundle.ForgeGitHub:
+				rawData, err = github.Packaging(request)
+			case bundle.ForgeGitLab:
+				rawData, err = gitlab.Packaging(request)
+			case bundle.ForgeAzureDevOps:
+				rawData, err = azuredevops.Packaging(request)
+			default:
+				return sce.WithMessage(sce.ErrScorecardInternal, "Only github, gitlab and azure devops are supported")
+			}
+			if err != nil {
+				return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+			}
+			ret.RawResults.PackagingResults = rawData
 		default:
-			return sce.WithMessage(sce.ErrScorecardInternal, "Only github and gitlab are supported")
+			return sce.WithMessage(sce.ErrScorecardInternal, "Only github, gitlab and azure devops are supported")
 		}
 	case checks.CheckPinnedDependencies:
 		rawData, err := raw.PinningDependencies(request)
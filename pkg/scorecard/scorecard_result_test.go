@@ -29,6 +29,7 @@ import (
 	"github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/options"
 	spol "github.com/ossf/scorecard/v5/policy"
+	"github.com/ossf/scorecard/v5/scoring"
 )
 
 func mockScorecardResultCheck1(t *testing.T) *Result {
@@ -204,3 +205,52 @@ func Test_formatResults_outputToFile(t *testing.T) {
 		})
 	}
 }
+
+func TestResult_GetAggregateScore(t *testing.T) {
+	t.Parallel()
+	checkDocs, err := checks.Read()
+	if err != nil {
+		t.Fatalf("checks.Read: %v", err)
+	}
+	result := &Result{
+		Checks: []checker.CheckResult{
+			{Name: "Maintained", Score: 10},
+			{Name: "Fuzzing", Score: 0},
+			{Name: "Release-Integrity", Score: 4},
+		},
+	}
+	custom := map[string]scoring.Check{"Release-Integrity": {Risk: "Low"}}
+	tests := []struct {
+		config *scoring.Config
+		name   string
+		want   float64
+	}{
+		{
+			name:   "risk weights",
+			config: &scoring.Config{Checks: custom},
+			// (7.5*10 + 5*0 + 2.5*4) / 15
+			want: 85.0 / 15,
+		},
+		{
+			name: "configured weights",
+			config: &scoring.Config{
+				Checks:  custom,
+				Weights: map[string]float64{"Fuzzing": 0, "Release-Integrity": 7.5},
+			},
+			// (7.5*10 + 7.5*4) / 15
+			want: 7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := result.GetAggregateScore(tt.config.Doc(checkDocs))
+			if err != nil {
+				t.Fatalf("GetAggregateScore: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("GetAggregateScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
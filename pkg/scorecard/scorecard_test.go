@@ -17,10 +17,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"slices"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -33,6 +40,8 @@ import (
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/probes/fuzzed"
+	"github.com/ossf/scorecard/v5/probes/hasLeakedSecrets"
+	"github.com/ossf/scorecard/v5/scoring"
 )
 
 func Test_getRepoCommitHash(t *testing.T) {
@@ -200,6 +209,102 @@ func TestRun(t *testing.T) {
 	}
 }
 
+func Test_runEnabledChecks_limits(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	repo := mockrepo.NewMockRepo(ctrl)
+	repo.EXPECT().URI().Return("github.com/foo/bar").AnyTimes()
+	repo.EXPECT().Host().Return("github.com").AnyTimes()
+
+	var running, maxRunning atomic.Int32
+	track := func(fn checker.CheckFn) checker.CheckFn {
+		return func(req *checker.CheckRequest) checker.CheckResult {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			return fn(req)
+		}
+	}
+	checks := checker.CheckNameToFnMap{
+		"Fast": {Fn: track(func(*checker.CheckRequest) checker.CheckResult {
+			time.Sleep(10 * time.Millisecond)
+			return checker.CreateMaxScoreResult("Fast", "ok")
+		})},
+		"Other": {Fn: track(func(*checker.CheckRequest) checker.CheckResult {
+			time.Sleep(10 * time.Millisecond)
+			return checker.CreateMaxScoreResult("Other", "ok")
+		})},
+		// Not tracked: a timed out check releases its slot before it returns.
+		"Slow": {Fn: func(req *checker.CheckRequest) checker.CheckResult {
+			<-req.Ctx.Done()
+			return checker.CreateRuntimeErrorResult("Slow", req.Ctx.Err())
+		}},
+	}
+	limits := checkLimits{
+		timeout:     time.Minute,
+		timeouts:    map[string]time.Duration{"Slow": 10 * time.Millisecond},
+		maxParallel: 1,
+	}
+
+	resultsCh := make(chan checker.CheckResult)
+	go runEnabledChecks(context.Background(), repo, &checker.CheckRequest{Repo: repo}, checks, limits, resultsCh)
+	results := map[string]checker.CheckResult{}
+	for res := range resultsCh {
+		results[res.Name] = res
+	}
+
+	if got := maxRunning.Load(); got != 1 {
+		t.Errorf("ran %d checks concurrently, want 1", got)
+	}
+	for name, res := range results {
+		if got, want := res.TimedOut(), name == "Slow"; got != want {
+			t.Errorf("%s: TimedOut() = %v, want %v", name, got, want)
+		}
+	}
+	if len(results) != len(checks) {
+		t.Errorf("got %d results, want %d", len(results), len(checks))
+	}
+}
+
+func Test_runCustomChecks(t *testing.T) {
+	t.Parallel()
+	ret := &Result{
+		Checks: []checker.CheckResult{{Name: "Signed-Releases", Score: 5}},
+		Findings: []finding.Finding{
+			{Probe: "releasesAreSigned", Outcome: finding.OutcomeTrue},
+			{Probe: "releasesHaveProvenance", Outcome: finding.OutcomeFalse},
+		},
+	}
+	scoringConfig := &scoring.Config{
+		Checks: map[string]scoring.Check{
+			"Release-Integrity": {
+				Risk: "High",
+				Probes: []scoring.Probe{
+					{Name: "releasesAreSigned", Weight: 3, Pass: true},
+					{Name: "releasesHaveProvenance", Weight: 1, Pass: true},
+				},
+			},
+		},
+	}
+	runCustomChecks(&checker.CheckRequest{}, scoringConfig, ret)
+
+	if len(ret.Checks) != 2 {
+		t.Fatalf("got %d checks, want 2", len(ret.Checks))
+	}
+	got := ret.Checks[1]
+	if got.Name != "Release-Integrity" || got.Score != 7 || got.Error != nil {
+		t.Errorf("unexpected custom check result: %+v", got)
+	}
+	if len(got.Findings) != 2 || len(got.Details) != 2 {
+		t.Errorf("got %d findings and %d details, want 2 of each", len(got.Findings), len(got.Details))
+	}
+}
+
 func TestRun_WithProbes(t *testing.T) {
 	t.Parallel()
 	// These values depend on the environment,
@@ -300,6 +405,9 @@ func TestRun_WithProbes(t *testing.T) {
 				}, nil
 			})
 			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil).AnyTimes()
+			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(filename string) (io.ReadCloser, error) {
+				return nil, fmt.Errorf("os.Open: %s", filename)
+			}).AnyTimes()
 			progLanguages := []clients.Language{
 				{
 					Name:     clients.Go,
@@ -344,6 +452,124 @@ func TestRun_WithProbes(t *testing.T) {
 	}
 }
 
+func TestRun_WithSecretsHistoryScan(t *testing.T) {
+	t.Parallel()
+	// A fake GitLab token, split so it isn't flagged in this file.
+	const token = "gl" + "pat-" + "q7Lw2Rk9Zt4Xm8Pb3Nc6"
+	dir := t.TempDir()
+	r, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("PlainInit: %v", err)
+	}
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatalf("Worktree: %v", err)
+	}
+	commit := func(content string) plumbing.Hash {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, "ci.sh"), []byte(content), 0o600); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		if _, err := w.Add("ci.sh"); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+		sig := &object.Signature{Name: "dev", Email: "dev@example.com", When: time.Now()}
+		hash, err := w.Commit("update ci.sh", &git.CommitOptions{Author: sig, Committer: sig})
+		if err != nil {
+			t.Fatalf("Commit: %v", err)
+		}
+		return hash
+	}
+	leaked := commit("GITLAB_TOKEN=" + token + "\n")
+	commit("echo $GITLAB_TOKEN\n")
+
+	tests := []struct {
+		name     string
+		options  []Option
+		outcome  finding.Outcome
+		messages []string
+	}{
+		{
+			name:     "the history is not scanned by default",
+			outcome:  finding.OutcomeFalse,
+			messages: []string{"no secrets found in the repository"},
+		},
+		{
+			name:     "secrets removed from the tree are found in the history",
+			options:  []Option{WithSecretsHistoryScan()},
+			outcome:  finding.OutcomeTrue,
+			messages: []string{"possible GitLab personal access token added in commit " + leaked.String()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo, err := localdir.MakeLocalDirRepo(dir)
+			if err != nil {
+				t.Fatalf("MakeLocalDirRepo: %v", err)
+			}
+			opts := append([]Option{
+				WithRepoClient(localdir.CreateLocalDirClient(context.Background(), log.NewLogger(log.DefaultLevel))),
+				WithProbes([]string{hasLeakedSecrets.Probe}),
+			}, tt.options...)
+			got, err := Run(context.Background(), repo, opts...)
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			var messages []string
+			for i := range got.Findings {
+				if got.Findings[i].Outcome != tt.outcome {
+					t.Errorf("finding %q outcome = %v, want %v", got.Findings[i].Message, got.Findings[i].Outcome, tt.outcome)
+				}
+				messages = append(messages, got.Findings[i].Message)
+			}
+			if diff := cmp.Diff(tt.messages, messages); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_runConfig_runsProbe(t *testing.T) {
+	t.Parallel()
+	customCheck := &scoring.Config{
+		Checks: map[string]scoring.Check{
+			"Secrets": {Probes: []scoring.Probe{{Name: hasLeakedSecrets.Probe, Weight: 1, Pass: false}}},
+		},
+	}
+	tests := []struct {
+		name   string
+		config runConfig
+		want   bool
+	}{
+		{
+			name: "checks only",
+		},
+		{
+			name:   "selected probe",
+			config: runConfig{probes: []string{hasLeakedSecrets.Probe}},
+			want:   true,
+		},
+		{
+			name:   "other probes",
+			config: runConfig{probes: []string{"fuzzed"}, scoring: customCheck},
+		},
+		{
+			name:   "probe of a custom check",
+			config: runConfig{scoring: customCheck},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.config.runsProbe(hasLeakedSecrets.Probe); got != tt.want {
+				t.Errorf("runsProbe() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_findConfigFile(t *testing.T) {
 	t.Parallel()
 
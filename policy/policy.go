@@ -20,6 +20,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -34,6 +35,7 @@ var (
 	errInvalidScore   = errors.New("invalid score")
 	errInvalidMode    = errors.New("invalid mode")
 	errRepeatingCheck = errors.New("check has multiple definitions")
+	errInvalidTimeout = errors.New("invalid timeout")
 )
 
 var allowedVersions = map[int]bool{1: true}
@@ -41,8 +43,9 @@ var allowedVersions = map[int]bool{1: true}
 var modes = map[string]bool{"enforced": true, "disabled": true}
 
 type checkPolicy struct {
-	Mode  string `yaml:"mode"`
-	Score int    `yaml:"score"`
+	Mode    string `yaml:"mode"`
+	Timeout string `yaml:"timeout"`
+	Score   int    `yaml:"score"`
 }
 
 type scorecardPolicy struct {
@@ -122,6 +125,15 @@ func parseFromYAML(b []byte) (*ScorecardPolicy, error) {
 			return &retPolicy, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("%v: %v", errInvalidScore.Error(), p.Score))
 		}
 
+		var timeout time.Duration
+		if p.Timeout != "" {
+			timeout, err = time.ParseDuration(p.Timeout)
+			if err != nil || timeout < time.Second {
+				return &retPolicy, sce.WithMessage(sce.ErrScorecardInternal,
+					fmt.Sprintf("%v: %v", errInvalidTimeout.Error(), p.Timeout))
+			}
+		}
+
 		_, exists = checksFound[n]
 		if exists {
 			return &retPolicy, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("%v: %v", errRepeatingCheck.Error(), n))
@@ -130,14 +142,26 @@ func parseFromYAML(b []byte) (*ScorecardPolicy, error) {
 
 		// Add an entry to the policy.
 		retPolicy.Policies[n] = &CheckPolicy{
-			Score: int32(p.Score),
-			Mode:  modeToProto(p.Mode),
+			Score:          int32(p.Score),
+			Mode:           modeToProto(p.Mode),
+			TimeoutSeconds: int64(timeout / time.Second),
 		}
 	}
 
 	return &retPolicy, nil
 }
 
+// GetTimeouts returns the timeouts of the checks which have one.
+func GetTimeouts(sp *ScorecardPolicy) map[string]time.Duration {
+	timeouts := map[string]time.Duration{}
+	for name, p := range sp.GetPolicies() {
+		if p.GetTimeoutSeconds() > 0 {
+			timeouts[name] = time.Duration(p.GetTimeoutSeconds()) * time.Second
+		}
+	}
+	return timeouts
+}
+
 // GetEnabled returns the list of enabled checks.
 func GetEnabled(
 	sp *ScorecardPolicy,
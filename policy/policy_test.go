@@ -18,6 +18,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -80,6 +81,30 @@ func TestPolicyRead(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "timeout",
+			filename: "./testdata/policy-timeout.yaml",
+			err:      nil,
+			result: ScorecardPolicy{
+				Version: 1,
+				Policies: map[string]*CheckPolicy{
+					"Branch-Protection": {
+						Score: 5,
+						Mode:  CheckPolicy_ENFORCED,
+					},
+					"Vulnerabilities": {
+						Score:          1,
+						Mode:           CheckPolicy_ENFORCED,
+						TimeoutSeconds: 600,
+					},
+				},
+			},
+		},
+		{
+			name:     "invalid timeout",
+			filename: "./testdata/policy-invalid-timeout.yaml",
+			err:      sce.ErrScorecardInternal,
+		},
 		{
 			name:     "invalid score - 0",
 			filename: "./testdata/policy-invalid-score-0.yaml",
@@ -136,6 +161,23 @@ func TestPolicyRead(t *testing.T) {
 	}
 }
 
+func TestGetTimeouts(t *testing.T) {
+	t.Parallel()
+	sp := &ScorecardPolicy{
+		Policies: map[string]*CheckPolicy{
+			"Branch-Protection": {Score: 5},
+			"Vulnerabilities":   {Score: 1, TimeoutSeconds: 600},
+		},
+	}
+	want := map[string]time.Duration{"Vulnerabilities": 10 * time.Minute}
+	if diff := cmp.Diff(want, GetTimeouts(sp)); diff != "" {
+		t.Errorf("GetTimeouts() mismatch (-want +got):\n%s", diff)
+	}
+	if got := GetTimeouts(nil); len(got) != 0 {
+		t.Errorf("GetTimeouts(nil) = %v, want no timeouts", got)
+	}
+}
+
 func TestChecksHavePolicies(t *testing.T) {
 	t.Parallel()
 	// Create a sample ScorecardPolicy
@@ -333,7 +375,7 @@ func TestGetEnabled(t *testing.T) {
 			name:                  "request types limit enabled checks",
 			argsChecks:            []string{},
 			requiredRequestTypes:  []checker.RequestType{checker.FileBased, checker.CommitBased},
-			expectedEnabledChecks: 7, // All checks which are FileBased and CommitBased
+			expectedEnabledChecks: 8, // All checks which are FileBased and CommitBased
 			expectedError:         false,
 		},
 		{
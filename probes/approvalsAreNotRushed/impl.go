@@ -0,0 +1,115 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package approvalsAreNotRushed
+
+import (
+	"embed"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/review"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.CodeReview})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe                  = "approvalsAreNotRushed"
+	TimeToApproveKey       = "timeToApprove"
+	MedianTimeToApproveKey = "medianTimeToApprove"
+
+	// minChangesets is the number of approved changesets needed to tell
+	// outliers apart.
+	minChangesets = 5
+	// outlierRatio is how many times faster than the median an approval
+	// must be to be an outlier.
+	outlierRatio = 10
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var durations []time.Duration
+	changesets := raw.CodeReviewResults.DefaultBranchChangesets
+	for i := range changesets {
+		if changesets[i].ReviewPlatform != checker.ReviewPlatformGitHub {
+			continue
+		}
+		if d, ok := timeToApprove(&changesets[i]); ok {
+			durations = append(durations, d)
+		}
+	}
+	if len(durations) < minChangesets {
+		// Not enough data to tell what is typical for the project.
+		return review.Run(raw, fs, Probe, func(*checker.Changeset) (review.Verdict, bool) {
+			return review.Verdict{}, false
+		})
+	}
+	slices.Sort(durations)
+	median := durations[len(durations)/2]
+
+	return review.Run(raw, fs, Probe, func(c *checker.Changeset) (review.Verdict, bool) {
+		d, ok := timeToApprove(c)
+		if !ok {
+			return review.Verdict{}, false
+		}
+		v := review.Verdict{
+			Outcome: finding.OutcomeTrue,
+			Text:    fmt.Sprintf("changeset %s approved after %s", c.RevisionID, d),
+			Values: map[string]string{
+				TimeToApproveKey:       strconv.Itoa(int(d.Seconds())),
+				MedianTimeToApproveKey: strconv.Itoa(int(median.Seconds())),
+			},
+		}
+		if d*outlierRatio < median {
+			v.Outcome = finding.OutcomeFalse
+			v.Text = fmt.Sprintf("changeset %s approved after %s, much faster than the median of %s",
+				c.RevisionID, d, median)
+		}
+		return v, true
+	})
+}
+
+// timeToApprove returns the time between the opening of the changeset and
+// its first approval.
+func timeToApprove(c *checker.Changeset) (time.Duration, bool) {
+	if c.CreatedAt.IsZero() {
+		return 0, false
+	}
+	var first time.Time
+	for _, a := range review.Approvals(c) {
+		if first.IsZero() || a.SubmittedAt.Before(first) {
+			first = a.SubmittedAt
+		}
+	}
+	if first.IsZero() {
+		return 0, false
+	}
+	return max(first.Sub(c.CreatedAt), 0), true
+}
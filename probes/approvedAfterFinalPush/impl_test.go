@@ -0,0 +1,116 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package approvedAfterFinalPush
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/review"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	submitted := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	approval := func(sha string) clients.Review {
+		return clients.Review{
+			Author:      &clients.User{Login: "bob"},
+			State:       "APPROVED",
+			SubmittedAt: submitted,
+			CommitSHA:   sha,
+		}
+	}
+	changeset := func(id, head string, reviews ...clients.Review) checker.Changeset {
+		return checker.Changeset{
+			ReviewPlatform: checker.ReviewPlatformGitHub,
+			RevisionID:     id,
+			Author:         clients.User{Login: "alice"},
+			HeadSHA:        head,
+			Reviews:        reviews,
+		}
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		ids      []string
+		err      error
+	}{
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no approvals",
+			raw: &checker.RawResults{
+				CodeReviewResults: checker.CodeReviewData{
+					DefaultBranchChangesets: []checker.Changeset{changeset("1", "head")},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "approvals before and after the final push",
+			raw: &checker.RawResults{
+				CodeReviewResults: checker.CodeReviewData{
+					DefaultBranchChangesets: []checker.Changeset{
+						changeset("1", "head", approval("old"), approval("head")),
+						changeset("2", "head", approval("old")),
+						// The reviewed commit is unknown.
+						changeset("3", "head", approval("")),
+						// The final commit is unknown.
+						changeset("4", "", approval("old")),
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeFalse,
+			},
+			ids: []string{"1", "2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := Run(tt.raw)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Run() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if s != Probe {
+				t.Errorf("Run() probe = %q, want %q", s, Probe)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			for i, id := range tt.ids {
+				if got := findings[i].Values[review.RevisionIDKey]; got != id {
+					t.Errorf("finding %d revision ID = %q, want %q", i, got, id)
+				}
+			}
+		})
+	}
+}
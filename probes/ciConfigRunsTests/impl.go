@@ -0,0 +1,84 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package ciConfigRunsTests
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.CITests})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe       = "ciConfigRunsTests"
+	CISystemKey = "ciSystem"
+	JobKey      = "job"
+	CommandKey  = "command"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	c := raw.CITestResults
+	if len(c.ConfigFiles) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no CI configuration file found", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	if len(c.TestCommands) == 0 {
+		f, err := finding.NewFalse(fs, Probe, "CI configuration files don't run tests", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range c.TestCommands {
+		cmd := &c.TestCommands[i]
+		msg := fmt.Sprintf("%s runs tests with '%s'", cmd.CISystem, cmd.Command)
+		if cmd.Job != "" {
+			msg = fmt.Sprintf("%s job '%s' runs tests with '%s'", cmd.CISystem, cmd.Job, cmd.Command)
+		}
+		f, err := finding.NewTrue(fs, Probe, msg, cmd.File.Location())
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(CISystemKey, cmd.CISystem).
+			WithValue(CommandKey, cmd.Command)
+		if cmd.Job != "" {
+			f = f.WithValue(JobKey, cmd.Job)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
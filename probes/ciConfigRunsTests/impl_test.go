@@ -0,0 +1,124 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package ciConfigRunsTests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	workflow := checker.File{Path: ".github/workflows/ci.yml", Offset: 12}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		values   map[string]string
+		err      error
+	}{
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no CI configuration",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "CI configuration without tests",
+			raw: &checker.RawResults{
+				CITestResults: checker.CITestData{
+					ConfigFiles: []checker.File{workflow},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+			},
+		},
+		{
+			name: "workflow job running tests",
+			raw: &checker.RawResults{
+				CITestResults: checker.CITestData{
+					ConfigFiles: []checker.File{workflow},
+					TestCommands: []checker.CITestCommand{
+						{CISystem: "GitHub Actions", Job: "unit", Command: "go test", File: workflow},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+			values: map[string]string{
+				CISystemKey: "GitHub Actions",
+				JobKey:      "unit",
+				CommandKey:  "go test",
+			},
+		},
+		{
+			name: "several commands running tests",
+			raw: &checker.RawResults{
+				CITestResults: checker.CITestData{
+					ConfigFiles: []checker.File{{Path: "Jenkinsfile"}, {Path: ".travis.yml"}},
+					TestCommands: []checker.CITestCommand{
+						{CISystem: "Jenkins", Command: "pytest", File: checker.File{Path: "Jenkinsfile"}},
+						{CISystem: "Travis CI", Command: "npm test", File: checker.File{Path: ".travis.yml"}},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeTrue,
+			},
+			values: map[string]string{
+				CISystemKey: "Jenkins",
+				CommandKey:  "pytest",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := Run(tt.raw)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Run() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if s != Probe {
+				t.Errorf("Run() probe = %q, want %q", s, Probe)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			if tt.values != nil {
+				if diff := cmp.Diff(tt.values, findings[0].Values); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
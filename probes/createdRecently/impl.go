@@ -39,7 +39,6 @@ const (
 	Probe = "createdRecently"
 
 	LookbackDayKey = "lookBackDays"
-	lookBackDays   = 90
 )
 
 func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
@@ -48,6 +47,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	}
 
 	r := raw.MaintainedResults
+	lookBackDays := r.GetLookBackDays()
 
 	recencyThreshold := time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
 
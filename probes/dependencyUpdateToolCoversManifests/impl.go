@@ -0,0 +1,164 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package dependencyUpdateToolCoversManifests
+
+import (
+	"embed"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gobwas/glob"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.DependencyUpdateTool})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe        = "dependencyUpdateToolCoversManifests"
+	EcosystemKey = "ecosystem"
+	DirectoryKey = "directory"
+	ToolsKey     = "tools"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.DependencyUpdateToolResults
+
+	if len(r.Configs) == 0 || len(r.Manifests) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no dependency update tool configuration or dependency manifest found", nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	// Manifests of the same ecosystem in the same directory are updated together.
+	type key struct{ ecosystem, directory string }
+	var keys []key
+	groups := make(map[key][]*checker.DependencyManifest)
+	for i := range r.Manifests {
+		m := &r.Manifests[i]
+		k := key{m.Ecosystem, m.Directory}
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], m)
+	}
+
+	findings := make([]finding.Finding, 0, len(keys))
+	for _, k := range keys {
+		var tools []string
+		for i := range r.Configs {
+			c := &r.Configs[i]
+			if contains(tools, c.Tool) {
+				continue
+			}
+			for _, m := range groups[k] {
+				if covers(c, m) {
+					tools = append(tools, c.Tool)
+					break
+				}
+			}
+		}
+
+		loc := groups[k][0].File.Location()
+		var f *finding.Finding
+		var err error
+		if len(tools) == 0 {
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("%s dependencies in %s are not updated by a dependency update tool", k.ecosystem, k.directory),
+				loc, finding.OutcomeFalse)
+		} else {
+			sort.Strings(tools)
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("%s dependencies in %s are updated by: %s", k.ecosystem, k.directory, strings.Join(tools, ", ")),
+				loc, finding.OutcomeTrue)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(EcosystemKey, k.ecosystem)
+		f = f.WithValue(DirectoryKey, k.directory)
+		if len(tools) > 0 {
+			f = f.WithValue(ToolsKey, strings.Join(tools, ","))
+		}
+		f = f.WithRemediationMetadata(map[string]string{
+			EcosystemKey: k.ecosystem,
+			DirectoryKey: k.directory,
+		})
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+// covers returns true if a configuration updates the dependencies of a manifest.
+func covers(c *checker.DependencyUpdateConfig, m *checker.DependencyManifest) bool {
+	if c.Disabled {
+		return false
+	}
+	for _, p := range c.IgnorePaths {
+		if strings.Contains(m.File.Path, p) || matchPath(p, m.File.Path) || matchPath(p, "/"+m.File.Path) {
+			return false
+		}
+	}
+	for i := range c.Updates {
+		u := &c.Updates[i]
+		if u.Disabled || (u.Ecosystem != "" && u.Ecosystem != m.Ecosystem) {
+			continue
+		}
+		if len(u.Directories) == 0 {
+			return true
+		}
+		for _, dir := range u.Directories {
+			if matchPath(dir, m.Directory) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchPath(pattern, name string) bool {
+	g, err := glob.Compile(pattern, '/')
+	if err != nil {
+		return false
+	}
+	return g.Match(name)
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package dependencyUpdateToolCoversManifests
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func manifest(ecosystem, dir, path string) checker.DependencyManifest {
+	return checker.DependencyManifest{
+		Ecosystem: ecosystem,
+		Directory: dir,
+		File:      checker.File{Path: path, Type: finding.FileTypeSource},
+	}
+}
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		err      error
+		outcomes []finding.Outcome
+		values   []map[string]string
+	}{
+		{
+			name: "nil raw results",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no configuration",
+			raw: &checker.RawResults{
+				DependencyUpdateToolResults: checker.DependencyUpdateToolData{
+					Manifests: []checker.DependencyManifest{manifest("gomod", "/", "go.mod")},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+			values:   []map[string]string{nil},
+		},
+		{
+			name: "no manifest",
+			raw: &checker.RawResults{
+				DependencyUpdateToolResults: checker.DependencyUpdateToolData{
+					Configs: []checker.DependencyUpdateConfig{{Tool: "Mend", Updates: []checker.DependencyUpdate{{}}}},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+			values:   []map[string]string{nil},
+		},
+		{
+			name: "dependabot directories",
+			raw: &checker.RawResults{
+				DependencyUpdateToolResults: checker.DependencyUpdateToolData{
+					Configs: []checker.DependencyUpdateConfig{
+						{
+							Tool: "Dependabot",
+							Updates: []checker.DependencyUpdate{
+								{Ecosystem: "gomod", Directories: []string{"/"}},
+								{Ecosystem: "npm", Directories: []string{"/web/**"}},
+								{Ecosystem: "docker", Directories: []string{"/"}, Disabled: true},
+							},
+						},
+					},
+					Manifests: []checker.DependencyManifest{
+						manifest("gomod", "/", "go.mod"),
+						manifest("gomod", "/tools", "tools/go.mod"),
+						manifest("npm", "/web/app", "web/app/package.json"),
+						manifest("docker", "/", "Dockerfile"),
+						manifest("docker", "/", "Dockerfile.dev"),
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue, finding.OutcomeFalse, finding.OutcomeTrue, finding.OutcomeFalse,
+			},
+			values: []map[string]string{
+				{EcosystemKey: "gomod", DirectoryKey: "/", ToolsKey: "Dependabot"},
+				{EcosystemKey: "gomod", DirectoryKey: "/tools"},
+				{EcosystemKey: "npm", DirectoryKey: "/web/app", ToolsKey: "Dependabot"},
+				{EcosystemKey: "docker", DirectoryKey: "/"},
+			},
+		},
+		{
+			name: "renovate managers and ignored paths",
+			raw: &checker.RawResults{
+				DependencyUpdateToolResults: checker.DependencyUpdateToolData{
+					Configs: []checker.DependencyUpdateConfig{
+						{
+							Tool:        "RenovateBot",
+							Updates:     []checker.DependencyUpdate{{Ecosystem: "npm"}, {Ecosystem: "pip"}},
+							IgnorePaths: []string{"**/examples/**", "docs/"},
+						},
+						{Tool: "PyUp", Updates: []checker.DependencyUpdate{{Ecosystem: "pip"}}},
+					},
+					Manifests: []checker.DependencyManifest{
+						manifest("npm", "/", "package.json"),
+						manifest("npm", "/examples/demo", "examples/demo/package.json"),
+						manifest("npm", "/docs", "docs/package.json"),
+						manifest("pip", "/", "requirements.txt"),
+						manifest("gomod", "/", "go.mod"),
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue, finding.OutcomeFalse, finding.OutcomeFalse, finding.OutcomeTrue, finding.OutcomeFalse,
+			},
+			values: []map[string]string{
+				{EcosystemKey: "npm", DirectoryKey: "/", ToolsKey: "RenovateBot"},
+				{EcosystemKey: "npm", DirectoryKey: "/examples/demo"},
+				{EcosystemKey: "npm", DirectoryKey: "/docs"},
+				{EcosystemKey: "pip", DirectoryKey: "/", ToolsKey: "PyUp,RenovateBot"},
+				{EcosystemKey: "gomod", DirectoryKey: "/"},
+			},
+		},
+		{
+			name: "disabled configuration",
+			raw: &checker.RawResults{
+				DependencyUpdateToolResults: checker.DependencyUpdateToolData{
+					Configs: []checker.DependencyUpdateConfig{
+						{Tool: "RenovateBot", Updates: []checker.DependencyUpdate{{}}, Disabled: true},
+					},
+					Manifests: []checker.DependencyManifest{manifest("cargo", "/", "Cargo.toml")},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeFalse},
+			values:   []map[string]string{{EcosystemKey: "cargo", DirectoryKey: "/"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			for i := range findings {
+				if diff := cmp.Diff(tt.values[i], findings[i].Values); diff != "" {
+					t.Errorf("finding %d values mismatch (-want +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_Run_remediation(t *testing.T) {
+	t.Parallel()
+	raw := &checker.RawResults{
+		DependencyUpdateToolResults: checker.DependencyUpdateToolData{
+			Configs: []checker.DependencyUpdateConfig{
+				{Tool: "Dependabot", Updates: []checker.DependencyUpdate{{Ecosystem: "github-actions"}}},
+			},
+			Manifests: []checker.DependencyManifest{manifest("gomod", "/cmd", "cmd/go.mod")},
+		},
+	}
+	findings, _, err := Run(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Remediation == nil {
+		t.Fatalf("expected one finding with a remediation, got %v", findings)
+	}
+	want := "Configure your dependency update tool to update the gomod dependencies in /cmd, " +
+		"e.g. add an entry to the `updates` of your Dependabot configuration."
+	if findings[0].Remediation.Text != want {
+		t.Errorf("remediation: got %q, want %q", findings[0].Remediation.Text, want)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package dependencyUpdateToolEnabled
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.DependencyUpdateTool})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe        = "dependencyUpdateToolEnabled"
+	ToolKey      = "tool"
+	FileKey      = "file"
+	EcosystemKey = "ecosystem"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	configs := raw.DependencyUpdateToolResults.Configs
+	if len(configs) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no dependency update tool configuration found", nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range configs {
+		c := &configs[i]
+		metadata := map[string]string{ToolKey: c.Tool, FileKey: c.File.Path}
+
+		outcome, msg := finding.OutcomeTrue, fmt.Sprintf("%s configuration enables updates", c.Tool)
+		if c.Disabled {
+			outcome, msg = finding.OutcomeFalse, fmt.Sprintf("%s configuration disables updates", c.Tool)
+		}
+		f, err := finding.NewWith(fs, Probe, msg, c.File.Location(), outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(ToolKey, c.Tool)
+		f = f.WithRemediationMetadata(metadata)
+		findings = append(findings, *f)
+
+		if c.Disabled {
+			continue
+		}
+		for j := range c.Updates {
+			u := &c.Updates[j]
+			if !u.Disabled {
+				continue
+			}
+			file := c.File
+			file.Offset = u.Offset
+			f, err := finding.NewWith(fs, Probe,
+				fmt.Sprintf("%s updates of %s dependencies are disabled", c.Tool, u.Ecosystem),
+				file.Location(), finding.OutcomeFalse)
+			if err != nil {
+				return nil, Probe, fmt.Errorf("create finding: %w", err)
+			}
+			f = f.WithValue(ToolKey, c.Tool)
+			f = f.WithValue(EcosystemKey, u.Ecosystem)
+			f = f.WithRemediationMetadata(metadata)
+			findings = append(findings, *f)
+		}
+	}
+	return findings, Probe, nil
+}
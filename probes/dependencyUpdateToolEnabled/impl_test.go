@@ -0,0 +1,124 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package dependencyUpdateToolEnabled
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		raw       *checker.RawResults
+		err       error
+		outcomes  []finding.Outcome
+		locations []uint
+	}{
+		{
+			name: "nil raw results",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no configuration",
+			raw: &checker.RawResults{
+				DependencyUpdateToolResults: checker.DependencyUpdateToolData{
+					Tools: []checker.Tool{{Name: "Dependabot"}},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+		},
+		{
+			name: "enabled configurations",
+			raw: &checker.RawResults{
+				DependencyUpdateToolResults: checker.DependencyUpdateToolData{
+					Configs: []checker.DependencyUpdateConfig{
+						{Tool: "RenovateBot", File: checker.File{Path: "renovate.json"}},
+						{Tool: "PyUp", File: checker.File{Path: ".pyup.yml"}},
+					},
+				},
+			},
+			outcomes:  []finding.Outcome{finding.OutcomeTrue, finding.OutcomeTrue},
+			locations: []uint{0, 0},
+		},
+		{
+			name: "disabled configuration",
+			raw: &checker.RawResults{
+				DependencyUpdateToolResults: checker.DependencyUpdateToolData{
+					Configs: []checker.DependencyUpdateConfig{
+						{
+							Tool:     "RenovateBot",
+							File:     checker.File{Path: "renovate.json"},
+							Updates:  []checker.DependencyUpdate{{Disabled: true}},
+							Disabled: true,
+						},
+					},
+				},
+			},
+			outcomes:  []finding.Outcome{finding.OutcomeFalse},
+			locations: []uint{0},
+		},
+		{
+			name: "disabled update",
+			raw: &checker.RawResults{
+				DependencyUpdateToolResults: checker.DependencyUpdateToolData{
+					Configs: []checker.DependencyUpdateConfig{
+						{
+							Tool: "Dependabot",
+							File: checker.File{Path: ".github/dependabot.yml"},
+							Updates: []checker.DependencyUpdate{
+								{Ecosystem: "gomod", Offset: 3},
+								{Ecosystem: "npm", Offset: 7, Disabled: true},
+							},
+						},
+					},
+				},
+			},
+			outcomes:  []finding.Outcome{finding.OutcomeTrue, finding.OutcomeFalse},
+			locations: []uint{0, 7},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			for i, line := range tt.locations {
+				if got := findings[i].Location.LineStart; got == nil || *got != line {
+					t.Errorf("finding %d: line %v, want %d", i, got, line)
+				}
+			}
+		})
+	}
+}
@@ -17,33 +17,56 @@ package probes
 import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/approvalsAreNotRushed"
+	"github.com/ossf/scorecard/v5/probes/approvedAfterFinalPush"
 	"github.com/ossf/scorecard/v5/probes/archived"
 	"github.com/ossf/scorecard/v5/probes/blocksDeleteOnBranches"
 	"github.com/ossf/scorecard/v5/probes/blocksForcePushOnBranches"
 	"github.com/ossf/scorecard/v5/probes/branchProtectionAppliesToAdmins"
 	"github.com/ossf/scorecard/v5/probes/branchesAreProtected"
+	"github.com/ossf/scorecard/v5/probes/ciConfigRunsTests"
 	"github.com/ossf/scorecard/v5/probes/codeApproved"
 	"github.com/ossf/scorecard/v5/probes/codeReviewOneReviewers"
 	"github.com/ossf/scorecard/v5/probes/contributorsFromOrgOrCompany"
 	"github.com/ossf/scorecard/v5/probes/createdRecently"
 	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolConfigured"
+	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolCoversManifests"
+	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolEnabled"
 	"github.com/ossf/scorecard/v5/probes/dismissesStaleReviews"
 	"github.com/ossf/scorecard/v5/probes/fuzzed"
 	"github.com/ossf/scorecard/v5/probes/hasBinaryArtifacts"
+	"github.com/ossf/scorecard/v5/probes/hasBusFactorAboveOne"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowArtifactPoisoning"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowEnvironmentFileInjection"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowGitHubScriptInjection"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowScriptInjection"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowSecretsInherit"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowSelfHostedRunner"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowUnguardedIssueComment"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowUntrustedCheckout"
 	"github.com/ossf/scorecard/v5/probes/hasFSFOrOSIApprovedLicense"
+	"github.com/ossf/scorecard/v5/probes/hasFewStalePullRequests"
+	"github.com/ossf/scorecard/v5/probes/hasHumanApproval"
+	"github.com/ossf/scorecard/v5/probes/hasIndependentApprover"
+	"github.com/ossf/scorecard/v5/probes/hasLeakedSecrets"
 	"github.com/ossf/scorecard/v5/probes/hasLicenseFile"
+	"github.com/ossf/scorecard/v5/probes/hasLicenseHeaders"
+	"github.com/ossf/scorecard/v5/probes/hasMultipleReviewers"
+	"github.com/ossf/scorecard/v5/probes/hasNoConflictingLicenses"
 	"github.com/ossf/scorecard/v5/probes/hasNoGitHubWorkflowPermissionUnknown"
 	"github.com/ossf/scorecard/v5/probes/hasOSVVulnerabilities"
 	"github.com/ossf/scorecard/v5/probes/hasOpenSSFBadge"
 	"github.com/ossf/scorecard/v5/probes/hasPermissiveLicense"
+	"github.com/ossf/scorecard/v5/probes/hasPrivateVulnerabilityReporting"
 	"github.com/ossf/scorecard/v5/probes/hasRecentCommits"
+	"github.com/ossf/scorecard/v5/probes/hasRecentRelease"
 	"github.com/ossf/scorecard/v5/probes/hasReleaseSBOM"
 	"github.com/ossf/scorecard/v5/probes/hasSBOM"
 	"github.com/ossf/scorecard/v5/probes/hasUnverifiedBinaryArtifacts"
+	"github.com/ossf/scorecard/v5/probes/hasValidSecurityTxt"
 	"github.com/ossf/scorecard/v5/probes/issueActivityByProjectMember"
 	"github.com/ossf/scorecard/v5/probes/jobLevelPermissions"
+	"github.com/ossf/scorecard/v5/probes/mergesRespectRequiredReviews"
 	"github.com/ossf/scorecard/v5/probes/packagedWithAutomatedWorkflow"
 	"github.com/ossf/scorecard/v5/probes/pinsDependencies"
 	"github.com/ossf/scorecard/v5/probes/releasesAreSigned"
@@ -52,16 +75,31 @@ import (
 	"github.com/ossf/scorecard/v5/probes/requiresApproversForPullRequests"
 	"github.com/ossf/scorecard/v5/probes/requiresCodeOwnersReview"
 	"github.com/ossf/scorecard/v5/probes/requiresLastPushApproval"
+	"github.com/ossf/scorecard/v5/probes/requiresLinearHistory"
+	"github.com/ossf/scorecard/v5/probes/requiresMergeQueue"
 	"github.com/ossf/scorecard/v5/probes/requiresPRsToChangeCode"
+	"github.com/ossf/scorecard/v5/probes/requiresSignedCommits"
 	"github.com/ossf/scorecard/v5/probes/requiresUpToDateBranches"
+	"github.com/ossf/scorecard/v5/probes/respondsToIssues"
+	"github.com/ossf/scorecard/v5/probes/respondsToPullRequests"
+	"github.com/ossf/scorecard/v5/probes/respondsToSecurityIssues"
+	"github.com/ossf/scorecard/v5/probes/reviewedByMaintainer"
+	"github.com/ossf/scorecard/v5/probes/rulesHaveNoBypassActors"
 	"github.com/ossf/scorecard/v5/probes/runsStatusChecksBeforeMerging"
 	"github.com/ossf/scorecard/v5/probes/sastToolConfigured"
+	"github.com/ossf/scorecard/v5/probes/sastToolCoversLanguages"
 	"github.com/ossf/scorecard/v5/probes/sastToolRunsOnAllCommits"
+	"github.com/ossf/scorecard/v5/probes/sbomHasNTIAMinimumElements"
+	"github.com/ossf/scorecard/v5/probes/sbomListsDeclaredDependencies"
+	"github.com/ossf/scorecard/v5/probes/securityPolicyContactsAreValid"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsLinks"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsText"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsVulnerabilityDisclosure"
+	"github.com/ossf/scorecard/v5/probes/securityPolicyHasResponseTimeline"
+	"github.com/ossf/scorecard/v5/probes/securityPolicyHasSupportedVersions"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyPresent"
 	"github.com/ossf/scorecard/v5/probes/testsRunInCI"
+	"github.com/ossf/scorecard/v5/probes/topContributorsAreActive"
 	"github.com/ossf/scorecard/v5/probes/topLevelPermissions"
 	"github.com/ossf/scorecard/v5/probes/unsafeblock"
 	"github.com/ossf/scorecard/v5/probes/webhooksUseSecrets"
@@ -83,11 +121,18 @@ var (
 		securityPolicyContainsLinks.Run,
 		securityPolicyContainsVulnerabilityDisclosure.Run,
 		securityPolicyContainsText.Run,
+		hasPrivateVulnerabilityReporting.Run,
+		hasValidSecurityTxt.Run,
+		securityPolicyHasSupportedVersions.Run,
+		securityPolicyHasResponseTimeline.Run,
+		securityPolicyContactsAreValid.Run,
 	}
 	// DependencyToolUpdates is all the probes for the
 	// DependencyUpdateTool check.
 	DependencyToolUpdates = []ProbeImpl{
 		dependencyUpdateToolConfigured.Run,
+		dependencyUpdateToolCoversManifests.Run,
+		dependencyUpdateToolEnabled.Run,
 	}
 	Fuzzing = []ProbeImpl{
 		fuzzed.Run,
@@ -111,16 +156,28 @@ var (
 	SAST = []ProbeImpl{
 		sastToolConfigured.Run,
 		sastToolRunsOnAllCommits.Run,
+		sastToolCoversLanguages.Run,
 	}
 	DangerousWorkflows = []ProbeImpl{
 		hasDangerousWorkflowScriptInjection.Run,
 		hasDangerousWorkflowUntrustedCheckout.Run,
+		hasDangerousWorkflowArtifactPoisoning.Run,
+		hasDangerousWorkflowSecretsInherit.Run,
+		hasDangerousWorkflowSelfHostedRunner.Run,
+		hasDangerousWorkflowEnvironmentFileInjection.Run,
+		hasDangerousWorkflowUnguardedIssueComment.Run,
+		hasDangerousWorkflowGitHubScriptInjection.Run,
 	}
 	Maintained = []ProbeImpl{
 		archived.Run,
 		hasRecentCommits.Run,
 		issueActivityByProjectMember.Run,
 		createdRecently.Run,
+		respondsToIssues.Run,
+		respondsToPullRequests.Run,
+		hasFewStalePullRequests.Run,
+		hasRecentRelease.Run,
+		respondsToSecurityIssues.Run,
 	}
 	CIIBestPractices = []ProbeImpl{
 		hasOpenSSFBadge.Run,
@@ -133,10 +190,13 @@ var (
 	}
 	CITests = []ProbeImpl{
 		testsRunInCI.Run,
+		ciConfigRunsTests.Run,
 	}
 	SBOM = []ProbeImpl{
 		hasSBOM.Run,
 		hasReleaseSBOM.Run,
+		sbomHasNTIAMinimumElements.Run,
+		sbomListsDeclaredDependencies.Run,
 	}
 	SignedReleases = []ProbeImpl{
 		releasesAreSigned.Run,
@@ -171,10 +231,26 @@ var (
 		codeReviewOneReviewers.Run,
 		hasBinaryArtifacts.Run,
 		releasesHaveVerifiedProvenance.Run,
+		requiresSignedCommits.Run,
+		requiresLinearHistory.Run,
+		requiresMergeQueue.Run,
+		rulesHaveNoBypassActors.Run,
+		hasLicenseHeaders.Run,
+		hasNoConflictingLicenses.Run,
+		hasBusFactorAboveOne.Run,
+		hasMultipleReviewers.Run,
+		topContributorsAreActive.Run,
+		hasHumanApproval.Run,
+		approvedAfterFinalPush.Run,
+		hasIndependentApprover.Run,
+		approvalsAreNotRushed.Run,
+		mergesRespectRequiredReviews.Run,
+		reviewedByMaintainer.Run,
 	}
 
 	// Probes which don't use pre-computed raw data but rather collect it themselves.
 	Independent = []IndependentProbeImpl{
+		hasLeakedSecrets.Run,
 		unsafeblock.Run,
 	}
 )
@@ -0,0 +1,76 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasBusFactorAboveOne
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Contributors})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe          = "hasBusFactorAboveOne"
+	BusFactor50Key = "busFactor50"
+	BusFactor80Key = "busFactor80"
+	ChangesKey     = "changes"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	activity := raw.ContributorsResults.Activity
+	var text string
+	var outcome finding.Outcome
+	switch {
+	case activity == nil:
+		text = "commit history is not available"
+		outcome = finding.OutcomeNotAvailable
+	case activity.Changes == 0:
+		text = "no recent changes from human authors"
+		outcome = finding.OutcomeNotApplicable
+	case activity.BusFactor80 > 1:
+		text = fmt.Sprintf("%d authors made 80%% of the last %d changes", activity.BusFactor80, activity.Changes)
+		outcome = finding.OutcomeTrue
+	default:
+		text = fmt.Sprintf("%s made 80%% of the last %d changes", activity.Authors[0].Login, activity.Changes)
+		outcome = finding.OutcomeFalse
+	}
+	f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	if activity != nil && activity.Changes > 0 {
+		f = f.WithValue(BusFactor50Key, strconv.Itoa(activity.BusFactor50)).
+			WithValue(BusFactor80Key, strconv.Itoa(activity.BusFactor80)).
+			WithValue(ChangesKey, strconv.Itoa(activity.Changes))
+	}
+	return []finding.Finding{*f}, Probe, nil
+}
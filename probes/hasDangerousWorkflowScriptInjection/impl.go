@@ -24,11 +24,13 @@ import (
 	"github.com/rhysd/actionlint"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/internal/checknames"
 	"github.com/ossf/scorecard/v5/internal/probes"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowScriptInjection/internal/patch"
 	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/workflowcall"
 )
 
 func init() {
@@ -68,9 +70,8 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 			continue
 		}
 
-		f, err := finding.NewWith(fs, Probe,
-			fmt.Sprintf("script injection with untrusted input '%v'", w.File.Snippet),
-			nil, finding.OutcomeTrue)
+		msg := fmt.Sprintf("script injection with untrusted input '%v'", w.File.Snippet)
+		f, err := finding.NewWith(fs, Probe, msg+workflowcall.Text(w.Caller), nil, finding.OutcomeTrue)
 		if err != nil {
 			return nil, Probe, fmt.Errorf("create finding: %w", err)
 		}
@@ -81,13 +82,20 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 			LineStart: &w.File.Offset,
 			Snippet:   &w.File.Snippet,
 		})
-
-		err = parseWorkflow(localPath, &w, &currWorkflow, &content, &workflow, &errs)
-		if err == nil {
-			generatePatch(&w, content, workflow, errs, f)
+		cf := workflowcall.Finding(f, msg, &w.File, w.Caller)
+
+		// Patches are only generated for GitHub workflows.
+		if fileparser.IsWorkflowFile(w.File.Path) {
+			err = parseWorkflow(localPath, &w, &currWorkflow, &content, &workflow, &errs)
+			if err == nil {
+				generatePatch(&w, content, workflow, errs, f)
+			}
 		}
 
 		findings = append(findings, *f)
+		if cf != nil {
+			findings = append(findings, *cf)
+		}
 	}
 
 	if len(findings) == 0 {
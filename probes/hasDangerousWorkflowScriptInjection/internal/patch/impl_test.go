@@ -207,10 +207,11 @@ func detectDangerousWorkflows(t *testing.T, filePath string) []checker.Dangerous
 		// Pretend the file is in the workflow directory to pass a check deep in
 		// raw.DangerousWorkflow
 		[]string{path.Join(".github/workflows/", filePath)}, nil,
-	)
+	).AnyTimes()
 	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
 		return os.Open("./testdata/" + filePath)
 	}).AnyTimes()
+	mockRepoClient.EXPECT().IsPrivate().Return(false, nil).AnyTimes()
 
 	req := &checker.CheckRequest{
 		Ctx:        context.Background(),
@@ -222,6 +223,11 @@ func detectDangerousWorkflows(t *testing.T, filePath string) []checker.Dangerous
 		t.Errorf("Error running raw.DangerousWorkflow. Error:\n%s", err)
 	}
 
+	// Only script injections are patched.
+	dw.Workflows = slices.DeleteFunc(dw.Workflows, func(w checker.DangerousWorkflow) bool {
+		return w.Type != checker.DangerousWorkflowScriptInjection
+	})
+
 	// Sort findings by position. This ensures each finding is compared to its
 	// respective "fixed" workflow.
 	slices.SortFunc(dw.Workflows, func(a, b checker.DangerousWorkflow) int {
@@ -24,6 +24,7 @@ import (
 	"github.com/ossf/scorecard/v5/internal/checknames"
 	"github.com/ossf/scorecard/v5/internal/probes"
 	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/workflowcall"
 )
 
 func init() {
@@ -55,9 +56,8 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	var findings []finding.Finding
 	for _, e := range r.Workflows {
 		if e.Type == checker.DangerousWorkflowUntrustedCheckout {
-			f, err := finding.NewWith(fs, Probe,
-				fmt.Sprintf("untrusted code checkout '%v'", e.File.Snippet),
-				nil, finding.OutcomeTrue)
+			msg := fmt.Sprintf("untrusted code checkout '%v'", e.File.Snippet)
+			f, err := finding.NewWith(fs, Probe, msg+workflowcall.Text(e.Caller), nil, finding.OutcomeTrue)
 			if err != nil {
 				return nil, Probe, fmt.Errorf("create finding: %w", err)
 			}
@@ -68,6 +68,9 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 				Snippet:   &e.File.Snippet,
 			})
 			findings = append(findings, *f)
+			if cf := workflowcall.Finding(f, msg, &e.File, e.Caller); cf != nil {
+				findings = append(findings, *cf)
+			}
 		}
 	}
 	if len(findings) == 0 {
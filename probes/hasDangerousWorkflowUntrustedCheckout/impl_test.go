@@ -33,6 +33,7 @@ func Test_Run(t *testing.T) {
 		name     string
 		raw      *checker.RawResults
 		outcomes []finding.Outcome
+		paths    []string
 		err      error
 	}{
 		{
@@ -67,6 +68,28 @@ func Test_Run(t *testing.T) {
 				finding.OutcomeTrue,
 			},
 		},
+		{
+			name: "Untrusted checkout in a reusable workflow is also reported at its call.",
+			raw: &checker.RawResults{
+				DangerousWorkflowResults: checker.DangerousWorkflowData{
+					NumWorkflows: 2,
+					Workflows: []checker.DangerousWorkflow{
+						{
+							Type: checker.DangerousWorkflowUntrustedCheckout,
+							File: checker.File{Path: ".github/workflows/build.yml", Offset: 12},
+							Caller: &checker.WorkflowCall{
+								File: checker.File{Path: ".github/workflows/pr.yml", Offset: 8},
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeTrue,
+			},
+			paths: []string{".github/workflows/build.yml", ".github/workflows/pr.yml"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -83,6 +106,16 @@ func Test_Run(t *testing.T) {
 				t.Errorf("mismatch (-want +got):\n%s", diff)
 			}
 			test.AssertOutcomes(t, findings, tt.outcomes)
+			if tt.paths == nil {
+				return
+			}
+			var paths []string
+			for i := range findings {
+				paths = append(paths, findings[i].Location.Path)
+			}
+			if diff := cmp.Diff(tt.paths, paths); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
 		})
 	}
 }
@@ -0,0 +1,86 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasFewStalePullRequests
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/responsiveness"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Maintained})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe                   = "hasFewStalePullRequests"
+	NumOpenPullRequestsKey  = "openPullRequests"
+	NumStalePullRequestsKey = "stalePullRequests"
+
+	staleDays = 30
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	threshold := time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*staleDays /*days*/)
+	var open, stale int
+	prs := raw.MaintainedResults.PullRequests
+	for i := range prs {
+		if prs[i].State != "OPEN" {
+			continue
+		}
+		open++
+		if responsiveness.LastActivity(&prs[i]).Before(threshold) {
+			stale++
+		}
+	}
+	if open == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no recent open pull requests", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	text := fmt.Sprintf("%d out of %d open pull requests are stale", stale, open)
+	outcome := finding.OutcomeTrue
+	if stale*2 > open {
+		outcome = finding.OutcomeFalse
+	}
+	f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValues(map[string]string{
+		NumOpenPullRequestsKey:  strconv.Itoa(open),
+		NumStalePullRequestsKey: strconv.Itoa(stale),
+	})
+	return []finding.Finding{*f}, Probe, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasFewStalePullRequests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	daysAgo := func(days int) time.Time {
+		return time.Now().AddDate(0, 0, -days)
+	}
+	open := func(openedDaysAgo int) clients.PullRequest {
+		return clients.PullRequest{State: "OPEN", CreatedAt: daysAgo(openedDaysAgo)}
+	}
+	commented := open(90)
+	commentedAt := daysAgo(3)
+	commented.Comments = []clients.IssueComment{{CreatedAt: &commentedAt}}
+	reviewed := open(90)
+	reviewed.Reviews = []clients.Review{{State: "COMMENTED", SubmittedAt: daysAgo(10)}}
+	merged := clients.PullRequest{State: "MERGED", CreatedAt: daysAgo(100)}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		values   map[string]string
+		err      error
+	}{
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no open pull requests",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					PullRequests: []clients.PullRequest{merged},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "open pull requests with recent activity",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					PullRequests: []clients.PullRequest{open(5), commented, reviewed, open(60), merged},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+			values: map[string]string{
+				NumOpenPullRequestsKey:  "4",
+				NumStalePullRequestsKey: "1",
+			},
+		},
+		{
+			name: "mostly stale open pull requests",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					PullRequests: []clients.PullRequest{open(5), open(40), open(60)},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+			},
+			values: map[string]string{
+				NumOpenPullRequestsKey:  "3",
+				NumStalePullRequestsKey: "2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := Run(tt.raw)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Run() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if s != Probe {
+				t.Errorf("Run() probe = %q, want %q", s, Probe)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			if tt.values != nil {
+				if diff := cmp.Diff(tt.values, findings[0].Values); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasHumanApproval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	submitted := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	approval := func(login string, bot bool) clients.Review {
+		return clients.Review{
+			Author:      &clients.User{Login: login, IsBot: bot},
+			State:       "APPROVED",
+			SubmittedAt: submitted,
+		}
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no changesets",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "approvals by humans and bots",
+			raw: &checker.RawResults{
+				CodeReviewResults: checker.CodeReviewData{
+					DefaultBranchChangesets: []checker.Changeset{
+						{
+							ReviewPlatform: checker.ReviewPlatformGitHub,
+							RevisionID:     "1",
+							Author:         clients.User{Login: "alice"},
+							Reviews:        []clients.Review{approval("approve-bot", true), approval("bob", false)},
+						},
+						{
+							ReviewPlatform: checker.ReviewPlatformGitHub,
+							RevisionID:     "2",
+							Author:         clients.User{Login: "alice"},
+							Reviews:        []clients.Review{approval("approve-bot", true)},
+						},
+						{
+							// Only approved by its author.
+							ReviewPlatform: checker.ReviewPlatformGitHub,
+							RevisionID:     "3",
+							Author:         clients.User{Login: "alice"},
+							Reviews:        []clients.Review{approval("alice", false)},
+						},
+						{
+							// The approval inferred from the merge doesn't count.
+							ReviewPlatform: checker.ReviewPlatformGitHub,
+							RevisionID:     "4",
+							Author:         clients.User{Login: "alice"},
+							Reviews: []clients.Review{
+								{Author: &clients.User{Login: "bob"}, State: "APPROVED"},
+							},
+						},
+						{
+							ReviewPlatform: checker.ReviewPlatformGerrit,
+							RevisionID:     "5",
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeFalse,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := Run(tt.raw)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Run() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if s != Probe {
+				t.Errorf("Run() probe = %q, want %q", s, Probe)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
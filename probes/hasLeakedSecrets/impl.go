@@ -0,0 +1,160 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasLeakedSecrets
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients/git"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe     = "hasLeakedSecrets"
+	RuleIDKey = "ruleID"
+	CommitKey = "commit"
+
+	// historyDepth is the number of recent commits scanned when the
+	// history scan is enabled.
+	historyDepth = 30
+	// maxFileSize is the size above which files are not scanned.
+	maxFileSize = 2 << 20
+)
+
+func init() {
+	probes.MustRegisterIndependent(Probe, Run)
+}
+
+type scanData struct {
+	request  *checker.CheckRequest
+	reported map[string]bool
+	findings []finding.Finding
+}
+
+func Run(raw *checker.CheckRequest) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, Probe, fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+	data := scanData{
+		request:  raw,
+		reported: make(map[string]bool),
+	}
+	err := fileparser.OnPredicateFileContentDo(raw.RepoClient, func(path string) bool {
+		return !skipFile(raw, path)
+	}, scanFile, &data)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("scanning files: %w", err)
+	}
+
+	if raw.HistoryClient != nil {
+		lines, err := raw.HistoryClient.ListAddedLines(historyDepth)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("listing recent changes: %w", err)
+		}
+		for i := range lines {
+			if err := scanAddedLine(&lines[i], &data); err != nil {
+				return nil, Probe, err
+			}
+		}
+	}
+
+	if len(data.findings) == 0 {
+		f, err := finding.NewWith(fs, Probe, "no secrets found in the repository", nil, finding.OutcomeFalse)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return data.findings, Probe, nil
+}
+
+// skipFile reports whether the file holds test data, either by convention
+// or because the maintainers annotated it as such, in which case the secrets
+// it contains are assumed to be fake.
+func skipFile(raw *checker.CheckRequest, path string) bool {
+	return fileparser.IsTestdataFile(path) ||
+		(raw.Config != nil && raw.Config.IsTestData(Probe, path))
+}
+
+func scanFile(path string, content []byte, args ...interface{}) (bool, error) {
+	data, ok := args[0].(*scanData)
+	if !ok {
+		// panic if it is not correct type
+		panic(fmt.Sprintf("expected type scanData, got %v", reflect.TypeOf(args[0])))
+	}
+	if skipFile(data.request, path) || len(content) > maxFileSize || bytes.IndexByte(content, 0) != -1 {
+		return true, nil
+	}
+	for i, line := range strings.Split(string(content), "\n") {
+		for _, m := range scanLine(line) {
+			if err := data.report(m, path, uint(i+1), ""); err != nil {
+				return false, err
+			}
+		}
+	}
+	return true, nil
+}
+
+func scanAddedLine(line *git.AddedLine, data *scanData) error {
+	if skipFile(data.request, line.Path) {
+		return nil
+	}
+	for _, m := range scanLine(line.Content) {
+		if err := data.report(m, line.Path, uint(line.Line), line.CommitSHA); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// report adds a finding for a secret, unless it was already reported.
+func (data *scanData) report(m match, path string, line uint, commit string) error {
+	if data.reported[m.secret] {
+		return nil
+	}
+	data.reported[m.secret] = true
+
+	snippet := redact(m)
+	msg := fmt.Sprintf("possible %s found", m.rule.description)
+	if commit != "" {
+		msg = fmt.Sprintf("possible %s added in commit %s", m.rule.description, commit)
+	}
+	f, err := finding.NewWith(fs, Probe, msg, &finding.Location{
+		Path:      path,
+		Type:      finding.FileTypeText,
+		LineStart: &line,
+		Snippet:   &snippet,
+	}, finding.OutcomeTrue)
+	if err != nil {
+		return fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(RuleIDKey, m.rule.id)
+	if commit != "" {
+		f = f.WithValue(CommitKey, commit)
+	}
+	data.findings = append(data.findings, *f)
+	return nil
+}
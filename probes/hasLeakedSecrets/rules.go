@@ -0,0 +1,199 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasLeakedSecrets
+
+import (
+	"math"
+	"regexp"
+	"strings"
+)
+
+type rule struct {
+	re          *regexp.Regexp
+	id          string
+	description string
+	// group is the index of the submatch holding the secret, 0 for the whole match.
+	group int
+	// minEntropy is the minimum Shannon entropy of the secret, in bits per character.
+	minEntropy float64
+	// public is set when the match identifies the secret without revealing it,
+	// e.g. the header of a private key, and does not need to be redacted.
+	public bool
+}
+
+// rules is the set of secret formats detected by the probe. The generic rule
+// must come last, so that known formats are reported under their own name.
+var rules = []rule{
+	{
+		id:          "aws-access-key-id",
+		description: "AWS access key ID",
+		re:          regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA)[A-Z0-9]{16}\b`),
+		minEntropy:  3,
+	},
+	{
+		id:          "gcp-api-key",
+		description: "Google Cloud API key",
+		re:          regexp.MustCompile(`\bAIza[0-9A-Za-z_\-]{35}\b`),
+		minEntropy:  3,
+	},
+	{
+		id:          "azure-storage-account-key",
+		description: "Azure storage account key",
+		re:          regexp.MustCompile(`AccountKey=([A-Za-z0-9+/]{86}==)`),
+		group:       1,
+		minEntropy:  3,
+	},
+	{
+		id:          "github-token",
+		description: "GitHub token",
+		re:          regexp.MustCompile(`\b(?:ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{36}\b`),
+		minEntropy:  3,
+	},
+	{
+		id:          "github-fine-grained-token",
+		description: "GitHub fine-grained personal access token",
+		re:          regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{82}\b`),
+		minEntropy:  3,
+	},
+	{
+		id:          "gitlab-token",
+		description: "GitLab personal access token",
+		re:          regexp.MustCompile(`\bglpat-[A-Za-z0-9_\-]{20}\b`),
+		minEntropy:  3,
+	},
+	{
+		id:          "slack-webhook",
+		description: "Slack webhook URL",
+		re:          regexp.MustCompile(`https://hooks\.slack\.com/(?:services|workflows)/[A-Za-z0-9+/_\-]{20,}`),
+	},
+	{
+		id:          "slack-token",
+		description: "Slack token",
+		re:          regexp.MustCompile(`\bxox[abposr]-[0-9A-Za-z\-]{10,}\b`),
+		minEntropy:  3,
+	},
+	{
+		id:          "stripe-secret-key",
+		description: "Stripe secret key",
+		re:          regexp.MustCompile(`\b[sr]k_live_[0-9A-Za-z]{24,}\b`),
+		minEntropy:  3,
+	},
+	{
+		id:          "npm-token",
+		description: "npm access token",
+		re:          regexp.MustCompile(`\bnpm_[A-Za-z0-9]{36}\b`),
+		minEntropy:  3,
+	},
+	{
+		id:          "pypi-token",
+		description: "PyPI API token",
+		re:          regexp.MustCompile(`\bpypi-AgEIcHlwaS5vcmc[A-Za-z0-9_\-]{50,}`),
+	},
+	{
+		id:          "private-key",
+		description: "private key",
+		re: regexp.MustCompile(
+			`-----BEGIN (?:RSA |EC |DSA |OPENSSH |PGP |ENCRYPTED )?PRIVATE KEY(?: BLOCK)?-----`),
+		public: true,
+	},
+	{
+		id:          "generic-secret",
+		description: "high-entropy secret",
+		re: regexp.MustCompile(`(?i)[\w.\-]*(?:secret|token|passwd|password|api[_\-]?key|access[_\-]?key|auth[_\-]?key)` +
+			`[\w.\-]*["']?\s*[:=]{1,2}\s*["']([A-Za-z0-9+/=_\-.~]{20,})["']`),
+		group:      1,
+		minEntropy: 4,
+	},
+}
+
+// placeholders are substrings of values used in documentation and examples.
+var placeholders = []string{
+	"example", "sample", "dummy", "placeholder", "changeme", "xxxxxxxx", "your_", "your-", "redacted",
+}
+
+type match struct {
+	rule   *rule
+	secret string
+}
+
+// scanLine returns the secrets found on a line. Each secret is reported
+// once, under the first rule which detects it.
+func scanLine(line string) []match {
+	var ret []match
+	seen := make(map[string]bool)
+	for i := range rules {
+		r := &rules[i]
+		for _, m := range r.re.FindAllStringSubmatch(line, -1) {
+			secret := m[r.group]
+			if seen[secret] || isPlaceholder(secret) || entropy(secret) < r.minEntropy {
+				continue
+			}
+			if r.minEntropy >= 4 && !hasLettersAndDigits(secret) {
+				continue
+			}
+			seen[secret] = true
+			ret = append(ret, match{rule: r, secret: secret})
+		}
+	}
+	return ret
+}
+
+func isPlaceholder(secret string) bool {
+	lower := strings.ToLower(secret)
+	for _, p := range placeholders {
+		if strings.Contains(lower, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasLettersAndDigits(s string) bool {
+	return strings.ContainsAny(s, "0123456789") &&
+		strings.ContainsAny(strings.ToLower(s), "abcdefghijklmnopqrstuvwxyz")
+}
+
+// entropy returns the Shannon entropy of s in bits per character.
+func entropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	for _, c := range s {
+		counts[c]++
+	}
+	var e float64
+	n := float64(len([]rune(s)))
+	for _, count := range counts {
+		p := float64(count) / n
+		e -= p * math.Log2(p)
+	}
+	return e
+}
+
+// redact hides all but the first characters of a secret.
+func redact(m match) string {
+	if m.rule.public {
+		return m.secret
+	}
+	const (
+		shown    = 4
+		maxStars = 16
+	)
+	if len(m.secret) <= 2*shown {
+		return strings.Repeat("*", len(m.secret))
+	}
+	return m.secret[:shown] + strings.Repeat("*", min(len(m.secret)-shown, maxStars))
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasLicenseHeaders
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.License})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "hasLicenseHeaders"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	data := raw.LicenseResults.SourceLicenses
+	var text string
+	var outcome finding.Outcome
+	switch {
+	case data == nil:
+		text = "the deep license scan is not enabled"
+		outcome = finding.OutcomeNotAvailable
+	case data.ScannedFiles == 0:
+		text = "no source files found"
+		outcome = finding.OutcomeNotApplicable
+	case len(data.UnlicensedFiles) == 0:
+		text = fmt.Sprintf("all %d source files declare their license", data.ScannedFiles)
+		outcome = finding.OutcomeTrue
+	}
+	if text != "" {
+		f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	findings := make([]finding.Finding, 0, len(data.UnlicensedFiles))
+	for i := range data.UnlicensedFiles {
+		file := &data.UnlicensedFiles[i]
+		f, err := finding.NewWith(fs, Probe, "source file does not declare its license",
+			file.Location(), finding.OutcomeFalse)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasMultipleReviewers
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Contributors})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe        = "hasMultipleReviewers"
+	ReviewersKey = "reviewers"
+	MergersKey   = "mergers"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	activity := raw.ContributorsResults.Activity
+	var text string
+	var outcome finding.Outcome
+	switch {
+	case activity == nil:
+		text = "commit history is not available"
+		outcome = finding.OutcomeNotAvailable
+	case activity.Changes == 0:
+		text = "no recent changes from human authors"
+		outcome = finding.OutcomeNotApplicable
+	default:
+		text = fmt.Sprintf("%d distinct reviewers and %d distinct mergers in the last %d changes",
+			len(activity.Reviewers), len(activity.Mergers), activity.Changes)
+		outcome = finding.OutcomeFalse
+		if len(activity.Reviewers) > 1 && len(activity.Mergers) > 1 {
+			outcome = finding.OutcomeTrue
+		}
+	}
+	f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	if activity != nil && activity.Changes > 0 {
+		f = f.WithValue(ReviewersKey, strconv.Itoa(len(activity.Reviewers))).
+			WithValue(MergersKey, strconv.Itoa(len(activity.Mergers)))
+	}
+	return []finding.Finding{*f}, Probe, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasNoConflictingLicenses
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.License})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe             = "hasNoConflictingLicenses"
+	ProjectLicenseKey = "projectLicense"
+	FileLicenseKey    = "fileLicense"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	data := raw.LicenseResults.SourceLicenses
+	var text string
+	var outcome finding.Outcome
+	switch {
+	case data == nil:
+		text = "the deep license scan is not enabled"
+		outcome = finding.OutcomeNotAvailable
+	case !hasKnownLicense(raw.LicenseResults.LicenseFiles):
+		text = "the license of the project is unknown"
+		outcome = finding.OutcomeNotApplicable
+	case len(data.Licenses) == 0:
+		text = "no source file declares its license"
+		outcome = finding.OutcomeNotApplicable
+	case len(data.Conflicts) == 0:
+		text = "the licenses of the source files are compatible with the license of the project"
+		outcome = finding.OutcomeTrue
+	}
+	if text != "" {
+		f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	findings := make([]finding.Finding, 0, len(data.Conflicts))
+	for i := range data.Conflicts {
+		conflict := &data.Conflicts[i]
+		text := fmt.Sprintf("%s license of %s is incompatible with the %s license of the project",
+			conflict.Expression, conflict.File.Path, conflict.ProjectLicense)
+		if conflict.Declaration != conflict.File.Path {
+			text += fmt.Sprintf(" (declared in %s)", conflict.Declaration)
+		}
+		f, err := finding.NewWith(fs, Probe, text, conflict.File.Location(), finding.OutcomeFalse)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(ProjectLicenseKey, conflict.ProjectLicense).
+			WithValue(FileLicenseKey, conflict.Expression)
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+func hasKnownLicense(files []checker.LicenseFile) bool {
+	for i := range files {
+		if id := files[i].LicenseInformation.SpdxID; id != "" && id != "NOASSERTION" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasNoConflictingLicenses
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	apache := []checker.LicenseFile{
+		{LicenseInformation: checker.License{SpdxID: "Apache-2.0"}},
+	}
+	gpl := checker.SourceLicense{
+		File:        checker.File{Path: "gpl.c", Type: finding.FileTypeSource, Offset: 1},
+		Declaration: "gpl.c",
+		Expression:  "GPL-3.0-only",
+		Source:      checker.SourceLicenseSourceHeader,
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "deep scan not enabled",
+			raw: &checker.RawResults{
+				LicenseResults: checker.LicenseData{LicenseFiles: apache},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotAvailable,
+			},
+		},
+		{
+			name: "unknown project license",
+			raw: &checker.RawResults{
+				LicenseResults: checker.LicenseData{
+					LicenseFiles: []checker.LicenseFile{
+						{LicenseInformation: checker.License{SpdxID: "NOASSERTION"}},
+					},
+					SourceLicenses: &checker.SourceLicenseData{
+						Licenses: []checker.SourceLicense{gpl},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "no declared licenses",
+			raw: &checker.RawResults{
+				LicenseResults: checker.LicenseData{
+					LicenseFiles:   apache,
+					SourceLicenses: &checker.SourceLicenseData{ScannedFiles: 2},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "compatible licenses",
+			raw: &checker.RawResults{
+				LicenseResults: checker.LicenseData{
+					LicenseFiles: apache,
+					SourceLicenses: &checker.SourceLicenseData{
+						Licenses: []checker.SourceLicense{
+							{File: checker.File{Path: "main.go"}, Expression: "MIT"},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+		},
+		{
+			name: "one false outcome per conflict",
+			raw: &checker.RawResults{
+				LicenseResults: checker.LicenseData{
+					LicenseFiles: apache,
+					SourceLicenses: &checker.SourceLicenseData{
+						Licenses: []checker.SourceLicense{gpl, gpl},
+						Conflicts: []checker.LicenseConflict{
+							{SourceLicense: gpl, ProjectLicense: "Apache-2.0"},
+							{SourceLicense: gpl, ProjectLicense: "Apache-2.0"},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+				finding.OutcomeFalse,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
+
+func Test_Run_values(t *testing.T) {
+	t.Parallel()
+	raw := &checker.RawResults{
+		LicenseResults: checker.LicenseData{
+			LicenseFiles: []checker.LicenseFile{
+				{LicenseInformation: checker.License{SpdxID: "MIT"}},
+			},
+			SourceLicenses: &checker.SourceLicenseData{
+				Licenses: []checker.SourceLicense{{}},
+				Conflicts: []checker.LicenseConflict{{
+					SourceLicense: checker.SourceLicense{
+						File:        checker.File{Path: "lib/x.py"},
+						Declaration: "REUSE.toml",
+						Expression:  "AGPL-3.0-only",
+					},
+					ProjectLicense: "MIT",
+				}},
+			},
+		},
+	}
+	findings, _, err := Run(raw)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	want := map[string]string{ProjectLicenseKey: "MIT", FileLicenseKey: "AGPL-3.0-only"}
+	if diff := cmp.Diff(want, findings[0].Values); diff != "" {
+		t.Errorf("values mismatch (-want +got):\n%s", diff)
+	}
+	if findings[0].Location == nil || findings[0].Location.Path != "lib/x.py" {
+		t.Errorf("unexpected location: %v", findings[0].Location)
+	}
+}
@@ -39,7 +39,6 @@ const (
 	Probe          = "hasRecentCommits"
 	NumCommitsKey  = "commitsWithinThreshold"
 	LookbackDayKey = "lookBackDays"
-	lookBackDays   = 90
 )
 
 func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
@@ -50,6 +49,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	var findings []finding.Finding
 
 	r := raw.MaintainedResults
+	lookBackDays := r.GetLookBackDays()
 	threshold := time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
 	commitsWithinThreshold := 0
 
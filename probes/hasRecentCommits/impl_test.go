@@ -78,7 +78,7 @@ func Test_Run(t *testing.T) {
 			},
 			values: map[string]string{
 				NumCommitsKey:  "5",
-				LookbackDayKey: strconv.Itoa(lookBackDays),
+				LookbackDayKey: strconv.Itoa(checker.DefaultMaintainedLookBackDays),
 			},
 			outcomes: []finding.Outcome{finding.OutcomeTrue},
 		},
@@ -91,7 +91,21 @@ func Test_Run(t *testing.T) {
 			},
 			values: map[string]string{
 				NumCommitsKey:  "20",
-				LookbackDayKey: strconv.Itoa(lookBackDays),
+				LookbackDayKey: strconv.Itoa(checker.DefaultMaintainedLookBackDays),
+			},
+			outcomes: []finding.Outcome{finding.OutcomeTrue},
+		},
+		{
+			name: "Has all commits in a longer look-back window",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					DefaultBranchCommits: twentyCommitsInThresholdAndTwentyNot(),
+					LookBackDays:         180,
+				},
+			},
+			values: map[string]string{
+				NumCommitsKey:  "41",
+				LookbackDayKey: "180",
 			},
 			outcomes: []finding.Outcome{finding.OutcomeTrue},
 		},
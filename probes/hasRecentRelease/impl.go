@@ -0,0 +1,79 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasRecentRelease
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Maintained})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe                   = "hasRecentRelease"
+	DaysSinceLastReleaseKey = "daysSinceLastRelease"
+
+	maxDaysSinceRelease = 365
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var latest time.Time
+	var tag string
+	releases := raw.MaintainedResults.Releases
+	for i := range releases {
+		if releases[i].PublishedAt.After(latest) {
+			latest = releases[i].PublishedAt
+			tag = releases[i].TagName
+		}
+	}
+	if latest.IsZero() {
+		f, err := finding.NewNotApplicable(fs, Probe, "no releases with a known publication date", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	days := max(int(time.Since(latest).Hours()/24), 0)
+	text := fmt.Sprintf("latest release %s was published %d day(s) ago", tag, days)
+	outcome := finding.OutcomeTrue
+	if days > maxDaysSinceRelease {
+		outcome = finding.OutcomeFalse
+	}
+	f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(DaysSinceLastReleaseKey, strconv.Itoa(days))
+	return []finding.Finding{*f}, Probe, nil
+}
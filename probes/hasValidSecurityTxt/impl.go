@@ -0,0 +1,114 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasValidSecurityTxt
+
+import (
+	"embed"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SecurityPolicy})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe          = "hasValidSecurityTxt"
+	ExpiresKey     = "expires"
+	NumContactsKey = "numberOfContacts"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	txt := raw.SecurityPolicyResults.SecurityTxt
+	if txt == nil {
+		f, err := finding.NewNotApplicable(fs, Probe, "no security.txt file found", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	problems := validate(txt, time.Now())
+	var f *finding.Finding
+	var err error
+	if len(problems) == 0 {
+		f, err = finding.NewTrue(fs, Probe, "security.txt file is valid", txt.File.Location())
+	} else {
+		f, err = finding.NewFalse(fs, Probe,
+			"security.txt file is invalid: "+strings.Join(problems, ", "), txt.File.Location())
+	}
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(ExpiresKey, txt.Expires).
+		WithValue(NumContactsKey, strconv.Itoa(len(txt.Contacts)))
+	return []finding.Finding{*f}, Probe, nil
+}
+
+func validate(txt *checker.SecurityTxt, now time.Time) []string {
+	var problems []string
+	if len(txt.Contacts) == 0 {
+		problems = append(problems, "missing Contact field")
+	}
+	for _, contact := range txt.Contacts {
+		if !isValidContact(contact) {
+			problems = append(problems, fmt.Sprintf("invalid Contact %q", contact))
+		}
+	}
+	if txt.Expires == "" {
+		return append(problems, "missing Expires field")
+	}
+	expires, err := time.Parse(time.RFC3339, txt.Expires)
+	switch {
+	case err != nil:
+		problems = append(problems, fmt.Sprintf("invalid Expires %q", txt.Expires))
+	case expires.Before(now):
+		problems = append(problems, "expired on "+expires.Format(time.DateOnly))
+	}
+	return problems
+}
+
+// isValidContact reports whether the contact is a URI RFC 9116 allows.
+func isValidContact(contact string) bool {
+	u, err := url.Parse(contact)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "https":
+		return u.Host != ""
+	case "mailto", "tel":
+		return u.Opaque != ""
+	default:
+		return false
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package responsiveness contains helpers for probes measuring how project
+// members respond to issues and pull requests.
+package responsiveness
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// Thread is an issue or a pull request awaiting a response from the project.
+type Thread struct {
+	CreatedAt time.Time
+	// FirstResponse is the first time a project member commented on,
+	// reviewed or closed the thread. It is zero if nobody responded.
+	FirstResponse time.Time
+}
+
+// IsProjectMember returns whether the association is collaborator or higher.
+func IsProjectMember(association *clients.RepoAssociation) bool {
+	return association != nil && association.Gte(clients.RepoAssociationCollaborator)
+}
+
+// IsSecurityIssue returns whether the issue is labelled as a security issue.
+func IsSecurityIssue(issue *clients.Issue) bool {
+	for _, label := range issue.Labels {
+		name := strings.ToLower(label.Name)
+		if strings.Contains(name, "security") || strings.Contains(name, "vulnerability") {
+			return true
+		}
+	}
+	return false
+}
+
+// FromIssue returns the thread of an issue opened by someone outside the
+// project since the given time, or false if the issue doesn't await a
+// response from the project.
+func FromIssue(issue *clients.Issue, since time.Time) (Thread, bool) {
+	if issue.CreatedAt == nil || issue.CreatedAt.Before(since) ||
+		IsProjectMember(issue.AuthorAssociation) {
+		return Thread{}, false
+	}
+	t := Thread{CreatedAt: *issue.CreatedAt}
+	for _, c := range issue.Comments {
+		if c.CreatedAt != nil && IsProjectMember(c.AuthorAssociation) {
+			t.respondedAt(*c.CreatedAt)
+		}
+	}
+	if issue.ClosedAt != nil {
+		t.respondedAt(*issue.ClosedAt)
+	}
+	return t, true
+}
+
+// FromPullRequest returns the thread of a pull request opened by someone
+// outside the project since the given time, or false if the pull request
+// doesn't await a response from the project. Pull requests opened by bots
+// are skipped.
+func FromPullRequest(pr *clients.PullRequest, since time.Time) (Thread, bool) {
+	if pr.CreatedAt.Before(since) || pr.Author.IsBot || IsProjectMember(pr.AuthorAssociation) {
+		return Thread{}, false
+	}
+	t := Thread{CreatedAt: pr.CreatedAt}
+	for _, c := range pr.Comments {
+		if c.CreatedAt != nil && IsProjectMember(c.AuthorAssociation) {
+			t.respondedAt(*c.CreatedAt)
+		}
+	}
+	for i := range pr.Reviews {
+		r := &pr.Reviews[i]
+		if !r.SubmittedAt.IsZero() && IsProjectMember(r.AuthorAssociation) {
+			t.respondedAt(r.SubmittedAt)
+		}
+	}
+	if !pr.ClosedAt.IsZero() {
+		t.respondedAt(pr.ClosedAt)
+	}
+	return t, true
+}
+
+func (t *Thread) respondedAt(when time.Time) {
+	if t.FirstResponse.IsZero() || when.Before(t.FirstResponse) {
+		t.FirstResponse = when
+	}
+}
+
+// TimeToFirstResponse returns how long the thread waited for a response.
+// For threads still awaiting one, it is the time elapsed since it was opened.
+func (t *Thread) TimeToFirstResponse(now time.Time) time.Duration {
+	end := t.FirstResponse
+	if end.IsZero() {
+		end = now
+	}
+	return max(end.Sub(t.CreatedAt), 0)
+}
+
+// MedianTimeToFirstResponse returns the median time the threads waited for
+// a response. It is zero if there are no threads.
+func MedianTimeToFirstResponse(threads []Thread, now time.Time) time.Duration {
+	if len(threads) == 0 {
+		return 0
+	}
+	durations := make([]time.Duration, 0, len(threads))
+	for i := range threads {
+		durations = append(durations, threads[i].TimeToFirstResponse(now))
+	}
+	slices.Sort(durations)
+	return durations[len(durations)/2]
+}
+
+// LastActivity returns the last time the pull request was opened, commented
+// on or reviewed.
+func LastActivity(pr *clients.PullRequest) time.Time {
+	last := pr.CreatedAt
+	for _, c := range pr.Comments {
+		if c.CreatedAt != nil && c.CreatedAt.After(last) {
+			last = *c.CreatedAt
+		}
+	}
+	for i := range pr.Reviews {
+		if pr.Reviews[i].SubmittedAt.After(last) {
+			last = pr.Reviews[i].SubmittedAt
+		}
+	}
+	return last
+}
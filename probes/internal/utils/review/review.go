@@ -0,0 +1,93 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package review contains helpers for probes analyzing how changesets were reviewed.
+package review
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+// RevisionIDKey is the key of the finding value identifying the changeset.
+const RevisionIDKey = "revisionID"
+
+// Verdict is the outcome of a probe for a changeset.
+type Verdict struct {
+	Values  map[string]string
+	Text    string
+	Outcome finding.Outcome
+}
+
+// Evaluator returns the verdict of a probe for a changeset, or false if the
+// probe doesn't apply to it.
+type Evaluator func(c *checker.Changeset) (Verdict, bool)
+
+// Run returns a finding for each GitHub changeset of the default branch the
+// evaluator applies to, or a single OutcomeNotApplicable finding if there
+// are none.
+func Run(raw *checker.RawResults, fs embed.FS, probe string, eval Evaluator) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	changesets := raw.CodeReviewResults.DefaultBranchChangesets
+	for i := range changesets {
+		c := &changesets[i]
+		if c.ReviewPlatform != checker.ReviewPlatformGitHub {
+			continue
+		}
+		v, ok := eval(c)
+		if !ok {
+			continue
+		}
+		f, err := finding.NewWith(fs, probe, v.Text, nil, v.Outcome)
+		if err != nil {
+			return nil, probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValues(v.Values).WithValue(RevisionIDKey, c.RevisionID)
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNotApplicable(fs, probe, "no applicable changesets reviewed on GitHub", nil)
+		if err != nil {
+			return nil, probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, probe, nil
+}
+
+// Approvals returns the approving reviews submitted on the changeset by
+// someone other than its author. Approvals inferred from the merge of the
+// changeset aren't included.
+func Approvals(c *checker.Changeset) []clients.Review {
+	var approvals []clients.Review
+	for i := range c.Reviews {
+		r := &c.Reviews[i]
+		if r.State != "APPROVED" || r.SubmittedAt.IsZero() || r.Author == nil ||
+			r.Author.Login == c.Author.Login {
+			continue
+		}
+		approvals = append(approvals, *r)
+	}
+	return approvals
+}
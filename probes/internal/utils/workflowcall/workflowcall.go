@@ -0,0 +1,57 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflowcall
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// Text describes the call of the reusable workflow or composite action a
+// finding was made in, to be appended to the finding's message.
+func Text(caller *checker.WorkflowCall) string {
+	if caller == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (called from %s:%d%s)", caller.File.Path, caller.File.Offset, secretsText(caller))
+}
+
+// Finding returns a copy of f located at the call of the reusable workflow or
+// composite action in which callee was found, so the caller's file is reported
+// too. msg describes the finding without its caller.
+// It returns nil if there is no caller.
+func Finding(f *finding.Finding, msg string, callee *checker.File, caller *checker.WorkflowCall) *finding.Finding {
+	if caller == nil {
+		return nil
+	}
+	c := *f
+	if f.Remediation != nil {
+		// Don't share the patches of the callee.
+		r := *f.Remediation
+		c.Remediation = &r
+	}
+	c.Message = fmt.Sprintf("call of %s:%d%s: %s", callee.Path, callee.Offset, secretsText(caller), msg)
+	c.Location = caller.File.Location()
+	return &c
+}
+
+func secretsText(caller *checker.WorkflowCall) string {
+	if caller.InheritSecrets {
+		return ", with the secrets of the caller"
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflowcall
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+func TestText(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		caller *checker.WorkflowCall
+		name   string
+		want   string
+	}{
+		{
+			name: "no caller",
+		},
+		{
+			name: "called workflow",
+			caller: &checker.WorkflowCall{
+				File: checker.File{Path: ".github/workflows/pr.yml", Offset: 8},
+			},
+			want: " (called from .github/workflows/pr.yml:8)",
+		},
+		{
+			name: "called workflow with inherited secrets",
+			caller: &checker.WorkflowCall{
+				File:           checker.File{Path: ".github/workflows/pr.yml", Offset: 8},
+				InheritSecrets: true,
+			},
+			want: " (called from .github/workflows/pr.yml:8, with the secrets of the caller)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Text(tt.caller); got != tt.want {
+				t.Errorf("Text() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFinding(t *testing.T) {
+	t.Parallel()
+	callee := checker.File{Path: ".github/workflows/build.yml", Offset: 12, Snippet: "run: echo"}
+	caller := &checker.WorkflowCall{
+		File: checker.File{
+			Path:    ".github/workflows/pr.yml",
+			Type:    finding.FileTypeSource,
+			Offset:  8,
+			Snippet: "uses: ./.github/workflows/build.yml",
+		},
+		InheritSecrets: true,
+	}
+	f := &finding.Finding{
+		Probe:       "someProbe",
+		Message:     "bad step" + Text(caller),
+		Outcome:     finding.OutcomeTrue,
+		Location:    callee.Location(),
+		Remediation: &finding.Remediation{Text: "fix it"},
+	}
+
+	if got := Finding(f, "bad step", &callee, nil); got != nil {
+		t.Errorf("Finding() = %v, want nil without a caller", got)
+	}
+	got := Finding(f, "bad step", &callee, caller)
+	want := &finding.Finding{
+		Probe:       "someProbe",
+		Message:     "call of .github/workflows/build.yml:12, with the secrets of the caller: bad step",
+		Outcome:     finding.OutcomeTrue,
+		Location:    caller.File.Location(),
+		Remediation: &finding.Remediation{Text: "fix it"},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(finding.Finding{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	f.WithPatch(new(string))
+	if got.Remediation.Patch != nil {
+		t.Errorf("the patch of the callee should not apply to the caller")
+	}
+}
@@ -40,7 +40,6 @@ const (
 	Probe          = "issueActivityByProjectMember"
 	NumIssuesKey   = "numberOfIssuesUpdatedWithinThreshold"
 	LookbackDayKey = "lookBackDays"
-	lookBackDays   = 90
 )
 
 func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
@@ -49,6 +48,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	}
 
 	r := raw.MaintainedResults
+	lookBackDays := r.GetLookBackDays()
 	numberOfIssuesUpdatedWithinThreshold := 0
 
 	// Look for activity in past `lookBackDays`.
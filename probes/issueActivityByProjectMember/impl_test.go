@@ -103,7 +103,7 @@ func Test_Run(t *testing.T) {
 				},
 			},
 			values: map[string]string{
-				LookbackDayKey: strconv.Itoa(lookBackDays),
+				LookbackDayKey: strconv.Itoa(checker.DefaultMaintainedLookBackDays),
 				NumIssuesKey:   "5",
 			},
 			outcomes: []finding.Outcome{finding.OutcomeTrue},
@@ -116,7 +116,7 @@ func Test_Run(t *testing.T) {
 				},
 			},
 			values: map[string]string{
-				LookbackDayKey: strconv.Itoa(lookBackDays),
+				LookbackDayKey: strconv.Itoa(checker.DefaultMaintainedLookBackDays),
 				NumIssuesKey:   "20",
 			},
 			outcomes: []finding.Outcome{finding.OutcomeTrue},
@@ -129,7 +129,7 @@ func Test_Run(t *testing.T) {
 				},
 			},
 			values: map[string]string{
-				LookbackDayKey: strconv.Itoa(lookBackDays),
+				LookbackDayKey: strconv.Itoa(checker.DefaultMaintainedLookBackDays),
 				NumIssuesKey:   "5",
 			},
 			outcomes: []finding.Outcome{finding.OutcomeTrue},
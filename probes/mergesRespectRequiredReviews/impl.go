@@ -0,0 +1,66 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package mergesRespectRequiredReviews
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/review"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.CodeReview})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe             = "mergesRespectRequiredReviews"
+	MergedByKey       = "mergedBy"
+	ReviewDecisionKey = "reviewDecision"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	return review.Run(raw, fs, Probe, evaluate)
+}
+
+func evaluate(c *checker.Changeset) (review.Verdict, bool) {
+	// Reviews aren't required.
+	if c.ReviewDecision == "" {
+		return review.Verdict{}, false
+	}
+	v := review.Verdict{
+		Values: map[string]string{
+			MergedByKey:       c.MergedBy.Login,
+			ReviewDecisionKey: c.ReviewDecision,
+		},
+	}
+	if c.ReviewDecision == "APPROVED" {
+		v.Outcome = finding.OutcomeTrue
+		v.Text = fmt.Sprintf("changeset %s merged with the required approvals", c.RevisionID)
+	} else {
+		v.Outcome = finding.OutcomeFalse
+		v.Text = fmt.Sprintf("changeset %s merged by %s while its review status was %s",
+			c.RevisionID, c.MergedBy.Login, c.ReviewDecision)
+	}
+	return v, true
+}
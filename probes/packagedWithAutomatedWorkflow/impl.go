@@ -49,7 +49,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 		// Presence of a single non-debug message means the
 		// check passes.
 		f, err := finding.NewWith(fs, Probe,
-			"Project packages its releases by way of GitHub Actions.", nil,
+			"Project packages its releases by way of an automated workflow.", nil,
 			finding.OutcomeTrue)
 		if err != nil {
 			return nil, Probe, fmt.Errorf("create finding: %w", err)
@@ -69,7 +69,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	}
 
 	f, err := finding.NewWith(fs, Probe,
-		"no GitHub/GitLab publishing workflow detected.", nil,
+		"no GitHub/GitLab/Azure Pipelines publishing workflow detected.", nil,
 		finding.OutcomeFalse)
 	if err != nil {
 		return nil, Probe, fmt.Errorf("create finding: %w", err)
@@ -26,6 +26,7 @@ import (
 	"github.com/ossf/scorecard/v5/internal/checknames"
 	"github.com/ossf/scorecard/v5/internal/probes"
 	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/workflowcall"
 )
 
 func init() {
@@ -121,14 +122,15 @@ func generateTextIncompleteResults(e checker.ElementError) string {
 }
 
 func generateTextUnpinned(rr *checker.Dependency) string {
+	caller := workflowcall.Text(rr.Caller)
 	if rr.Type == checker.DependencyUseTypeGHAction {
 		// Check if we are dealing with a GitHub action or a third-party one.
 		gitHubOwned := fileparser.IsGitHubOwnedAction(rr.Location.Snippet)
 		owner := generateOwnerToDisplay(gitHubOwned)
-		return fmt.Sprintf("%s not pinned by hash", owner)
+		return fmt.Sprintf("%s not pinned by hash%s", owner, caller)
 	}
 
-	return fmt.Sprintf("%s not pinned by hash", rr.Type)
+	return fmt.Sprintf("%s not pinned by hash%s", rr.Type, caller)
 }
 
 func generateOwnerToDisplay(gitHubOwned bool) string {
@@ -58,7 +58,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 			break
 		}
 
-		if len(release.Assets) == 0 {
+		if len(release.Assets) == 0 && release.TagSignature == "" {
 			continue
 		}
 
@@ -99,6 +99,24 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 			continue
 		}
 
+		if release.TagSignature != "" {
+			// The release's tag is signed, e.g. a signed git tag.
+			loc := &finding.Location{
+				Type: finding.FileTypeURL,
+				Path: release.URL,
+			}
+			f, err := finding.NewWith(fs, Probe,
+				fmt.Sprintf("signed release tag: %s", release.TagName),
+				loc,
+				finding.OutcomeTrue)
+			if err != nil {
+				return nil, Probe, fmt.Errorf("create finding: %w", err)
+			}
+			f = f.WithValue(ReleaseNameKey, release.TagName)
+			findings = append(findings, *f)
+			continue
+		}
+
 		// Release is not signed
 		loc := &finding.Location{
 			Type: finding.FileTypeURL,
@@ -189,6 +189,33 @@ func Test_Run(t *testing.T) {
 				finding.OutcomeTrue,
 			},
 		},
+		{
+			name: "Has a signed release tag.",
+			raw: &checker.RawResults{
+				SignedReleasesResults: checker.SignedReleasesData{
+					Releases: []clients.Release{
+						{
+							TagName:      "v1.0",
+							TagSignature: "-----BEGIN PGP SIGNATURE-----",
+						},
+						{
+							TagName:      "v0.9",
+							TagSignature: "-----BEGIN SSH SIGNATURE-----",
+							Assets: []clients.ReleaseAsset{
+								{Name: "binary.tar.gz"},
+							},
+						},
+						{
+							TagName: "v0.8",
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeTrue,
+			},
+		},
 		{
 			// https://github.com/ossf/scorecard/issues/4059
 			name: "lookback cutoff not skipped if 6th release has no assets",
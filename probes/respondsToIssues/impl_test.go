@@ -0,0 +1,177 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package respondsToIssues
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	member := clients.RepoAssociationMember
+	outsider := clients.RepoAssociationNone
+	daysAgo := func(days int) *time.Time {
+		d := time.Now().AddDate(0, 0, -days)
+		return &d
+	}
+	// issue returns an issue opened by an outsider and answered by a
+	// project member if answeredAfter is positive.
+	issue := func(openedDaysAgo, answeredAfter int) clients.Issue {
+		i := clients.Issue{
+			CreatedAt:         daysAgo(openedDaysAgo),
+			AuthorAssociation: &outsider,
+			Comments: []clients.IssueComment{
+				{CreatedAt: daysAgo(openedDaysAgo - 1), AuthorAssociation: &outsider},
+			},
+		}
+		if answeredAfter > 0 {
+			i.Comments = append(i.Comments, clients.IssueComment{
+				CreatedAt:         daysAgo(openedDaysAgo - answeredAfter),
+				AuthorAssociation: &member,
+			})
+		}
+		return i
+	}
+	closed := func(i clients.Issue, closedDaysAgo int) clients.Issue {
+		i.ClosedAt = daysAgo(closedDaysAgo)
+		return i
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		values   map[string]string
+		err      error
+	}{
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no issues",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "issues opened by members or before the window",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					Issues: []clients.Issue{
+						{CreatedAt: daysAgo(10), AuthorAssociation: &member},
+						issue(100, 0),
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "issues answered quickly",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					Issues: []clients.Issue{issue(30, 2), issue(20, 3), issue(10, 0)},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+			values: map[string]string{
+				NumIssuesKey:                 "3",
+				MedianTimeToFirstResponseKey: strconv.Itoa(3 * 24 * 60 * 60),
+				LookbackDayKey:               "90",
+			},
+		},
+		{
+			name: "issues closed without comments",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					Issues: []clients.Issue{
+						closed(issue(30, 0), 29),
+						closed(issue(20, 0), 18),
+						issue(10, 0),
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+			values: map[string]string{
+				NumIssuesKey:                 "3",
+				MedianTimeToFirstResponseKey: strconv.Itoa(2 * 24 * 60 * 60),
+				LookbackDayKey:               "90",
+			},
+		},
+		{
+			name: "issues left unanswered",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					Issues: []clients.Issue{issue(60, 30), issue(50, 0), issue(5, 1)},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+			},
+		},
+		{
+			name: "unanswered issues before a shorter window",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					Issues:       []clients.Issue{issue(60, 30), issue(50, 0), issue(5, 1)},
+					LookBackDays: 30,
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := Run(tt.raw)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Run() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if s != Probe {
+				t.Errorf("Run() probe = %q, want %q", s, Probe)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			if tt.values != nil {
+				if diff := cmp.Diff(tt.values, findings[0].Values); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
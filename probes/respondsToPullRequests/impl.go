@@ -0,0 +1,90 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package respondsToPullRequests
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/responsiveness"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Maintained})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe                        = "respondsToPullRequests"
+	NumPullRequestsKey           = "numberOfPullRequests"
+	MedianTimeToFirstResponseKey = "medianTimeToFirstResponse"
+	LookbackDayKey               = "lookBackDays"
+
+	maxMedianResponseDays = 14
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.MaintainedResults
+	lookBackDays := r.GetLookBackDays()
+	now := time.Now()
+	since := now.AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
+
+	var threads []responsiveness.Thread
+	for i := range r.PullRequests {
+		if t, ok := responsiveness.FromPullRequest(&r.PullRequests[i], since); ok {
+			threads = append(threads, t)
+		}
+	}
+	if len(threads) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe,
+			fmt.Sprintf("no pull requests opened by users outside the project in the last %d days", lookBackDays), nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	median := responsiveness.MedianTimeToFirstResponse(threads, now)
+	text := fmt.Sprintf("project members respond to pull requests in %s (median)", median.Round(time.Minute))
+	outcome := finding.OutcomeTrue
+	if median > maxMedianResponseDays*24*time.Hour {
+		text = fmt.Sprintf("project members take %s (median) to respond to pull requests", median.Round(time.Minute))
+		outcome = finding.OutcomeFalse
+	}
+	f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValues(map[string]string{
+		NumPullRequestsKey:           strconv.Itoa(len(threads)),
+		MedianTimeToFirstResponseKey: strconv.Itoa(int(median.Seconds())),
+		LookbackDayKey:               strconv.Itoa(lookBackDays),
+	})
+	return []finding.Finding{*f}, Probe, nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package respondsToPullRequests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	member := clients.RepoAssociationMember
+	outsider := clients.RepoAssociationContributor
+	daysAgo := func(days int) time.Time {
+		return time.Now().AddDate(0, 0, -days)
+	}
+	pr := func(openedDaysAgo int) clients.PullRequest {
+		return clients.PullRequest{
+			CreatedAt:         daysAgo(openedDaysAgo),
+			AuthorAssociation: &outsider,
+			Author:            clients.User{Login: "alice"},
+		}
+	}
+	reviewed := func(p clients.PullRequest, days int) clients.PullRequest {
+		p.Reviews = []clients.Review{{
+			State:             "COMMENTED",
+			SubmittedAt:       p.CreatedAt.AddDate(0, 0, days),
+			AuthorAssociation: &member,
+		}}
+		return p
+	}
+	closed := func(p clients.PullRequest, days int) clients.PullRequest {
+		p.ClosedAt = p.CreatedAt.AddDate(0, 0, days)
+		return p
+	}
+	bot := pr(80)
+	bot.Author.IsBot = true
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		values   map[string]string
+		err      error
+	}{
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no pull requests",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "only pull requests opened by bots",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					PullRequests: []clients.PullRequest{bot},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "pull requests reviewed or closed quickly",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					PullRequests: []clients.PullRequest{
+						reviewed(pr(40), 1), closed(pr(30), 2), reviewed(closed(pr(20), 1), 5), bot,
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+			values: map[string]string{
+				NumPullRequestsKey:           "3",
+				MedianTimeToFirstResponseKey: "86400",
+				LookbackDayKey:               "90",
+			},
+		},
+		{
+			name: "pull requests awaiting review",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					PullRequests: []clients.PullRequest{pr(60), pr(30), reviewed(pr(10), 1)},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := Run(tt.raw)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Run() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if s != Probe {
+				t.Errorf("Run() probe = %q, want %q", s, Probe)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			if tt.values != nil {
+				if diff := cmp.Diff(tt.values, findings[0].Values); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package respondsToSecurityIssues
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/responsiveness"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Maintained})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe                  = "respondsToSecurityIssues"
+	NumSecurityIssuesKey   = "securityIssues"
+	NumUnansweredIssuesKey = "unansweredSecurityIssues"
+
+	gracePeriodDays = 7
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	gracePeriod := time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*gracePeriodDays /*days*/)
+	var total, unanswered int
+	issues := raw.MaintainedResults.Issues
+	for i := range issues {
+		if !responsiveness.IsSecurityIssue(&issues[i]) {
+			continue
+		}
+		t, ok := responsiveness.FromIssue(&issues[i], time.Time{})
+		if !ok {
+			continue
+		}
+		if t.FirstResponse.IsZero() && t.CreatedAt.After(gracePeriod) {
+			// Too early to tell.
+			continue
+		}
+		total++
+		if t.FirstResponse.IsZero() {
+			unanswered++
+		}
+	}
+	if total == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no recent security issues opened by users outside the project", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	text := fmt.Sprintf("all %d security issue(s) were answered by project members", total)
+	outcome := finding.OutcomeTrue
+	if unanswered > 0 {
+		text = fmt.Sprintf("%d out of %d security issue(s) were not answered by project members", unanswered, total)
+		outcome = finding.OutcomeFalse
+	}
+	f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValues(map[string]string{
+		NumSecurityIssuesKey:   strconv.Itoa(total),
+		NumUnansweredIssuesKey: strconv.Itoa(unanswered),
+	})
+	return []finding.Finding{*f}, Probe, nil
+}
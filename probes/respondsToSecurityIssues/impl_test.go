@@ -0,0 +1,142 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package respondsToSecurityIssues
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	member := clients.RepoAssociationOwner
+	outsider := clients.RepoAssociationNone
+	daysAgo := func(days int) *time.Time {
+		d := time.Now().AddDate(0, 0, -days)
+		return &d
+	}
+	issue := func(label string, openedDaysAgo int, answered bool) clients.Issue {
+		i := clients.Issue{
+			CreatedAt:         daysAgo(openedDaysAgo),
+			AuthorAssociation: &outsider,
+			Labels:            []clients.Label{{Name: label}},
+		}
+		if answered {
+			i.Comments = []clients.IssueComment{{CreatedAt: daysAgo(openedDaysAgo - 1), AuthorAssociation: &member}}
+		}
+		return i
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		values   map[string]string
+		err      error
+	}{
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no security issues",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					Issues: []clients.Issue{issue("bug", 30, false)},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "unanswered security issue in grace period",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					Issues: []clients.Issue{issue("security", 2, false)},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "answered security issues",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					Issues: []clients.Issue{
+						issue("Security", 30, true),
+						issue("type: vulnerability", 200, true),
+						issue("security", 2, false),
+						issue("bug", 30, false),
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+			values: map[string]string{
+				NumSecurityIssuesKey:   "2",
+				NumUnansweredIssuesKey: "0",
+			},
+		},
+		{
+			name: "unanswered security issues",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					Issues: []clients.Issue{issue("security", 30, true), issue("area/security", 20, false)},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+			},
+			values: map[string]string{
+				NumSecurityIssuesKey:   "2",
+				NumUnansweredIssuesKey: "1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := Run(tt.raw)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Run() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if s != Probe {
+				t.Errorf("Run() probe = %q, want %q", s, Probe)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			if tt.values != nil {
+				if diff := cmp.Diff(tt.values, findings[0].Values); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
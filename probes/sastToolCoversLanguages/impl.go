@@ -0,0 +1,104 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package sastToolCoversLanguages
+
+import (
+	"embed"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SAST})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe       = "sastToolCoversLanguages"
+	LanguageKey = "language"
+	ToolsKey    = "tools"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.SASTResults
+
+	if len(r.Workflows) == 0 || len(r.Languages) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no SAST tool detected or languages of the project unknown", nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	tools := make(map[clients.LanguageName][]string)
+	for i := range r.Workflows {
+		w := &r.Workflows[i]
+		for _, lang := range w.Languages {
+			if !contains(tools[lang], string(w.Type)) {
+				tools[lang] = append(tools[lang], string(w.Type))
+			}
+		}
+	}
+
+	findings := make([]finding.Finding, 0, len(r.Languages))
+	for _, lang := range r.Languages {
+		analyzers := tools[lang]
+		var f *finding.Finding
+		var err error
+		if len(analyzers) == 0 {
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("%s code is not analyzed by a SAST tool", lang), nil, finding.OutcomeFalse)
+		} else {
+			sort.Strings(analyzers)
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("%s code is analyzed by: %s", lang, strings.Join(analyzers, ", ")), nil, finding.OutcomeTrue)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(LanguageKey, string(lang))
+		if len(analyzers) > 0 {
+			f = f.WithValue(ToolsKey, strings.Join(analyzers, ","))
+		}
+		f = f.WithRemediationMetadata(map[string]string{LanguageKey: string(lang)})
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
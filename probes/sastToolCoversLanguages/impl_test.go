@@ -0,0 +1,131 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package sastToolCoversLanguages
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		err      error
+		outcomes []finding.Outcome
+		values   []map[string]string
+	}{
+		{
+			name: "nil raw results",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no SAST tool",
+			raw: &checker.RawResults{
+				SASTResults: checker.SASTData{
+					Languages: []clients.LanguageName{clients.Go},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+			values:   []map[string]string{nil},
+		},
+		{
+			name: "languages unknown",
+			raw: &checker.RawResults{
+				SASTResults: checker.SASTData{
+					Workflows: []checker.SASTWorkflow{
+						{Type: checker.CodeQLWorkflow, Languages: []clients.LanguageName{clients.Go}},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+			values:   []map[string]string{nil},
+		},
+		{
+			name: "one language analyzed by two tools, one not analyzed",
+			raw: &checker.RawResults{
+				SASTResults: checker.SASTData{
+					Languages: []clients.LanguageName{clients.Go, clients.Python},
+					Workflows: []checker.SASTWorkflow{
+						{Type: "gosec", Languages: []clients.LanguageName{clients.Go}},
+						{Type: checker.CodeQLWorkflow, Languages: []clients.LanguageName{clients.Go, clients.Java}},
+						{Type: "gosec", Languages: []clients.LanguageName{clients.Go}},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeTrue, finding.OutcomeFalse},
+			values: []map[string]string{
+				{LanguageKey: "go", ToolsKey: "CodeQL,gosec"},
+				{LanguageKey: "python"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			for i := range findings {
+				if diff := cmp.Diff(tt.values[i], findings[i].Values); diff != "" {
+					t.Errorf("finding %d values mismatch (-want +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_Run_remediation(t *testing.T) {
+	t.Parallel()
+	raw := &checker.RawResults{
+		SASTResults: checker.SASTData{
+			Languages: []clients.LanguageName{clients.Ruby},
+			Workflows: []checker.SASTWorkflow{
+				{Type: "Bandit", Languages: []clients.LanguageName{clients.Python}},
+			},
+		},
+	}
+	findings, _, err := Run(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Remediation == nil {
+		t.Fatalf("expected one finding with a remediation, got %v", findings)
+	}
+	want := "Setup a SAST tool analyzing ruby, e.g. one of the tools we currently detect " +
+		"https://github.com/ossf/scorecard/blob/main/docs/checks/sast/README.md."
+	if findings[0].Remediation.Text != want {
+		t.Errorf("remediation: got %q, want %q", findings[0].Remediation.Text, want)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package sbomHasNTIAMinimumElements
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SBOM})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe      = "sbomHasNTIAMinimumElements"
+	QualityKey = "quality"
+	MissingKey = "missing"
+	FileKey    = "file"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	sboms := raw.SBOMResults.SBOMFiles
+	for i := range sboms {
+		sbom := &sboms[i]
+		if sbom.Document == nil && sbom.ParseError == "" {
+			continue
+		}
+
+		var f *finding.Finding
+		var err error
+		var missing []string
+		switch {
+		case sbom.Document == nil:
+			f, err = finding.NewFalse(fs, Probe,
+				fmt.Sprintf("SBOM %s could not be parsed: %s", sbom.Name, sbom.ParseError), sbom.File.Location())
+		default:
+			missing = missingElements(sbom.Document)
+			if len(missing) == 0 {
+				f, err = finding.NewTrue(fs, Probe,
+					fmt.Sprintf("SBOM %s provides the NTIA minimum elements", sbom.Name), sbom.File.Location())
+			} else {
+				f, err = finding.NewFalse(fs, Probe,
+					fmt.Sprintf("SBOM %s is missing NTIA minimum elements: %s", sbom.Name, strings.Join(missing, ", ")),
+					sbom.File.Location())
+			}
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		if sbom.Document != nil {
+			f = f.WithValue(QualityKey, strconv.Itoa(sbom.Document.Quality))
+		}
+		if len(missing) > 0 {
+			f = f.WithValue(MissingKey, strings.Join(missing, ", "))
+		}
+		f = f.WithRemediationMetadata(map[string]string{
+			FileKey:    sbom.File.Path,
+			MissingKey: strings.Join(missing, ", "),
+		})
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no SBOM parsed", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
+
+// missingElements lists the NTIA minimum elements an SBOM doesn't provide.
+func missingElements(doc *checker.SBOMDocument) []string {
+	var named, versioned, supplied, identified int
+	for i := range doc.Components {
+		c := &doc.Components[i]
+		if c.Name != "" {
+			named++
+		}
+		if c.Version != "" {
+			versioned++
+		}
+		if c.Supplier != "" {
+			supplied++
+		}
+		if len(c.Identifiers) > 0 {
+			identified++
+		}
+	}
+
+	var missing []string
+	n := len(doc.Components)
+	if n == 0 {
+		missing = append(missing, "components")
+	}
+	for _, e := range []struct {
+		name  string
+		count int
+	}{
+		{"supplier", supplied},
+		{"component name", named},
+		{"version", versioned},
+		{"unique identifier", identified},
+	} {
+		if n > 0 && e.count < n {
+			missing = append(missing, fmt.Sprintf("%s (%d of %d components)", e.name, n-e.count, n))
+		}
+	}
+	if doc.Relationships == 0 {
+		missing = append(missing, "dependency relationships")
+	}
+	if len(doc.Authors) == 0 {
+		missing = append(missing, "author")
+	}
+	if doc.Timestamp == "" {
+		missing = append(missing, "timestamp")
+	}
+	return missing
+}
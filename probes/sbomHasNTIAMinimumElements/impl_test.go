@@ -0,0 +1,133 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package sbomHasNTIAMinimumElements
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	complete := &checker.SBOMDocument{
+		Format:    "SPDX",
+		Authors:   []string{"Tool: syft"},
+		Timestamp: "2026-01-01T00:00:00Z",
+		Components: []checker.SBOMComponent{
+			{Name: "app", Version: "1.0.0", Supplier: "Organization: Foo", Identifiers: []string{"pkg:golang/foo/app@1.0.0"}},
+		},
+		Relationships: 1,
+		Quality:       10,
+	}
+	partial := &checker.SBOMDocument{
+		Format: "CycloneDX",
+		Components: []checker.SBOMComponent{
+			{Name: "app", Version: "1.0.0"},
+			{Name: "lib", Version: "2.0.0", Identifiers: []string{"pkg:npm/lib@2.0.0"}},
+		},
+		Quality: 4,
+	}
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		err      error
+		outcomes []finding.Outcome
+		values   []map[string]string
+	}{
+		{
+			name: "nil raw results",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no parsed SBOM",
+			raw: &checker.RawResults{
+				SBOMResults: checker.SBOMData{
+					SBOMFiles: []checker.SBOM{{Name: "sbom.json"}},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+			values:   []map[string]string{nil},
+		},
+		{
+			name: "complete, partial and malformed SBOMs",
+			raw: &checker.RawResults{
+				SBOMResults: checker.SBOMData{
+					SBOMFiles: []checker.SBOM{
+						{Name: "app.spdx.json", Document: complete},
+						{Name: "app.cdx.json", Document: partial},
+						{Name: "bad.spdx", ParseError: "unexpected EOF"},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeTrue, finding.OutcomeFalse, finding.OutcomeFalse},
+			values: []map[string]string{
+				{QualityKey: "10"},
+				{
+					QualityKey: "4",
+					MissingKey: "supplier (2 of 2 components), unique identifier (1 of 2 components), " +
+						"dependency relationships, author, timestamp",
+				},
+				nil,
+			},
+		},
+		{
+			name: "no components",
+			raw: &checker.RawResults{
+				SBOMResults: checker.SBOMData{
+					SBOMFiles: []checker.SBOM{
+						{Name: "empty.cdx.json", Document: &checker.SBOMDocument{
+							Authors:       []string{"syft"},
+							Timestamp:     "2026-01-01T00:00:00Z",
+							Relationships: 1,
+							Quality:       4,
+						}},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeFalse},
+			values:   []map[string]string{{QualityKey: "4", MissingKey: "components"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			for i := range findings {
+				if diff := cmp.Diff(tt.values[i], findings[i].Values); diff != "" {
+					t.Errorf("finding %d values mismatch (-want +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package sbomListsDeclaredDependencies
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SBOM})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe      = "sbomListsDeclaredDependencies"
+	MissingKey = "missing"
+	FileKey    = "file"
+
+	// Maximum number of missing dependencies named in a finding.
+	maxNamed = 5
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.SBOMResults
+	var findings []finding.Finding
+	for i := range r.SBOMFiles {
+		sbom := &r.SBOMFiles[i]
+		if sbom.Document == nil || len(r.DeclaredDependencies) == 0 {
+			continue
+		}
+
+		var f *finding.Finding
+		var err error
+		missing := sbom.Document.MissingDependencies
+		if len(missing) == 0 {
+			f, err = finding.NewTrue(fs, Probe,
+				fmt.Sprintf("SBOM %s lists the %d declared dependencies", sbom.Name, len(r.DeclaredDependencies)),
+				sbom.File.Location())
+		} else {
+			named := missing
+			if len(named) > maxNamed {
+				named = named[:maxNamed]
+			}
+			msg := fmt.Sprintf("SBOM %s does not list %d of %d declared dependencies: %s",
+				sbom.Name, len(missing), len(r.DeclaredDependencies), strings.Join(named, ", "))
+			if len(missing) > maxNamed {
+				msg += fmt.Sprintf(" and %d more", len(missing)-maxNamed)
+			}
+			f, err = finding.NewFalse(fs, Probe, msg, sbom.File.Location())
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(MissingKey, strconv.Itoa(len(missing)))
+		f = f.WithRemediationMetadata(map[string]string{FileKey: sbom.File.Path})
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no SBOM parsed or no declared dependency", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
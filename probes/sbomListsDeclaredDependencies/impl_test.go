@@ -0,0 +1,113 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package sbomListsDeclaredDependencies
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		err      error
+		outcomes []finding.Outcome
+		values   []map[string]string
+		text     []string
+	}{
+		{
+			name: "nil raw results",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no declared dependency",
+			raw: &checker.RawResults{
+				SBOMResults: checker.SBOMData{
+					SBOMFiles: []checker.SBOM{{Name: "app.cdx.json", Document: &checker.SBOMDocument{}}},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+			values:   []map[string]string{nil},
+			text:     []string{"no SBOM parsed or no declared dependency"},
+		},
+		{
+			name: "no parsed SBOM",
+			raw: &checker.RawResults{
+				SBOMResults: checker.SBOMData{
+					SBOMFiles:            []checker.SBOM{{Name: "bad.spdx", ParseError: "unexpected EOF"}},
+					DeclaredDependencies: []string{"foo"},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+			values:   []map[string]string{nil},
+			text:     []string{"no SBOM parsed or no declared dependency"},
+		},
+		{
+			name: "complete and incomplete SBOMs",
+			raw: &checker.RawResults{
+				SBOMResults: checker.SBOMData{
+					SBOMFiles: []checker.SBOM{
+						{Name: "app.spdx.json", Document: &checker.SBOMDocument{}},
+						{Name: "app.cdx.json", Document: &checker.SBOMDocument{
+							MissingDependencies: []string{"a", "b", "c", "d", "e", "f", "g"},
+						}},
+					},
+					DeclaredDependencies: []string{"a", "b", "c", "d", "e", "f", "g"},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeTrue, finding.OutcomeFalse},
+			values:   []map[string]string{{MissingKey: "0"}, {MissingKey: "7"}},
+			text: []string{
+				"SBOM app.spdx.json lists the 7 declared dependencies",
+				"SBOM app.cdx.json does not list 7 of 7 declared dependencies: a, b, c, d, e and 2 more",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			for i := range findings {
+				if diff := cmp.Diff(tt.values[i], findings[i].Values); diff != "" {
+					t.Errorf("finding %d values mismatch (-want +got):\n%s", i, diff)
+				}
+				if diff := cmp.Diff(tt.text[i], findings[i].Message); diff != "" {
+					t.Errorf("finding %d message mismatch (-want +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
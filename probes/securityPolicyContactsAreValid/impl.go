@@ -0,0 +1,153 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package securityPolicyContactsAreValid
+
+import (
+	"embed"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/secpolicy"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SecurityPolicy})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe      = "securityPolicyContactsAreValid"
+	ContactKey = "contact"
+)
+
+// placeholderDomains are reserved for documentation and testing by RFC 2606.
+var placeholderDomains = []string{"example.com", "example.net", "example.org", "example", "test", "invalid", "localhost"}
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+	var findings []finding.Finding
+	policies := raw.SecurityPolicyResults.PolicyFiles
+	for i := range policies {
+		policy := &policies[i]
+		emails := secpolicy.FindSecInfo(policy.Information, checker.SecurityPolicyInformationTypeEmail, true)
+		urls := secpolicy.FindSecInfo(policy.Information, checker.SecurityPolicyInformationTypeLink, true)
+		if len(emails)+len(urls) == 0 {
+			continue
+		}
+
+		var invalid []finding.Finding
+		for j := range emails {
+			if reason := checkEmail(emails[j].InformationValue.Match); reason != "" {
+				f, err := newInvalid(policy, &emails[j], reason)
+				if err != nil {
+					return nil, Probe, err
+				}
+				invalid = append(invalid, *f)
+			}
+		}
+		for j := range urls {
+			if reason := checkURL(urls[j].InformationValue.Match); reason != "" {
+				f, err := newInvalid(policy, &urls[j], reason)
+				if err != nil {
+					return nil, Probe, err
+				}
+				invalid = append(invalid, *f)
+			}
+		}
+
+		if len(invalid) == 0 {
+			f, err := finding.NewTrue(fs, Probe, "links and email addresses are well-formed", policy.File.Location())
+			if err != nil {
+				return nil, Probe, fmt.Errorf("create finding: %w", err)
+			}
+			findings = append(findings, *f)
+		}
+		findings = append(findings, invalid...)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no links or email addresses to analyze", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+func newInvalid(policy *checker.SecurityPolicyFile, info *checker.SecurityPolicyInformation,
+	reason string,
+) (*finding.Finding, error) {
+	contact := info.InformationValue.Match
+	loc := policy.File.Location()
+	loc.LineStart = &info.InformationValue.LineNumber
+	f, err := finding.NewFalse(fs, Probe, fmt.Sprintf("%s: %s", contact, reason), loc)
+	if err != nil {
+		return nil, fmt.Errorf("create finding: %w", err)
+	}
+	return f.WithValue(ContactKey, contact), nil
+}
+
+// checkEmail returns why the email address is not sane, or "" if it is.
+func checkEmail(s string) string {
+	addr, err := mail.ParseAddress(trimPunctuation(s))
+	if err != nil {
+		return "malformed email address"
+	}
+	_, domain, _ := strings.Cut(addr.Address, "@")
+	if isPlaceholder(domain) {
+		return "placeholder email address"
+	}
+	return ""
+}
+
+// checkURL returns why the link is not sane, or "" if it is.
+func checkURL(s string) string {
+	u, err := url.Parse(trimPunctuation(s))
+	if err != nil || u.Hostname() == "" || !strings.Contains(u.Hostname(), ".") {
+		return "malformed link"
+	}
+	if isPlaceholder(u.Hostname()) {
+		return "placeholder link"
+	}
+	return ""
+}
+
+// trimPunctuation removes punctuation that ends a sentence rather than the contact.
+func trimPunctuation(s string) string {
+	return strings.TrimRight(s, ".,:;")
+}
+
+func isPlaceholder(domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	for _, p := range placeholderDomains {
+		if domain == p || strings.HasSuffix(domain, "."+p) {
+			return true
+		}
+	}
+	return false
+}
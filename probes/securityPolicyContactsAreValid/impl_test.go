@@ -0,0 +1,150 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package securityPolicyContactsAreValid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	policy := func(info ...checker.SecurityPolicyInformation) checker.SecurityPolicyData {
+		return checker.SecurityPolicyData{
+			PolicyFiles: []checker.SecurityPolicyFile{
+				{
+					File: checker.File{
+						Path: "SECURITY.md",
+						Type: finding.FileTypeText,
+					},
+					Information: info,
+				},
+			},
+		}
+	}
+	email := func(s string) checker.SecurityPolicyInformation {
+		return checker.SecurityPolicyInformation{
+			InformationType:  checker.SecurityPolicyInformationTypeEmail,
+			InformationValue: checker.SecurityPolicyValueType{Match: s, LineNumber: 1},
+		}
+	}
+	link := func(s string) checker.SecurityPolicyInformation {
+		return checker.SecurityPolicyInformation{
+			InformationType:  checker.SecurityPolicyInformationTypeLink,
+			InformationValue: checker.SecurityPolicyValueType{Match: s, LineNumber: 2},
+		}
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		values   map[string]string
+		err      error
+	}{
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no security policy",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "no contacts",
+			raw: &checker.RawResults{
+				SecurityPolicyResults: policy(),
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "valid contacts",
+			raw: &checker.RawResults{
+				SecurityPolicyResults: policy(
+					email("security@ossf.dev"),
+					link("https://github.com/ossf/scorecard/security/advisories/new."),
+				),
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+		},
+		{
+			name: "placeholder email",
+			raw: &checker.RawResults{
+				SecurityPolicyResults: policy(email("security@example.com")),
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+			},
+			values: map[string]string{
+				ContactKey: "security@example.com",
+			},
+		},
+		{
+			name: "malformed links",
+			raw: &checker.RawResults{
+				SecurityPolicyResults: policy(
+					email("security@ossf.dev"),
+					link("https://"),
+					link("https://localhost:8080/report"),
+					link("http://security.test/report"),
+				),
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+				finding.OutcomeFalse,
+				finding.OutcomeFalse,
+			},
+			values: map[string]string{
+				ContactKey: "https://",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := Run(tt.raw)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Run() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if s != Probe {
+				t.Errorf("Run() probe = %q, want %q", s, Probe)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+			if tt.values != nil {
+				if diff := cmp.Diff(tt.values, findings[0].Values); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -18,9 +18,11 @@ package testsRunInCI
 import (
 	"embed"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/config"
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/internal/checknames"
 	"github.com/ossf/scorecard/v5/internal/probes"
@@ -35,10 +37,42 @@ func init() {
 var fs embed.FS
 
 const (
-	Probe   = "testsRunInCI"
-	success = "success"
+	Probe       = "testsRunInCI"
+	CISystemKey = "ciSystem"
+	success     = "success"
 )
 
+// ciSystems maps the CI systems to the patterns of their status contexts,
+// check run app slugs and target URLs. The patterns are anchored to the
+// prefixes the CI systems use, so that the names of unrelated contexts
+// containing e.g. "drone" or "prow" don't match.
+var ciSystems = []struct {
+	name     string
+	patterns []*regexp.Regexp
+}{
+	{name: "Jenkins", patterns: ciPatterns(`^continuous-integration/jenkins/`, `^jenkins\b`)},
+	{name: "Buildkite", patterns: ciPatterns(`^buildkite(/|$)`, `^https://buildkite\.com/`)},
+	{name: "CircleCI", patterns: ciPatterns(`^ci/circleci\b`, `^circleci(-checks)?$`)},
+	{name: "Travis CI", patterns: ciPatterns(`^continuous-integration/travis-ci/`, `^travis-ci$`, `^travis ci\b`)},
+	{name: "Drone", patterns: ciPatterns(`^continuous-integration/drone/`)},
+	{name: "Woodpecker", patterns: ciPatterns(`^ci/woodpecker/`)},
+	{name: "Tekton", patterns: ciPatterns(`^pipelines-as-code$`, `^pipelines as code ci\b`)},
+	{name: "Prow", patterns: ciPatterns(`^https://prow\.`)},
+	{name: "AppVeyor", patterns: ciPatterns(`^continuous-integration/appveyor/`, `^appveyor$`)},
+	{name: "Semaphore", patterns: ciPatterns(`^ci/semaphoreci/`, `^semaphoreci$`)},
+	{name: "Cirrus CI", patterns: ciPatterns(`^cirrus-ci$`, `^cirrus ci\b`)},
+	{name: "Azure Pipelines", patterns: ciPatterns(`^azure-pipelines$`, `^vstfs:///build/build/`)},
+	{name: "GitHub Actions", patterns: ciPatterns(`^github-actions$`)},
+}
+
+func ciPatterns(patterns ...string) []*regexp.Regexp {
+	res := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		res = append(res, regexp.MustCompile(p))
+	}
+	return res
+}
+
 func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	if raw == nil {
 		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
@@ -48,6 +82,17 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 
 	c := raw.CITestResults
 
+	if c.PullRequestsUnavailable {
+		f, err := finding.NewWith(fs, Probe,
+			"pull requests are not available", nil,
+			finding.OutcomeNotAvailable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+		return findings, Probe, nil
+	}
+
 	if len(c.CIInfo) == 0 {
 		f, err := finding.NewWith(fs, Probe,
 			"no pull requests found", nil,
@@ -62,7 +107,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	for i := range c.CIInfo {
 		r := c.CIInfo[i]
 		// GitHub Statuses.
-		prSuccessStatus, f, err := prHasSuccessStatus(r)
+		prSuccessStatus, f, err := prHasSuccessStatus(r, &c.Config)
 		if err != nil {
 			return nil, Probe, fmt.Errorf("create finding: %w", err)
 		}
@@ -72,7 +117,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 		}
 
 		// GitHub Check Runs.
-		prCheckSuccessful, f, err := prHasSuccessfulCheck(r)
+		prCheckSuccessful, f, err := prHasSuccessfulCheck(r, &c.Config)
 		if err != nil {
 			return nil, Probe, fmt.Errorf("create finding: %w", err)
 		}
@@ -97,12 +142,12 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 // PR has a status marked 'success' and a CI-related context.
 //
 //nolint:unparam
-func prHasSuccessStatus(r checker.RevisionCIInfo) (bool, *finding.Finding, error) {
+func prHasSuccessStatus(r checker.RevisionCIInfo, conf *config.CITests) (bool, *finding.Finding, error) {
 	for _, status := range r.Statuses {
 		if status.State != success {
 			continue
 		}
-		if isTest(status.Context) || isTest(status.TargetURL) {
+		if conf.IsTestContext(status.Context) || isTest(status.Context) || isTest(status.TargetURL) {
 			msg := fmt.Sprintf("CI test found: pr: %s, context: %s", r.HeadSHA,
 				status.Context)
 
@@ -118,6 +163,9 @@ func prHasSuccessStatus(r checker.RevisionCIInfo) (bool, *finding.Finding, error
 				Type: finding.FileTypeURL,
 			}
 			f = f.WithLocation(loc)
+			if system := ciSystem(status.Context, status.TargetURL); system != "" {
+				f = f.WithValue(CISystemKey, system)
+			}
 			return true, f, nil
 		}
 	}
@@ -127,7 +175,7 @@ func prHasSuccessStatus(r checker.RevisionCIInfo) (bool, *finding.Finding, error
 // PR has a successful CI-related check.
 //
 //nolint:unparam
-func prHasSuccessfulCheck(r checker.RevisionCIInfo) (bool, *finding.Finding, error) {
+func prHasSuccessfulCheck(r checker.RevisionCIInfo, conf *config.CITests) (bool, *finding.Finding, error) {
 	for _, cr := range r.CheckRuns {
 		if cr.Status != "completed" {
 			continue
@@ -135,7 +183,7 @@ func prHasSuccessfulCheck(r checker.RevisionCIInfo) (bool, *finding.Finding, err
 		if cr.Conclusion != success {
 			continue
 		}
-		if isTest(cr.App.Slug) {
+		if conf.IsTestContext(cr.App.Slug) || isTest(cr.App.Slug) {
 			msg := fmt.Sprintf("CI test found: pr: %d, context: %s", r.PullRequestNumber,
 				cr.App.Slug)
 
@@ -151,6 +199,9 @@ func prHasSuccessfulCheck(r checker.RevisionCIInfo) (bool, *finding.Finding, err
 				Type: finding.FileTypeURL,
 			}
 			f = f.WithLocation(loc)
+			if system := ciSystem(cr.App.Slug); system != "" {
+				f = f.WithValue(CISystemKey, system)
+			}
 			return true, f, nil
 		}
 	}
@@ -159,6 +210,9 @@ func prHasSuccessfulCheck(r checker.RevisionCIInfo) (bool, *finding.Finding, err
 
 // isTest returns true if the given string is a CI test.
 func isTest(s string) bool {
+	if ciSystem(s) != "" {
+		return true
+	}
 	l := strings.ToLower(s)
 
 	// Add more patterns here!
@@ -174,3 +228,19 @@ func isTest(s string) bool {
 	}
 	return false
 }
+
+// ciSystem returns the name of the CI system of the first of the given
+// contexts, slugs or URLs which matches one, or "" if none does.
+func ciSystem(ss ...string) string {
+	for _, s := range ss {
+		l := strings.ToLower(s)
+		for _, system := range ciSystems {
+			for _, pattern := range system.patterns {
+				if pattern.MatchString(l) {
+					return system.name
+				}
+			}
+		}
+	}
+	return ""
+}
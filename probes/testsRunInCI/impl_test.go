@@ -23,6 +23,7 @@ import (
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/config"
 	"github.com/ossf/scorecard/v5/finding"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
@@ -114,6 +115,78 @@ func Test_Run(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Status of a known CI system.",
+			raw: &checker.RawResults{
+				CITestResults: checker.CITestData{
+					CIInfo: []checker.RevisionCIInfo{
+						{
+							HeadSHA:           "HeadSHA",
+							PullRequestNumber: 1,
+							Statuses: []clients.Status{
+								{
+									State:     "success",
+									Context:   "buildkite/pipeline",
+									TargetURL: "https://buildkite.com/org/pipeline/builds/1",
+								},
+							},
+						},
+					},
+				},
+			},
+			findings: []*finding.Finding{
+				{
+					Outcome:  finding.OutcomeTrue,
+					Probe:    Probe,
+					Message:  "CI test found: pr: HeadSHA, context: buildkite/pipeline",
+					Location: &finding.Location{Type: 4},
+					Values:   map[string]string{CISystemKey: "Buildkite"},
+				},
+			},
+		},
+		{
+			name: "Status context configured as a test.",
+			raw: &checker.RawResults{
+				CITestResults: checker.CITestData{
+					Config: config.CITests{TestContexts: []string{"internal/*"}},
+					CIInfo: []checker.RevisionCIInfo{
+						{
+							HeadSHA:           "HeadSHA",
+							PullRequestNumber: 1,
+							Statuses: []clients.Status{
+								{
+									State:   "success",
+									Context: "internal/verify",
+								},
+							},
+						},
+					},
+				},
+			},
+			findings: []*finding.Finding{
+				{
+					Outcome:  finding.OutcomeTrue,
+					Probe:    Probe,
+					Message:  "CI test found: pr: HeadSHA, context: internal/verify",
+					Location: &finding.Location{Type: 4},
+				},
+			},
+		},
+		{
+			name: "Pull requests are not available.",
+			raw: &checker.RawResults{
+				CITestResults: checker.CITestData{
+					PullRequestsUnavailable: true,
+				},
+			},
+			findings: []*finding.Finding{
+				{
+					Outcome: finding.OutcomeNotAvailable,
+					Probe:   Probe,
+					Message: "pull requests are not available",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -237,6 +310,34 @@ func Test_isTest(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "buildkite",
+			args: args{
+				s: "buildkite/scorecard",
+			},
+			want: true,
+		},
+		{
+			name: "drone",
+			args: args{
+				s: "continuous-integration/drone/pr",
+			},
+			want: true,
+		},
+		{
+			name: "tekton",
+			args: args{
+				s: "pipelines-as-code",
+			},
+			want: true,
+		},
+		{
+			name: "prow",
+			args: args{
+				s: "https://prow.k8s.io/view/gs/kubernetes-jenkins/pr-logs/pull/1",
+			},
+			want: true,
+		},
 		{
 			name: "woodpecker",
 			args: args{
@@ -255,6 +356,35 @@ func Test_isTest(t *testing.T) {
 	}
 }
 
+func Test_ciSystem(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "continuous-integration/jenkins/pr-merge", want: "Jenkins"},
+		{s: "continuous-integration/travis-ci/pr", want: "Travis CI"},
+		{s: "travis-ci", want: "Travis CI"},
+		{s: "continuous-integration/drone/pr", want: "Drone"},
+		{s: "ci/woodpecker/pr/test-release", want: "Woodpecker"},
+		{s: "pipelines-as-code", want: "Tekton"},
+		{s: "https://prow.k8s.io/view/gs/kubernetes-jenkins/pr-logs/pull/1", want: "Prow"},
+		{s: "azure-pipelines", want: "Azure Pipelines"},
+		{s: "Cirrus CI", want: "Cirrus CI"},
+		{s: "drone-deploy", want: ""},
+		{s: "approve-drone-release", want: ""},
+		{s: "prowler-scan", want: ""},
+		{s: "tekton-catalog-sync", want: ""},
+		{s: "travis-migration-bot", want: ""},
+		{s: "https://example.com/jenkins-docs", want: ""},
+	}
+	for _, tt := range tests {
+		if got := ciSystem(tt.s); got != tt.want {
+			t.Errorf("ciSystem(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
 func Test_prHasSuccessfulCheck(t *testing.T) {
 	t.Parallel()
 
@@ -318,7 +448,7 @@ func Test_prHasSuccessfulCheck(t *testing.T) {
 	}
 	for _, tt := range tests {
 		//nolint:errcheck
-		got, _, _ := prHasSuccessfulCheck(tt.args)
+		got, _, _ := prHasSuccessfulCheck(tt.args, &config.CITests{})
 		if got != tt.want {
 			t.Errorf("prHasSuccessfulCheck() = %v, want %v", got, tt.want)
 		}
@@ -383,7 +513,7 @@ func Test_prHasSuccessStatus(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got, _, err := prHasSuccessStatus(tt.args.r)
+			got, _, err := prHasSuccessStatus(tt.args.r, &config.CITests{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("prHasSuccessStatus() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -474,7 +604,7 @@ func Test_prHasSuccessfulCheckAdditional(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got, _, err := prHasSuccessfulCheck(tt.args.r)
+			got, _, err := prHasSuccessfulCheck(tt.args.r, &config.CITests{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("prHasSuccessfulCheck() error = %v, wantErr %v", err, tt.wantErr)
 				return
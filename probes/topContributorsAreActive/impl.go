@@ -0,0 +1,84 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package topContributorsAreActive
+
+import (
+	"embed"
+	"fmt"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Contributors})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe         = "topContributorsAreActive"
+	LoginKey      = "login"
+	LastChangeKey = "lastChange"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	activity := raw.ContributorsResults.Activity
+	var text string
+	var outcome finding.Outcome
+	switch {
+	case activity == nil:
+		text = "commit history is not available"
+		outcome = finding.OutcomeNotAvailable
+	case activity.Changes == 0:
+		text = "no recent changes from human authors"
+		outcome = finding.OutcomeNotApplicable
+	case len(activity.DepartedContributors) == 0:
+		text = "all top contributors are still active"
+		outcome = finding.OutcomeTrue
+	}
+	if text != "" {
+		f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	findings := make([]finding.Finding, 0, len(activity.DepartedContributors))
+	for _, departed := range activity.DepartedContributors {
+		f, err := finding.NewWith(fs, Probe,
+			fmt.Sprintf("top contributor %s has not made a change since %s",
+				departed.Login, departed.LastChange.Format(time.DateOnly)),
+			nil, finding.OutcomeFalse)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(LoginKey, departed.Login).
+			WithValue(LastChangeKey, departed.LastChange.Format(time.RFC3339))
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -32,6 +32,12 @@ var (
 	//nolint:lll
 	workflowMarkdown  = "update your workflow using [https://app.stepsecurity.io](https://app.stepsecurity.io/secureworkflow/%s/%s/%s?enable=%s)"
 	dockerfilePinText = "pin your Docker image by updating %[1]s to %[1]s@%s"
+	//nolint:lll
+	helmChartLockText = "lock the dependencies of the chart by running `helm dependency update %[1]s` and committing %[1]s/Chart.lock"
+	//nolint:lll
+	terraformLockText          = "lock the providers of the module by running `terraform providers lock` in %[1]s and committing %[1]s/.terraform.lock.hcl"
+	terraformModuleGitText     = "pin the module by updating %s to a commit SHA with `?ref=<sha>`"
+	terraformModuleVersionText = "pin the module by setting the version of %s to an exact version"
 )
 
 // TODO fix how this info makes it checks/evaluation.
@@ -120,3 +126,39 @@ func CreateDockerfilePinningRemediation(dep *checker.Dependency, digester Digest
 		Markdown: markdown,
 	}
 }
+
+// CreateHelmChartPinningRemediation create remediation for locking the dependencies of a Helm chart.
+func CreateHelmChartPinningRemediation(dir string) *finding.Remediation {
+	return textRemediation(fmt.Sprintf(helmChartLockText, dirOrDot(dir)))
+}
+
+// CreateTerraformProviderPinningRemediation create remediation for locking the providers of a Terraform module.
+func CreateTerraformProviderPinningRemediation(dir string) *finding.Remediation {
+	return textRemediation(fmt.Sprintf(terraformLockText, dirOrDot(dir)))
+}
+
+// CreateTerraformModulePinningRemediation create remediation for pinning a Terraform module,
+// from a registry or from another repository.
+func CreateTerraformModulePinningRemediation(dep *checker.Dependency, registry bool) *finding.Remediation {
+	if dep.Name == nil || *dep.Name == "" {
+		return nil
+	}
+	if registry {
+		return textRemediation(fmt.Sprintf(terraformModuleVersionText, *dep.Name))
+	}
+	return textRemediation(fmt.Sprintf(terraformModuleGitText, *dep.Name))
+}
+
+func textRemediation(text string) *finding.Remediation {
+	return &finding.Remediation{
+		Text:     text,
+		Markdown: text,
+	}
+}
+
+func dirOrDot(dir string) string {
+	if dir == "" {
+		return "."
+	}
+	return dir
+}
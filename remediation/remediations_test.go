@@ -188,3 +188,47 @@ func TestCreateWorkflowPinningRemediation(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateIaCPinningRemediations(t *testing.T) {
+	t.Parallel()
+	module := "terraform-aws-modules/vpc/aws"
+	tests := []struct {
+		name     string
+		got      *finding.Remediation
+		expected string
+	}{
+		{
+			name:     "helm chart at the root",
+			got:      CreateHelmChartPinningRemediation(""),
+			expected: "lock the dependencies of the chart by running `helm dependency update .` and committing ./Chart.lock",
+		},
+		{
+			name: "terraform providers",
+			got:  CreateTerraformProviderPinningRemediation("infra"),
+			expected: "lock the providers of the module by running `terraform providers lock` in infra " +
+				"and committing infra/.terraform.lock.hcl",
+		},
+		{
+			name:     "terraform registry module",
+			got:      CreateTerraformModulePinningRemediation(&checker.Dependency{Name: &module}, true),
+			expected: "pin the module by setting the version of terraform-aws-modules/vpc/aws to an exact version",
+		},
+		{
+			name:     "terraform git module",
+			got:      CreateTerraformModulePinningRemediation(&checker.Dependency{Name: &module}, false),
+			expected: "pin the module by updating terraform-aws-modules/vpc/aws to a commit SHA with `?ref=<sha>`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			want := &finding.Remediation{Text: tt.expected, Markdown: tt.expected}
+			if !cmp.Equal(tt.got, want) {
+				t.Error(cmp.Diff(tt.got, want))
+			}
+		})
+	}
+	if CreateTerraformModulePinningRemediation(&checker.Dependency{}, true) != nil {
+		t.Error("expected no remediation for a module without name")
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scoring defines user-configured check weights and custom checks
+// composed from probes.
+//
+// A scoring file looks like:
+//
+//	version: 1
+//	weights:
+//	  Maintained: 0
+//	  Release-Integrity: 10
+//	checks:
+//	  Release-Integrity:
+//	    short: Determines if releases are signed, attested and ship an SBOM.
+//	    risk: High
+//	    probes:
+//	      - releasesAreSigned
+//	      - releasesHaveProvenance
+//	      - name: hasReleaseSBOM
+//	        weight: 0.5
+//	      - name: hasUnverifiedBinaryArtifacts
+//	        pass: false
+//
+// Weights override the weight a check contributes to the aggregate score,
+// which otherwise derives from the check's risk: 10 for Critical, 7.5 for High,
+// 5 for Medium and 2.5 for Low. A zero weight excludes the check from the
+// aggregate score.
+//
+// A probe passes a custom check with its true outcome unless it sets pass to
+// false, for probes whose true outcome is a risk, e.g. hasUnverifiedBinaryArtifacts.
+// Probes weigh 1 unless they set a weight. See [Evaluate] for how custom checks
+// are scored.
+package scoring
+
+import (
+	docChecks "github.com/ossf/scorecard/v5/docs/checks"
+)
+
+// Doc returns the documentation of the built-in checks in base extended with
+// the custom checks and weights of the configuration.
+func (c *Config) Doc(base docChecks.Doc) docChecks.Doc {
+	if c == nil {
+		return base
+	}
+	return &doc{base: base, config: c}
+}
+
+type doc struct {
+	base   docChecks.Doc
+	config *Config
+}
+
+func (d *doc) GetCheck(name string) (docChecks.CheckDoc, error) {
+	var cd docChecks.CheckDoc
+	if check, ok := d.config.Checks[name]; ok {
+		cd = &checkDoc{name: name, check: check}
+	} else {
+		var err error
+		cd, err = d.base.GetCheck(name)
+		if err != nil {
+			//nolint:wrapcheck
+			return nil, err
+		}
+	}
+	if w, ok := d.config.Weights[name]; ok {
+		return &weightedCheckDoc{CheckDoc: cd, weight: w}, nil
+	}
+	return cd, nil
+}
+
+func (d *doc) GetChecks() []docChecks.CheckDoc {
+	checks := d.base.GetChecks()
+	for name := range d.config.Checks {
+		//nolint:errcheck
+		check, _ := d.GetCheck(name)
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+func (d *doc) CheckExists(name string) bool {
+	_, ok := d.config.Checks[name]
+	return ok || d.base.CheckExists(name)
+}
+
+// checkDoc documents a custom check.
+type checkDoc struct {
+	name  string
+	check Check
+}
+
+// GetName returns the name of the check.
+func (c *checkDoc) GetName() string {
+	return c.name
+}
+
+// GetRisk returns the risk of the check.
+func (c *checkDoc) GetRisk() string {
+	return c.check.Risk
+}
+
+// GetShort returns the short description of the check.
+func (c *checkDoc) GetShort() string {
+	return c.check.Short
+}
+
+// GetDescription returns the full description of the check, or its short
+// description if it has none.
+func (c *checkDoc) GetDescription() string {
+	if c.check.Description == "" {
+		return c.check.Short
+	}
+	return c.check.Description
+}
+
+// GetRemediation returns the remediation of the check.
+func (c *checkDoc) GetRemediation() []string {
+	return c.check.Remediation
+}
+
+// GetTags returns the list of tags of the check.
+func (c *checkDoc) GetTags() []string {
+	return []string{"custom"}
+}
+
+// GetSupportedRepoTypes returns the list of repo types the check supports.
+// Custom checks only read probe findings, so they run wherever their probes do.
+func (c *checkDoc) GetSupportedRepoTypes() []string {
+	return []string{"GitHub", "GitLab", "local"}
+}
+
+// GetDocumentationURL returns the URL for the documentation of the check.
+func (c *checkDoc) GetDocumentationURL(string) string {
+	return c.check.URL
+}
+
+// weightedCheckDoc overrides the weight of a check in the aggregate score.
+type weightedCheckDoc struct {
+	docChecks.CheckDoc
+	weight float64
+}
+
+func (w *weightedCheckDoc) GetWeight() float64 {
+	return w.weight
+}
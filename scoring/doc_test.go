@@ -0,0 +1,80 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scoring
+
+import (
+	"testing"
+
+	docChecks "github.com/ossf/scorecard/v5/docs/checks"
+)
+
+func TestConfig_Doc(t *testing.T) {
+	t.Parallel()
+	base, err := docChecks.Read()
+	if err != nil {
+		t.Fatalf("docChecks.Read: %v", err)
+	}
+	c := &Config{
+		Weights: map[string]float64{"Maintained": 0, "Release-Integrity": 10},
+		Checks: map[string]Check{
+			"Release-Integrity": {Short: "short", Risk: "High", URL: "https://example.com"},
+		},
+	}
+	d := c.Doc(base)
+
+	custom, err := d.GetCheck("Release-Integrity")
+	if err != nil {
+		t.Fatalf("GetCheck(Release-Integrity): %v", err)
+	}
+	if custom.GetName() != "Release-Integrity" || custom.GetRisk() != "High" ||
+		custom.GetDescription() != "short" || custom.GetDocumentationURL("main") != "https://example.com" {
+		t.Errorf("unexpected custom check doc: %+v", custom)
+	}
+	if wd, ok := custom.(docChecks.WeightedCheckDoc); !ok || wd.GetWeight() != 10 {
+		t.Errorf("custom check weight not overridden")
+	}
+
+	maintained, err := d.GetCheck("Maintained")
+	if err != nil {
+		t.Fatalf("GetCheck(Maintained): %v", err)
+	}
+	if wd, ok := maintained.(docChecks.WeightedCheckDoc); !ok || wd.GetWeight() != 0 {
+		t.Errorf("Maintained weight not overridden")
+	}
+	if maintained.GetRisk() != "High" {
+		t.Errorf("Maintained risk = %q, want High", maintained.GetRisk())
+	}
+
+	fuzzing, err := d.GetCheck("Fuzzing")
+	if err != nil {
+		t.Fatalf("GetCheck(Fuzzing): %v", err)
+	}
+	if _, ok := fuzzing.(docChecks.WeightedCheckDoc); ok {
+		t.Errorf("Fuzzing weight unexpectedly overridden")
+	}
+
+	if _, err := d.GetCheck("Foo"); err == nil {
+		t.Errorf("GetCheck(Foo) succeeded for an unknown check")
+	}
+	if !d.CheckExists("Release-Integrity") || !d.CheckExists("Maintained") || d.CheckExists("Foo") {
+		t.Errorf("unexpected CheckExists result")
+	}
+	if got, want := len(d.GetChecks()), len(base.GetChecks())+1; got != want {
+		t.Errorf("GetChecks() returned %d checks, want %d", got, want)
+	}
+	if (*Config)(nil).Doc(base) != base {
+		t.Errorf("nil config changed the documentation")
+	}
+}
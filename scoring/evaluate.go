@@ -0,0 +1,92 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scoring
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ossf/scorecard/v5/checker"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// Evaluate scores the custom check name from the findings of its probes.
+//
+// Each probe scores the fraction of its findings with its passing outcome, see
+// [Probe.Pass], among those with a true or false outcome. Probes with neither, e.g. because they
+// don't apply to the project, are ignored. The check's score is the weighted
+// average of the probe scores scaled to [checker.MaxResultScore], rounded down.
+// The result is inconclusive if all probes are ignored or weigh zero, and a
+// runtime error if any probe failed.
+func Evaluate(name string, check *Check, findings []finding.Finding, dl checker.DetailLogger) checker.CheckResult {
+	type tally struct {
+		passed, total int
+		pass          finding.Outcome
+	}
+	tallies := make(map[string]*tally, len(check.Probes))
+	for _, p := range check.Probes {
+		t := &tally{pass: finding.OutcomeTrue}
+		if !p.Pass {
+			t.pass = finding.OutcomeFalse
+		}
+		tallies[p.Name] = t
+	}
+
+	for i := range findings {
+		f := &findings[i]
+		t, ok := tallies[f.Probe]
+		if !ok {
+			continue
+		}
+		var logLevel checker.DetailType
+		switch f.Outcome {
+		case t.pass:
+			t.passed++
+			t.total++
+			logLevel = checker.DetailInfo
+		case finding.OutcomeTrue, finding.OutcomeFalse:
+			t.total++
+			logLevel = checker.DetailWarn
+		case finding.OutcomeError:
+			e := sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("probe %s: %s", f.Probe, f.Message))
+			return checker.CreateRuntimeErrorResult(name, e)
+		default:
+			continue
+		}
+		checker.LogFinding(dl, f, logLevel)
+	}
+
+	var score, weight float64
+	passed, applicable := 0, 0
+	for _, p := range check.Probes {
+		t := tallies[p.Name]
+		if t.total == 0 {
+			continue
+		}
+		applicable++
+		if t.passed == t.total {
+			passed++
+		}
+		score += p.Weight * float64(t.passed) / float64(t.total)
+		weight += p.Weight
+	}
+	if weight == 0 {
+		return checker.CreateInconclusiveResult(name, "no applicable probe findings")
+	}
+
+	reason := fmt.Sprintf("%d of %d applicable probes passed", passed, applicable)
+	return checker.CreateResultWithScore(name, reason, int(math.Floor(checker.MaxResultScore*score/weight)))
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scoring
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	check := &Check{
+		Risk: "High",
+		Probes: []Probe{
+			{Name: "signed", Weight: 1, Pass: true},
+			{Name: "provenance", Weight: 1, Pass: true},
+			{Name: "sbom", Weight: 0.5, Pass: true},
+			{Name: "binaries", Weight: 1},
+		},
+	}
+	tests := []struct {
+		wantErr     error
+		name        string
+		findings    []finding.Finding
+		wantScore   int
+		wantDetails int
+	}{
+		{
+			name: "all probes pass",
+			findings: []finding.Finding{
+				{Probe: "signed", Outcome: finding.OutcomeTrue},
+				{Probe: "provenance", Outcome: finding.OutcomeTrue},
+				{Probe: "sbom", Outcome: finding.OutcomeTrue},
+			},
+			wantScore:   checker.MaxResultScore,
+			wantDetails: 3,
+		},
+		{
+			name: "probes are weighted",
+			findings: []finding.Finding{
+				{Probe: "signed", Outcome: finding.OutcomeTrue},
+				{Probe: "provenance", Outcome: finding.OutcomeFalse},
+				{Probe: "sbom", Outcome: finding.OutcomeTrue},
+			},
+			// (1 + 0 + 0.5) / 2.5
+			wantScore:   6,
+			wantDetails: 3,
+		},
+		{
+			name: "probe score is the fraction of true outcomes",
+			findings: []finding.Finding{
+				{Probe: "signed", Outcome: finding.OutcomeTrue},
+				{Probe: "signed", Outcome: finding.OutcomeFalse},
+				{Probe: "provenance", Outcome: finding.OutcomeTrue},
+				{Probe: "sbom", Outcome: finding.OutcomeTrue},
+			},
+			// (0.5 + 1 + 0.5) / 2.5
+			wantScore:   8,
+			wantDetails: 4,
+		},
+		{
+			name: "inapplicable and unrelated probes are ignored",
+			findings: []finding.Finding{
+				{Probe: "signed", Outcome: finding.OutcomeFalse},
+				{Probe: "provenance", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "other", Outcome: finding.OutcomeTrue},
+			},
+			wantScore:   checker.MinResultScore,
+			wantDetails: 1,
+		},
+		{
+			name: "probe passes with a false outcome",
+			findings: []finding.Finding{
+				{Probe: "signed", Outcome: finding.OutcomeTrue},
+				{Probe: "binaries", Outcome: finding.OutcomeFalse},
+			},
+			wantScore:   checker.MaxResultScore,
+			wantDetails: 2,
+		},
+		{
+			name: "probe fails with a true outcome",
+			findings: []finding.Finding{
+				{Probe: "signed", Outcome: finding.OutcomeTrue},
+				{Probe: "binaries", Outcome: finding.OutcomeTrue},
+			},
+			// (1 + 0) / 2
+			wantScore:   5,
+			wantDetails: 2,
+		},
+		{
+			name: "no applicable findings",
+			findings: []finding.Finding{
+				{Probe: "signed", Outcome: finding.OutcomeNotAvailable},
+			},
+			wantScore: checker.InconclusiveResultScore,
+		},
+		{
+			name: "probe error",
+			findings: []finding.Finding{
+				{Probe: "signed", Outcome: finding.OutcomeTrue},
+				{Probe: "sbom", Outcome: finding.OutcomeError, Message: "oops"},
+			},
+			wantScore: checker.InconclusiveResultScore,
+			wantErr:   sce.ErrScorecardInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := checker.NewLogger()
+			got := Evaluate("Release-Integrity", check, tt.findings, dl)
+			if got.Score != tt.wantScore {
+				t.Errorf("Evaluate() score = %d, want %d", got.Score, tt.wantScore)
+			}
+			if !errors.Is(got.Error, tt.wantErr) {
+				t.Errorf("Evaluate() error = %v, want %v", got.Error, tt.wantErr)
+			}
+			if details := dl.Flush(); tt.wantErr == nil && len(details) != tt.wantDetails {
+				t.Errorf("Evaluate() logged %d details, want %d", len(details), tt.wantDetails)
+			}
+		})
+	}
+}
+
+func TestEvaluate_zeroWeight(t *testing.T) {
+	t.Parallel()
+	check := &Check{Probes: []Probe{{Name: "signed", Weight: 0, Pass: true}}}
+	findings := []finding.Finding{{Probe: "signed", Outcome: finding.OutcomeTrue}}
+	got := Evaluate("Release-Integrity", check, findings, checker.NewLogger())
+	if got.Score != checker.InconclusiveResultScore {
+		t.Errorf("Evaluate() score = %d, want %d", got.Score, checker.InconclusiveResultScore)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scoring
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+	proberegistration "github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/policy"
+)
+
+var (
+	errInvalidVersion = errors.New("invalid version")
+	errInvalidCheck   = errors.New("invalid check name")
+	errInvalidWeight  = errors.New("invalid weight")
+	errInvalidRisk    = errors.New("invalid risk")
+	errInvalidProbe   = errors.New("invalid probe")
+	errNoProbes       = errors.New("check has no probes")
+	errRepeatingProbe = errors.New("probe has multiple definitions")
+)
+
+var allowedVersions = map[int]bool{1: true}
+
+var risks = map[string]bool{"Critical": true, "High": true, "Medium": true, "Low": true}
+
+// Config is a scoring configuration.
+type Config struct {
+	// Weights overrides the weight of checks in the aggregate score.
+	Weights map[string]float64 `yaml:"weights"`
+	// Checks are the custom checks, keyed by name.
+	Checks  map[string]Check `yaml:"checks"`
+	Version int              `yaml:"version"`
+}
+
+// Check is a custom check composed from probes.
+type Check struct {
+	Short       string   `yaml:"short"`
+	Description string   `yaml:"description"`
+	Risk        string   `yaml:"risk"`
+	URL         string   `yaml:"url"`
+	Remediation []string `yaml:"remediation"`
+	Probes      []Probe  `yaml:"probes"`
+}
+
+// Probe is a probe contributing to a custom check.
+type Probe struct {
+	Name string `yaml:"name"`
+	// Weight is the weight of the probe in the check's score. Defaults to 1.
+	Weight float64 `yaml:"weight"`
+	// Pass is the outcome of the probe's findings which passes the check.
+	// Defaults to true.
+	Pass bool `yaml:"pass"`
+}
+
+// UnmarshalYAML allows a probe to be given by name only.
+func (p *Probe) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		p.Name = value.Value
+		p.Weight = 1
+		p.Pass = true
+		return nil
+	}
+	type probe Probe
+	pr := probe{Weight: 1, Pass: true}
+	if err := value.Decode(&pr); err != nil {
+		return fmt.Errorf("decoding probe: %w", err)
+	}
+	*p = Probe(pr)
+	return nil
+}
+
+// ParseFromFile takes a scoring file and returns a `Config`.
+func ParseFromFile(scoringFile string) (*Config, error) {
+	if scoringFile == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(scoringFile)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal,
+			fmt.Sprintf("os.ReadFile: %v", err))
+	}
+
+	c, err := parseFromYAML(data)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("scoring.parseFromYAML: %v", err))
+	}
+	return c, nil
+}
+
+// parseFromYAML parses and validates a scoring file.
+func parseFromYAML(b []byte) (*Config, error) {
+	var c Config
+	if err := yaml.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("yaml.Unmarshal: %w", err)
+	}
+
+	if !allowedVersions[c.Version] {
+		return nil, fmt.Errorf("%w: %d", errInvalidVersion, c.Version)
+	}
+
+	builtin := checks.GetAllWithExperimental()
+	for name, check := range c.Checks {
+		if _, exists := builtin[name]; exists || name == "" {
+			return nil, fmt.Errorf("%w: %q conflicts with a built-in check", errInvalidCheck, name)
+		}
+		if err := validateCheck(name, &check); err != nil {
+			return nil, err
+		}
+	}
+
+	for name, w := range c.Weights {
+		_, isBuiltin := builtin[name]
+		_, isCustom := c.Checks[name]
+		if !isBuiltin && !isCustom {
+			return nil, fmt.Errorf("%w: %s", errInvalidCheck, name)
+		}
+		if !validWeight(w) {
+			return nil, fmt.Errorf("%w: %s: %v", errInvalidWeight, name, w)
+		}
+	}
+	return &c, nil
+}
+
+func validateCheck(name string, check *Check) error {
+	if !risks[check.Risk] {
+		return fmt.Errorf("%w: %s: %q", errInvalidRisk, name, check.Risk)
+	}
+	if len(check.Probes) == 0 {
+		return fmt.Errorf("%w: %s", errNoProbes, name)
+	}
+	seen := make(map[string]bool, len(check.Probes))
+	for _, p := range check.Probes {
+		if _, err := proberegistration.Get(p.Name); err != nil {
+			return fmt.Errorf("%w: %s: %q", errInvalidProbe, name, p.Name)
+		}
+		if seen[p.Name] {
+			return fmt.Errorf("%w: %s: %s", errRepeatingProbe, name, p.Name)
+		}
+		seen[p.Name] = true
+		if !validWeight(p.Weight) {
+			return fmt.Errorf("%w: %s: %s: %v", errInvalidWeight, name, p.Name, p.Weight)
+		}
+	}
+	return nil
+}
+
+func validWeight(w float64) bool {
+	return w >= 0 && !math.IsInf(w, 0) && !math.IsNaN(w)
+}
+
+// ProbesToRun returns the probes used by the custom checks.
+func (c *Config) ProbesToRun() []string {
+	if c == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	var probes []string
+	for _, check := range c.Checks {
+		for _, p := range check.Probes {
+			if !seen[p.Name] {
+				seen[p.Name] = true
+				probes = append(probes, p.Name)
+			}
+		}
+	}
+	slices.Sort(probes)
+	return probes
+}
+
+// Policies splits sp between the built-in and the custom checks. checks holds
+// the policies of the built-in checks, to select the checks to run, and results
+// holds a policy for every check, to format the results with. Custom checks
+// without a policy in sp are enforced with the maximum score, so they are
+// reported in SARIF output alongside the built-in checks. Policies never
+// modifies sp.
+func (c *Config) Policies(sp *policy.ScorecardPolicy) (checks, results *policy.ScorecardPolicy) {
+	if c == nil || sp == nil {
+		return sp, sp
+	}
+	checks = &policy.ScorecardPolicy{Version: sp.GetVersion(), Policies: map[string]*policy.CheckPolicy{}}
+	results = &policy.ScorecardPolicy{Version: sp.GetVersion(), Policies: map[string]*policy.CheckPolicy{}}
+	for name, cp := range sp.GetPolicies() {
+		results.Policies[name] = cp
+		if _, isCustom := c.Checks[name]; !isCustom {
+			checks.Policies[name] = cp
+		}
+	}
+	for name := range c.Checks {
+		if _, exists := results.Policies[name]; !exists {
+			results.Policies[name] = &policy.CheckPolicy{
+				Score: checker.MaxResultScore,
+				Mode:  policy.CheckPolicy_ENFORCED,
+			}
+		}
+	}
+	return checks, results
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scoring
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/policy"
+)
+
+func TestParseFromFile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want     *Config
+		name     string
+		filename string
+		wantErr  bool
+	}{
+		{
+			name:     "no file",
+			filename: "",
+		},
+		{
+			name:     "missing file",
+			filename: "./testdata/missing.yaml",
+			wantErr:  true,
+		},
+		{
+			name:     "correct",
+			filename: "./testdata/scoring.yaml",
+			want: &Config{
+				Version: 1,
+				Weights: map[string]float64{
+					"Maintained":        0,
+					"Release-Integrity": 10,
+				},
+				Checks: map[string]Check{
+					"Release-Integrity": {
+						Short:       "Determines if releases are signed, attested and ship an SBOM.",
+						Risk:        "High",
+						Remediation: []string{"Sign releases and publish their provenance and SBOM."},
+						Probes: []Probe{
+							{Name: "releasesAreSigned", Weight: 1, Pass: true},
+							{Name: "releasesHaveProvenance", Weight: 1, Pass: true},
+							{Name: "hasReleaseSBOM", Weight: 0.5, Pass: true},
+							{Name: "hasUnverifiedBinaryArtifacts", Weight: 1},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseFromFile(tt.filename)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFromFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseFromFile() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseFromYAML_invalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		err  error
+		name string
+		yaml string
+	}{
+		{
+			name: "invalid version",
+			yaml: "version: 2",
+			err:  errInvalidVersion,
+		},
+		{
+			name: "weight of unknown check",
+			yaml: "version: 1\nweights:\n  Foo: 1",
+			err:  errInvalidCheck,
+		},
+		{
+			name: "negative weight",
+			yaml: "version: 1\nweights:\n  Maintained: -1",
+			err:  errInvalidWeight,
+		},
+		{
+			name: "custom check shadows a built-in check",
+			yaml: "version: 1\nchecks:\n  Maintained:\n    risk: Low\n    probes: [hasRecentCommits]",
+			err:  errInvalidCheck,
+		},
+		{
+			name: "invalid risk",
+			yaml: "version: 1\nchecks:\n  Foo:\n    risk: Severe\n    probes: [hasRecentCommits]",
+			err:  errInvalidRisk,
+		},
+		{
+			name: "no probes",
+			yaml: "version: 1\nchecks:\n  Foo:\n    risk: Low",
+			err:  errNoProbes,
+		},
+		{
+			name: "unknown probe",
+			yaml: "version: 1\nchecks:\n  Foo:\n    risk: Low\n    probes: [notAProbe]",
+			err:  errInvalidProbe,
+		},
+		{
+			name: "repeated probe",
+			yaml: "version: 1\nchecks:\n  Foo:\n    risk: Low\n    probes: [hasRecentCommits, hasRecentCommits]",
+			err:  errRepeatingProbe,
+		},
+		{
+			name: "negative probe weight",
+			yaml: "version: 1\nchecks:\n  Foo:\n    risk: Low\n    probes:\n      - name: hasRecentCommits\n        weight: -1",
+			err:  errInvalidWeight,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parseFromYAML([]byte(tt.yaml))
+			if !errors.Is(err, tt.err) {
+				t.Errorf("parseFromYAML() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestConfig_ProbesToRun(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		Checks: map[string]Check{
+			"Foo": {Probes: []Probe{{Name: "b"}, {Name: "a"}}},
+			"Bar": {Probes: []Probe{{Name: "a"}, {Name: "c"}}},
+		},
+	}
+	if diff := cmp.Diff([]string{"a", "b", "c"}, c.ProbesToRun()); diff != "" {
+		t.Errorf("ProbesToRun() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfig_Policies(t *testing.T) {
+	t.Parallel()
+	c := &Config{Checks: map[string]Check{"Foo": {}, "Bar": {}}}
+	sp := &policy.ScorecardPolicy{
+		Version: 1,
+		Policies: map[string]*policy.CheckPolicy{
+			"Maintained": {Score: 5, Mode: policy.CheckPolicy_DISABLED},
+			"Bar":        {Score: 3, Mode: policy.CheckPolicy_ENFORCED},
+		},
+	}
+	checks, results := c.Policies(sp)
+	if len(sp.GetPolicies()) != 2 || sp.GetPolicies()["Foo"] != nil {
+		t.Errorf("Policies() modified the policy: %v", sp)
+	}
+	if got := checks.GetPolicies(); len(got) != 1 || got["Maintained"] == nil {
+		t.Errorf("Policies() checks = %v, want the Maintained policy only", got)
+	}
+	if got := results.GetPolicies()["Maintained"]; got.GetMode() != policy.CheckPolicy_DISABLED || got.GetScore() != 5 {
+		t.Errorf("Policies() changed the Maintained policy: %v", got)
+	}
+	if got := results.GetPolicies()["Bar"]; got.GetMode() != policy.CheckPolicy_ENFORCED || got.GetScore() != 3 {
+		t.Errorf("Policies() overrode the Bar policy: %v", got)
+	}
+	if got := results.GetPolicies()["Foo"]; got.GetMode() != policy.CheckPolicy_ENFORCED || got.GetScore() != 10 {
+		t.Errorf("Policies() Foo policy = %v, want enforced with score 10", got)
+	}
+}
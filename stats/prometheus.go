@@ -0,0 +1,78 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const promNamespace = "scorecard"
+
+var (
+	// PromCheckDuration tracks wall-clock runtime per check.
+	PromCheckDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: promNamespace,
+		Name:      "check_duration_seconds",
+		Help:      "Wall-clock runtime of a check in seconds.",
+		Buckets:   prometheus.ExponentialBuckets(0.25, 2, 14),
+	}, []string{"check", "repo_host"})
+
+	// PromCheckErrors counts check runtime errors by type.
+	PromCheckErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: promNamespace,
+		Name:      "check_errors_total",
+		Help:      "Count of check runtime errors by error type.",
+	}, []string{"check", "repo_host", "error"})
+
+	// PromClientCallDuration tracks RepoClient call latency per check.
+	PromClientCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: promNamespace,
+		Name:      "repo_client_call_duration_seconds",
+		Help:      "Latency of RepoClient calls in seconds.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+	}, []string{"method", "check"})
+
+	// PromHTTPRequests counts outgoing HTTP requests to forge APIs.
+	PromHTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: promNamespace,
+		Name:      "http_requests_total",
+		Help:      "Count of outgoing HTTP requests.",
+	}, []string{"host", "cache"})
+)
+
+var promRegistry = newPromRegistry()
+
+func newPromRegistry() *prometheus.Registry {
+	r := prometheus.NewRegistry()
+	r.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		PromCheckDuration,
+		PromCheckErrors,
+		PromClientCallDuration,
+		PromHTTPRequests,
+	)
+	return r
+}
+
+// MetricsHandler returns an http.Handler serving Scorecard metrics in the
+// Prometheus exposition format, suitable for a `/metrics` endpoint.
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(promRegistry, promhttp.HandlerOpts{})
+}
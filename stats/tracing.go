@@ -0,0 +1,86 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/ossf/scorecard/v5"
+
+var (
+	// AttrRepo is the span attribute for the repository being scanned.
+	AttrRepo = attribute.Key("scorecard.repo")
+	// AttrRepoHost is the span attribute for the host of the repository being scanned.
+	AttrRepoHost = attribute.Key("scorecard.repo.host")
+	// AttrCheck is the span attribute for the check name.
+	AttrCheck = attribute.Key("scorecard.check")
+	// AttrClientMethod is the span attribute for the RepoClient method called.
+	AttrClientMethod = attribute.Key("scorecard.client.method")
+	// AttrEndpoint is the span attribute for the forge API endpoint requested.
+	AttrEndpoint = attribute.Key("scorecard.api.endpoint")
+)
+
+// Tracer returns the tracer used for Scorecard spans. Spans are no-ops
+// unless InitTracing was called.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// TracingConfigured returns true if an OTLP trace endpoint is set in the
+// environment, using the standard OTEL_EXPORTER_OTLP_* variables.
+func TracingConfigured() bool {
+	for _, env := range []string{"OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"} {
+		if os.Getenv(env) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// InitTracing installs a global tracer provider exporting spans over OTLP/HTTP.
+// The exporter is configured through the standard OTEL_EXPORTER_OTLP_*
+// environment variables. The returned function flushes and stops the
+// provider and should be called before the program exits.
+func InitTracing(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("otlptracehttp.New: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("resource.Merge: %w", err)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}